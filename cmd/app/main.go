@@ -1,6 +1,9 @@
 package main
 
 import (
+	// Final image is built from scratch and has no system time zone database
+	_ "time/tzdata"
+
 	"github.com/spanwalla/pvz/internal/app"
 )
 
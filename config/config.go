@@ -9,13 +9,16 @@ import (
 
 type (
 	Config struct {
-		App        App        `yaml:"app"`
-		GRPC       GRPC       `yaml:"grpc"`
-		HTTP       HTTP       `yaml:"http"`
-		Prometheus Prometheus `yaml:"prometheus"`
-		Log        Log        `yaml:"logger"`
-		PG         PG         `yaml:"postgres"`
-		Auth       Auth       `yaml:"auth"`
+		App         App         `yaml:"app"`
+		GRPC        GRPC        `yaml:"grpc"`
+		HTTP        HTTP        `yaml:"http"`
+		Prometheus  Prometheus  `yaml:"prometheus"`
+		Log         Log         `yaml:"logger"`
+		PG          PG          `yaml:"postgres"`
+		Auth        Auth        `yaml:"auth"`
+		Idempotency Idempotency `yaml:"idempotency"`
+		Outbox      Outbox      `yaml:"outbox"`
+		Webhooks    Webhooks    `yaml:"webhooks"`
 	}
 
 	App struct {
@@ -28,7 +31,8 @@ type (
 	}
 
 	HTTP struct {
-		Port string `env-required:"true" yaml:"port" env:"HTTP_PORT"`
+		Port              string `env-required:"true" yaml:"port" env:"HTTP_PORT"`
+		ValidateResponses bool   `yaml:"validate_responses" env:"HTTP_VALIDATE_RESPONSES"`
 	}
 
 	Prometheus struct {
@@ -45,8 +49,45 @@ type (
 	}
 
 	Auth struct {
-		JWTSecretKey string        `env-required:"true" env:"AUTH_JWT_SECRET_KEY"`
-		TokenTTL     time.Duration `env_required:"true" yaml:"token_ttl" env:"AUTH_TOKEN_TTL"`
+		JWTSecretKey    string        `env:"AUTH_JWT_SECRET_KEY"`
+		KeyFiles        []string      `yaml:"key_files" env:"AUTH_KEY_FILES" env-separator:","`
+		SigningKeyID    string        `yaml:"signing_key_id" env:"AUTH_SIGNING_KEY_ID"`
+		Issuer          string        `env-required:"true" yaml:"issuer" env:"AUTH_ISSUER"`
+		Audience        string        `env-required:"true" yaml:"audience" env:"AUTH_AUDIENCE"`
+		TokenTTL        time.Duration `env_required:"true" yaml:"token_ttl" env:"AUTH_TOKEN_TTL"`
+		RefreshTokenTTL time.Duration `env-required:"true" yaml:"refresh_token_ttl" env:"AUTH_REFRESH_TOKEN_TTL"`
+		CleanupInterval time.Duration `env-default:"1h" yaml:"cleanup_interval" env:"AUTH_CLEANUP_INTERVAL"`
+	}
+
+	Idempotency struct {
+		TTL             time.Duration `env-required:"true" yaml:"ttl" env:"IDEMPOTENCY_TTL"`
+		CleanupInterval time.Duration `env-default:"1h" yaml:"cleanup_interval" env:"IDEMPOTENCY_CLEANUP_INTERVAL"`
+	}
+
+	Outbox struct {
+		PollInterval    time.Duration `env-required:"true" yaml:"poll_interval" env:"OUTBOX_POLL_INTERVAL"`
+		BatchSize       int           `env-required:"true" yaml:"batch_size" env:"OUTBOX_BATCH_SIZE"`
+		Publisher       string        `env-default:"log" yaml:"publisher" env:"OUTBOX_PUBLISHER"`
+		FilePath        string        `yaml:"file_path" env:"OUTBOX_FILE_PATH"`
+		HTTPURL         string        `yaml:"http_url" env:"OUTBOX_HTTP_URL"`
+		HTTPTimeout     time.Duration `env-default:"5s" yaml:"http_timeout" env:"OUTBOX_HTTP_TIMEOUT"`
+		MaxAttempts     int           `env-default:"10" yaml:"max_attempts" env:"OUTBOX_MAX_ATTEMPTS"`
+		RetryBaseDelay  time.Duration `env-default:"1s" yaml:"retry_base_delay" env:"OUTBOX_RETRY_BASE_DELAY"`
+		RetryMaxDelay   time.Duration `env-default:"5m" yaml:"retry_max_delay" env:"OUTBOX_RETRY_MAX_DELAY"`
+		PublishTimeout  time.Duration `env-default:"10s" yaml:"publish_timeout" env:"OUTBOX_PUBLISH_TIMEOUT"`
+		Retention       time.Duration `env-default:"168h" yaml:"retention" env:"OUTBOX_RETENTION"`
+		CleanupInterval time.Duration `env-default:"1h" yaml:"cleanup_interval" env:"OUTBOX_CLEANUP_INTERVAL"`
+	}
+
+	Webhooks struct {
+		PollInterval   time.Duration `env-required:"true" yaml:"poll_interval" env:"WEBHOOKS_POLL_INTERVAL"`
+		BatchSize      int           `env-required:"true" yaml:"batch_size" env:"WEBHOOKS_BATCH_SIZE"`
+		Timeout        time.Duration `env-required:"true" yaml:"timeout" env:"WEBHOOKS_TIMEOUT"`
+		MaxAttempts    int           `env-required:"true" yaml:"max_attempts" env:"WEBHOOKS_MAX_ATTEMPTS"`
+		RetryBaseDelay time.Duration `env-required:"true" yaml:"retry_base_delay" env:"WEBHOOKS_RETRY_BASE_DELAY"`
+		RetryMaxDelay  time.Duration `env-required:"true" yaml:"retry_max_delay" env:"WEBHOOKS_RETRY_MAX_DELAY"`
+		// AllowPrivateTargets is meant for local receivers in tests, it must stay off in production
+		AllowPrivateTargets bool `env-default:"false" yaml:"allow_private_targets" env:"WEBHOOKS_ALLOW_PRIVATE_TARGETS"`
 	}
 )
 
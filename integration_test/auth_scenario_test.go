@@ -0,0 +1,191 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/google/uuid"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+// Scenario
+// 1. POST /register, POST /login
+// 2. POST /refresh rotates the refresh token
+// 3. POST /refresh with the rotated token revokes the session, its tokens stop working
+// 4. POST /login, POST /logout, the access token stops working
+// 5. POST /login, POST /users/:userId/revoke_sessions, the access token stops working
+// 6. POST /users/:userId/revoke_sessions of an unknown user is 404
+func TestAuthScenario(t *testing.T) {
+	const password = "12TestMark"
+	email := uuid.NewString() + "@mail.ru"
+
+	userID, err := register(email, password, entity.RoleTypeEmployee)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	access, refresh, err := login(email, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotatedAccess, rotatedRefresh, err := refreshTokens(refresh, http.StatusOK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rotatedRefresh == refresh {
+		t.Fatal("refresh token was not rotated")
+	}
+
+	// Reuse of the rotated token means it leaked, the whole session is revoked
+	if _, _, err = refreshTokens(refresh, http.StatusUnauthorized); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = refreshTokens(rotatedRefresh, http.StatusUnauthorized); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, token := range []string{access, rotatedAccess} {
+		if err = checkAccess(token, http.StatusUnauthorized); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	access, refresh, err = login(email, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = Do(
+		Post(basePath+"/logout"),
+		Send().Headers("Content-Type").Add("application/json"),
+		Send().Headers("Authorization").Add("Bearer "+access),
+		Send().Body().JSON(map[string]string{"refreshToken": refresh}),
+		Expect().Status().Equal(http.StatusOK),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = checkAccess(access, http.StatusUnauthorized); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = refreshTokens(refresh, http.StatusUnauthorized); err != nil {
+		t.Fatal(err)
+	}
+
+	access, _, err = login(email, password)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = checkAccess(access, http.StatusOK); err != nil {
+		t.Fatal(err)
+	}
+
+	moderatorToken, err := dummyLogin(entity.RoleTypeModerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = Do(
+		Post(basePath+"/users/"+userID.String()+"/revoke_sessions"),
+		Send().Headers("Authorization").Add("Bearer "+moderatorToken),
+		Expect().Status().Equal(http.StatusOK),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = checkAccess(access, http.StatusUnauthorized); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = Do(
+		Post(basePath+"/users/"+uuid.NewString()+"/revoke_sessions"),
+		Send().Headers("Authorization").Add("Bearer "+moderatorToken),
+		Expect().Status().Equal(http.StatusNotFound),
+	); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// POST /register
+func register(email, password string, role entity.RoleType) (uuid.UUID, error) {
+	var id uuid.UUID
+
+	body := map[string]string{
+		"email":    email,
+		"password": password,
+		"role":     string(role),
+	}
+	if err := Do(
+		Post(basePath+"/register"),
+		Send().Headers("Content-Type").Add("application/json"),
+		Send().Body().JSON(body),
+		Expect().Status().Equal(http.StatusCreated),
+		Store().Response().Body().JSON().JQ(".id").In(&id),
+	); err != nil {
+		return uuid.Nil, err
+	}
+
+	return id, nil
+}
+
+// POST /login
+func login(email, password string) (string, string, error) {
+	var access, refresh string
+
+	body := map[string]string{
+		"email":    email,
+		"password": password,
+	}
+	if err := Do(
+		Post(basePath+"/login"),
+		Send().Headers("Content-Type").Add("application/json"),
+		Send().Body().JSON(body),
+		Expect().Status().Equal(http.StatusOK),
+		Store().Response().Body().JSON().JQ(".token").In(&access),
+		Store().Response().Body().JSON().JQ(".refreshToken").In(&refresh),
+	); err != nil {
+		return "", "", err
+	}
+
+	return access, refresh, nil
+}
+
+// POST /refresh, tokens are returned only when status is 200
+func refreshTokens(refreshToken string, status int64) (string, string, error) {
+	var access, refresh string
+
+	steps := []IStep{
+		Post(basePath + "/refresh"),
+		Send().Headers("Content-Type").Add("application/json"),
+		Send().Body().JSON(map[string]string{"refreshToken": refreshToken}),
+		Expect().Status().Equal(status),
+	}
+	if status == http.StatusOK {
+		steps = append(steps,
+			Store().Response().Body().JSON().JQ(".token").In(&access),
+			Store().Response().Body().JSON().JQ(".refreshToken").In(&refresh),
+		)
+	}
+
+	if err := Do(steps...); err != nil {
+		return "", "", err
+	}
+
+	return access, refresh, nil
+}
+
+// GET /pvz
+func checkAccess(token string, status int64) error {
+	return Do(
+		Get(basePath+"/pvz"),
+		Send().Headers("Authorization").Add("Bearer "+token),
+		Expect().Status().Equal(status),
+	)
+}
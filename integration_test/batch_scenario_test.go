@@ -0,0 +1,87 @@
+package integration_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/google/uuid"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+// Scenario
+// 1. POST /pvz
+// 2. POST /receptions
+// 3. POST /products/batch with 50 products
+// 4. POST /pvz/:pvzId/close_last_reception
+func TestBatchReceptionScenario(t *testing.T) {
+	const products = 50
+	const city = "Москва"
+	allowedProducts := []entity.ProductType{entity.ProductTypeShoes, entity.ProductTypeClothes, entity.ProductTypeElectronics}
+
+	moderatorToken, err := dummyLogin(entity.RoleTypeModerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	employeeToken, err := dummyLogin(entity.RoleTypeEmployee)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pvzId, err := createPvz(moderatorToken, city)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = openReception(employeeToken, pvzId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := make([]map[string]string, products)
+	for i := range products {
+		items[i] = map[string]string{
+			"type":     string(allowedProducts[i%len(allowedProducts)]),
+			"itemCode": fmt.Sprintf("%s-batch-%03d", pvzId, i),
+		}
+	}
+
+	err = createProductBatch(employeeToken, pvzId, items)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = closeReception(employeeToken, pvzId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Batch is rejected as a whole once the reception is closed
+	err = createProductBatch(employeeToken, pvzId, items[:1])
+	if err == nil {
+		t.Fatal("batch was accepted without an active reception")
+	}
+}
+
+// POST /products/batch
+func createProductBatch(token string, pvzId uuid.UUID, items []map[string]string) error {
+	body := map[string]any{
+		"pvzId":    pvzId.String(),
+		"products": items,
+	}
+	if err := Do(
+		Post(basePath+"/products/batch"),
+		Send().Headers("Content-Type").Add("application/json"),
+		Send().Headers("Authorization").Add("Bearer "+token),
+		Send().Body().JSON(body),
+		Expect().Status().Equal(http.StatusCreated),
+		Expect().Body().JSON().JQ(".").Len().Equal(len(items)),
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
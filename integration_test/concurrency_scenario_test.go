@@ -0,0 +1,384 @@
+package integration_test
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/google/uuid"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+// Scenario
+// 1. POST /pvz
+// 2. POST /receptions
+// 3. POST /products x20
+// 4. POST /pvz/:pvzId/delete_last_product x40 in parallel
+// 5. GET /pvz/:pvzId/receptions/active
+func TestConcurrentDeleteLastProductScenario(t *testing.T) {
+	const products = 20
+	const workers = 2 * products
+	const city = "Москва"
+
+	moderatorToken, err := dummyLogin(entity.RoleTypeModerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	employeeToken, err := dummyLogin(entity.RoleTypeEmployee)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pvzId, err := createPvz(moderatorToken, city)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = openReception(employeeToken, pvzId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := range products {
+		err = createProduct(employeeToken, pvzId, string(entity.ProductTypeShoes), fmt.Sprintf("%s-delete-%03d", pvzId, i))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	statuses := hammer(workers, func(int) (int, error) {
+		return deleteLastProduct(employeeToken, pvzId)
+	})
+
+	// Every product is deleted exactly once, the rest find the reception empty
+	if statuses[http.StatusOK] != products || statuses[http.StatusBadRequest] != workers-products {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+
+	count, err := countActiveReceptionProducts(employeeToken, pvzId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if count != 0 {
+		t.Fatalf("active reception still has %d products", count)
+	}
+}
+
+// Scenario
+// 1. POST /pvz
+// 2. POST /receptions
+// 3. POST /pvz/:pvzId/close_last_reception x20 in parallel
+func TestConcurrentCloseReceptionScenario(t *testing.T) {
+	const workers = 20
+	const city = "Казань"
+
+	moderatorToken, err := dummyLogin(entity.RoleTypeModerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	employeeToken, err := dummyLogin(entity.RoleTypeEmployee)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pvzId, err := createPvz(moderatorToken, city)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = openReception(employeeToken, pvzId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	statuses := hammer(workers, func(int) (int, error) {
+		return tryCloseReception(employeeToken, pvzId, new(uuid.UUID))
+	})
+
+	if statuses[http.StatusOK] != 1 || statuses[http.StatusBadRequest] != workers-1 {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+}
+
+// Scenario
+// 1. POST /pvz
+// 2. POST /receptions
+// 3. POST /products x40 in parallel with POST /pvz/:pvzId/close_last_reception
+// 4. GET /receptions/:receptionId
+func TestConcurrentAddWhileClosingScenario(t *testing.T) {
+	const workers = 40
+	const city = "Санкт-Петербург"
+
+	moderatorToken, err := dummyLogin(entity.RoleTypeModerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	employeeToken, err := dummyLogin(entity.RoleTypeEmployee)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pvzId, err := createPvz(moderatorToken, city)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = openReception(employeeToken, pvzId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		receptionId uuid.UUID
+		closeStatus int
+		closeErr    error
+		wg          sync.WaitGroup
+	)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		closeStatus, closeErr = tryCloseReception(employeeToken, pvzId, &receptionId)
+	}()
+
+	statuses := hammer(workers, func(i int) (int, error) {
+		return tryCreateProduct(employeeToken, pvzId, fmt.Sprintf("%s-race-%03d", pvzId, i))
+	})
+
+	wg.Wait()
+	if closeErr != nil {
+		t.Fatal(closeErr)
+	}
+
+	if closeStatus != http.StatusOK {
+		t.Fatalf("reception was not closed: %d", closeStatus)
+	}
+
+	// Products are either stored in the reception before it was closed or rejected, none are lost
+	if statuses[http.StatusCreated]+statuses[http.StatusBadRequest] != workers {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+
+	var count int
+	if err = Do(
+		Get(basePath+"/receptions/"+receptionId.String()),
+		Send().Headers("Authorization").Add("Bearer "+employeeToken),
+		Expect().Status().Equal(http.StatusOK),
+		Expect().Body().JSON().JQ(".reception.status").Equal("close"),
+		Store().Response().Body().JSON().JQ(".products | length").In(&count),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if count != statuses[http.StatusCreated] {
+		t.Fatalf("reception has %d products, %d were added", count, statuses[http.StatusCreated])
+	}
+}
+
+// Scenario
+// 1. POST /pvz x2
+// 2. POST /receptions x2
+// 3. POST /products x20 in parallel with one item code into both receptions
+func TestConcurrentDuplicateItemCodeScenario(t *testing.T) {
+	const workers = 20
+	const city = "Москва"
+
+	moderatorToken, err := dummyLogin(entity.RoleTypeModerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	employeeToken, err := dummyLogin(entity.RoleTypeEmployee)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pvzIds [2]uuid.UUID
+	for i := range pvzIds {
+		pvzIds[i], err = createPvz(moderatorToken, city)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = openReception(employeeToken, pvzIds[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	itemCode := pvzIds[0].String() + "-duplicate"
+	statuses := hammer(workers, func(i int) (int, error) {
+		return tryCreateProduct(employeeToken, pvzIds[i%len(pvzIds)], itemCode)
+	})
+
+	// The item code gets into exactly one open reception
+	if statuses[http.StatusCreated] != 1 || statuses[http.StatusConflict] != workers-1 {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+}
+
+// Scenario
+// 1. POST /pvz x2
+// 2. POST /receptions x2
+// 3. POST /products/batch x20 in parallel, every batch holds one shared item code
+func TestConcurrentDuplicateItemCodeBatchScenario(t *testing.T) {
+	const workers = 20
+	const city = "Казань"
+
+	moderatorToken, err := dummyLogin(entity.RoleTypeModerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	employeeToken, err := dummyLogin(entity.RoleTypeEmployee)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var pvzIds [2]uuid.UUID
+	for i := range pvzIds {
+		pvzIds[i], err = createPvz(moderatorToken, city)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = openReception(employeeToken, pvzIds[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	itemCode := pvzIds[0].String() + "-batch-duplicate"
+	statuses := hammer(workers, func(i int) (int, error) {
+		return tryCreateProductBatch(employeeToken, pvzIds[i%len(pvzIds)], []map[string]string{
+			{"type": string(entity.ProductTypeShoes), "itemCode": fmt.Sprintf("%s-batch-own-%03d", pvzIds[0], i)},
+			{"type": string(entity.ProductTypeShoes), "itemCode": itemCode},
+		})
+	})
+
+	// Exactly one batch gets the shared item code, the others are rejected as a whole
+	if statuses[http.StatusCreated] != 1 || statuses[http.StatusConflict] != workers-1 {
+		t.Fatalf("unexpected statuses: %v", statuses)
+	}
+}
+
+// hammer runs request from the given number of goroutines at once and counts response statuses,
+// a request failed before getting a response is counted as 0
+func hammer(workers int, request func(i int) (int, error)) map[int]int {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		start    = make(chan struct{})
+		statuses = make(map[int]int)
+	)
+
+	for i := range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+
+			status, err := request(i)
+			if err != nil {
+				status = 0
+			}
+
+			mu.Lock()
+			statuses[status]++
+			mu.Unlock()
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+
+	return statuses
+}
+
+// POST /pvz/:pvzId/delete_last_product
+func deleteLastProduct(token string, pvzId uuid.UUID) (int, error) {
+	var status int
+	err := Do(
+		Post(basePath+"/pvz/"+pvzId.String()+"/delete_last_product"),
+		Send().Headers("Authorization").Add("Bearer "+token),
+		Store().Response().StatusCode().In(&status),
+	)
+
+	return status, err
+}
+
+// POST /pvz/:pvzId/close_last_reception, the id of the closed reception is stored on success
+func tryCloseReception(token string, pvzId uuid.UUID, receptionId *uuid.UUID) (int, error) {
+	var status int
+	var body struct {
+		Id uuid.UUID `json:"id"`
+	}
+	err := Do(
+		Post(basePath+"/pvz/"+pvzId.String()+"/close_last_reception"),
+		Send().Headers("Authorization").Add("Bearer "+token),
+		Store().Response().StatusCode().In(&status),
+		Store().Response().Body().JSON().In(&body),
+	)
+	if err == nil && status == http.StatusOK {
+		*receptionId = body.Id
+	}
+
+	return status, err
+}
+
+// POST /products
+func tryCreateProduct(token string, pvzId uuid.UUID, itemCode string) (int, error) {
+	var status int
+	body := map[string]string{
+		"pvzId":    pvzId.String(),
+		"type":     string(entity.ProductTypeClothes),
+		"itemCode": itemCode,
+	}
+	err := Do(
+		Post(basePath+"/products"),
+		Send().Headers("Content-Type").Add("application/json"),
+		Send().Headers("Authorization").Add("Bearer "+token),
+		Send().Body().JSON(body),
+		Store().Response().StatusCode().In(&status),
+	)
+
+	return status, err
+}
+
+// POST /products/batch
+func tryCreateProductBatch(token string, pvzId uuid.UUID, items []map[string]string) (int, error) {
+	var status int
+	body := map[string]any{
+		"pvzId":    pvzId.String(),
+		"products": items,
+	}
+	err := Do(
+		Post(basePath+"/products/batch"),
+		Send().Headers("Content-Type").Add("application/json"),
+		Send().Headers("Authorization").Add("Bearer "+token),
+		Send().Body().JSON(body),
+		Store().Response().StatusCode().In(&status),
+	)
+
+	return status, err
+}
+
+// GET /pvz/:pvzId/receptions/active
+func countActiveReceptionProducts(token string, pvzId uuid.UUID) (int, error) {
+	var count int
+	if err := Do(
+		Get(basePath+"/pvz/"+pvzId.String()+"/receptions/active"),
+		Send().Headers("Authorization").Add("Bearer "+token),
+		Expect().Status().Equal(http.StatusOK),
+		Store().Response().Body().JSON().JQ(".products | length").In(&count),
+	); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
@@ -0,0 +1,72 @@
+package integration_test
+
+import (
+	"net/http"
+	"testing"
+
+	. "github.com/Eun/go-hit"
+	"github.com/google/uuid"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+// Scenario
+// 1. POST /pvz with Idempotency-Key
+// 2. POST /pvz with the same key and body, the first response is replayed
+// 3. POST /pvz with the same key and another body is rejected
+func TestIdempotencyScenario(t *testing.T) {
+	const city = "Казань"
+	key := uuid.NewString()
+
+	moderatorToken, err := dummyLogin(entity.RoleTypeModerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body := map[string]string{
+		"city": city,
+	}
+
+	var first, replayed uuid.UUID
+	if err = Do(
+		Post(basePath+"/pvz"),
+		Send().Headers("Content-Type").Add("application/json"),
+		Send().Headers("Authorization").Add("Bearer "+moderatorToken),
+		Send().Headers("Idempotency-Key").Add(key),
+		Send().Body().JSON(body),
+		Expect().Status().Equal(http.StatusCreated),
+		Expect().Headers("Idempotent-Replayed").Empty(),
+		Store().Response().Body().JSON().JQ(".id").In(&first),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = Do(
+		Post(basePath+"/pvz"),
+		Send().Headers("Content-Type").Add("application/json"),
+		Send().Headers("Authorization").Add("Bearer "+moderatorToken),
+		Send().Headers("Idempotency-Key").Add(key),
+		Send().Body().JSON(body),
+		Expect().Status().Equal(http.StatusCreated),
+		Expect().Headers("Idempotent-Replayed").Equal("true"),
+		Store().Response().Body().JSON().JQ(".id").In(&replayed),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	if first != replayed {
+		t.Fatalf("replayed pvz %s differs from created %s", replayed, first)
+	}
+
+	if err = Do(
+		Post(basePath+"/pvz"),
+		Send().Headers("Content-Type").Add("application/json"),
+		Send().Headers("Authorization").Add("Bearer "+moderatorToken),
+		Send().Headers("Idempotency-Key").Add(key),
+		Send().Body().JSON(map[string]string{"city": "Москва"}),
+		Expect().Status().Equal(http.StatusUnprocessableEntity),
+		Expect().Body().JSON().JQ(".code").Equal("IDEMPOTENCY_KEY_REUSED"),
+	); err != nil {
+		t.Fatal(err)
+	}
+}
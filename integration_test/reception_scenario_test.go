@@ -1,6 +1,7 @@
 package integration_test
 
 import (
+	"fmt"
 	"net/http"
 	"testing"
 
@@ -41,7 +42,7 @@ func TestReceptionScenario(t *testing.T) {
 	}
 
 	for i := range products {
-		err = createProduct(employeeToken, pvzId, string(allowedProducts[i%len(allowedProducts)]))
+		err = createProduct(employeeToken, pvzId, string(allowedProducts[i%len(allowedProducts)]), fmt.Sprintf("%s-%03d", pvzId, i))
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -53,6 +54,55 @@ func TestReceptionScenario(t *testing.T) {
 	}
 }
 
+// Scenario
+// 1. POST /pvz
+// 2. POST /receptions
+// 3. POST /products with the values of the enum that preceded the catalogue
+func TestLegacyProductTypeScenario(t *testing.T) {
+	legacyProducts := map[string]entity.ProductType{
+		"электроника": entity.ProductTypeElectronics,
+		"одежда":      entity.ProductTypeClothes,
+		"обувь":       entity.ProductTypeShoes,
+	}
+
+	moderatorToken, err := dummyLogin(entity.RoleTypeModerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	employeeToken, err := dummyLogin(entity.RoleTypeEmployee)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pvzId, err := createPvz(moderatorToken, "Москва")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = openReception(employeeToken, pvzId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for legacy, code := range legacyProducts {
+		if err = Do(
+			Post(basePath+"/products"),
+			Send().Headers("Content-Type").Add("application/json"),
+			Send().Headers("Authorization").Add("Bearer "+employeeToken),
+			Send().Body().JSON(map[string]string{
+				"pvzId":    pvzId.String(),
+				"type":     legacy,
+				"itemCode": fmt.Sprintf("%s-%s", pvzId, code),
+			}),
+			Expect().Status().Equal(http.StatusCreated),
+			Expect().Body().JSON().JQ(".type").Equal(string(code)),
+		); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
 // POST /pvz
 func createPvz(token string, city string) (uuid.UUID, error) {
 	var id uuid.UUID
@@ -93,10 +143,11 @@ func openReception(token string, pvzId uuid.UUID) error {
 }
 
 // POST /products
-func createProduct(token string, pvzId uuid.UUID, productType string) error {
+func createProduct(token string, pvzId uuid.UUID, productType, itemCode string) error {
 	body := map[string]string{
-		"pvzId": pvzId.String(),
-		"type":  productType,
+		"pvzId":    pvzId.String(),
+		"type":     productType,
+		"itemCode": itemCode,
 	}
 	if err := Do(
 		Post(basePath+"/products"),
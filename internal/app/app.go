@@ -1,6 +1,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -16,14 +17,15 @@ import (
 	"github.com/spanwalla/pvz/config"
 	grpccontroller "github.com/spanwalla/pvz/internal/controller/grpc"
 	httpcontroller "github.com/spanwalla/pvz/internal/controller/http"
+	"github.com/spanwalla/pvz/internal/events"
 	"github.com/spanwalla/pvz/internal/metrics"
 	"github.com/spanwalla/pvz/internal/repository"
 	"github.com/spanwalla/pvz/internal/service"
 	"github.com/spanwalla/pvz/pkg/grpcserver"
 	"github.com/spanwalla/pvz/pkg/hasher"
 	"github.com/spanwalla/pvz/pkg/httpserver"
+	"github.com/spanwalla/pvz/pkg/jwtkeys"
 	"github.com/spanwalla/pvz/pkg/postgres"
-	"github.com/spanwalla/pvz/pkg/validator"
 )
 
 // Run creates objects via constructors
@@ -51,28 +53,91 @@ func Run() {
 	}
 	defer pg.Close()
 
+	// Event sink
+	eventSink, closeEventSink, err := newEventSink(cfg.Outbox)
+	if err != nil {
+		panic(fmt.Errorf("app - Run - newEventSink: %w", err))
+	}
+	defer closeEventSink()
+
+	// Token signing keys
+	jwtKeys, err := jwtkeys.LoadFiles(cfg.Auth.KeyFiles, cfg.Auth.SigningKeyID)
+	if err != nil {
+		panic(fmt.Errorf("app - Run - jwtkeys.LoadFiles: %w", err))
+	}
+	if _, ok := jwtKeys.Signing(); !ok && len(cfg.Auth.JWTSecretKey) == 0 {
+		panic("app - Run: either AUTH_SIGNING_KEY_ID or AUTH_JWT_SECRET_KEY must be set")
+	}
+
 	// Services and dependencies
 	log.Info("Initializing services and dependencies...")
+	clock := clockwork.NewRealClock()
 	services := service.New(service.Dependencies{
 		Repos:          repository.New(pg),
 		Counters:       metrics.New(),
+		Events:         events.NewBus(clock),
+		EventSink:      eventSink,
 		Transaction:    manager.Must(trmpgx.NewDefaultFactory(pg.Pool)),
 		PasswordHasher: hasher.NewBcrypt(),
-		Clock:          clockwork.NewRealClock(),
-		SecretKey:      cfg.Auth.JWTSecretKey,
-		TokenTTL:       cfg.Auth.TokenTTL,
+		Clock:          clock,
+		Idempotency: service.IdempotencySettings{
+			TTL:             cfg.Idempotency.TTL,
+			CleanupInterval: cfg.Idempotency.CleanupInterval,
+		},
+		Auth: service.AuthSettings{
+			SecretKey:       cfg.Auth.JWTSecretKey,
+			Keys:            jwtKeys,
+			Issuer:          cfg.Auth.Issuer,
+			Audience:        cfg.Auth.Audience,
+			TokenTTL:        cfg.Auth.TokenTTL,
+			RefreshTokenTTL: cfg.Auth.RefreshTokenTTL,
+			CleanupInterval: cfg.Auth.CleanupInterval,
+		},
+		Outbox: service.OutboxSettings{
+			PollInterval:    cfg.Outbox.PollInterval,
+			BatchSize:       cfg.Outbox.BatchSize,
+			MaxAttempts:     cfg.Outbox.MaxAttempts,
+			RetryBaseDelay:  cfg.Outbox.RetryBaseDelay,
+			RetryMaxDelay:   cfg.Outbox.RetryMaxDelay,
+			PublishTimeout:  cfg.Outbox.PublishTimeout,
+			Retention:       cfg.Outbox.Retention,
+			CleanupInterval: cfg.Outbox.CleanupInterval,
+		},
+		Webhooks: service.WebhookSettings{
+			PollInterval:        cfg.Webhooks.PollInterval,
+			BatchSize:           cfg.Webhooks.BatchSize,
+			MaxAttempts:         cfg.Webhooks.MaxAttempts,
+			RetryBaseDelay:      cfg.Webhooks.RetryBaseDelay,
+			RetryMaxDelay:       cfg.Webhooks.RetryMaxDelay,
+			Timeout:             cfg.Webhooks.Timeout,
+			AllowPrivateTargets: cfg.Webhooks.AllowPrivateTargets,
+		},
 	})
 
+	// Background workers
+	log.Info("Starting outbox relay, webhook delivery, idempotency key and token cleanup...")
+	workersCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	go services.Outbox.Run(workersCtx)
+	go services.Webhook.Run(workersCtx)
+	go services.Idempotency.Run(workersCtx)
+	go services.Auth.Run(workersCtx)
+
 	// Echo handler
 	log.Info("Initializing handlers and routes...")
 	handler := echo.New()
-	handler.Validator = validator.NewCustomValidator()
-	httpcontroller.ConfigureRouter(handler, services)
+	httpcontroller.ConfigureRouter(handler, services, cfg.HTTP.ValidateResponses)
 
 	// gRPC Server
 	log.Infof("Starting gRPC server...")
 	log.Debugf("Server port: %s", cfg.GRPC.Port)
-	grpcHandler := grpc.NewServer()
+	authInterceptor := grpccontroller.NewAuthInterceptor(services.Auth)
+	idempotencyInterceptor := grpccontroller.NewIdempotencyInterceptor(services.Idempotency)
+	errorInterceptor := grpccontroller.NewErrorInterceptor()
+	grpcHandler := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(errorInterceptor.Unary(), authInterceptor.Unary(), idempotencyInterceptor.Unary()),
+		grpc.ChainStreamInterceptor(errorInterceptor.Stream(), authInterceptor.Stream()),
+	)
 	grpccontroller.ConfigureHandler(grpcHandler, services)
 	grpcServer, err := grpcserver.New(grpcHandler, grpcserver.WithPort(cfg.GRPC.Port))
 	if err != nil {
@@ -0,0 +1,37 @@
+package app
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/config"
+	"github.com/spanwalla/pvz/internal/events"
+)
+
+// newEventSink creates the publisher relayed events are sent to, close releases its resources
+func newEventSink(cfg config.Outbox) (events.Publisher, func(), error) {
+	switch cfg.Publisher {
+	case "log":
+		return events.NewLogPublisher(), func() {}, nil
+	case "file":
+		filePublisher, err := events.NewFilePublisher(cfg.FilePath)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return filePublisher, func() {
+			if err := filePublisher.Close(); err != nil {
+				log.Errorf("app - newEventSink - filePublisher.Close: %v", err)
+			}
+		}, nil
+	case "http":
+		if len(cfg.HTTPURL) == 0 {
+			return nil, nil, fmt.Errorf("http_url is required for http publisher")
+		}
+
+		return events.NewHTTPPublisher(cfg.HTTPURL, cfg.HTTPTimeout), func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unknown publisher %q", cfg.Publisher)
+	}
+}
@@ -0,0 +1,93 @@
+package access
+
+import (
+	"net/http"
+
+	"github.com/spanwalla/pvz/internal/controller/grpc/pvz_v1"
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+// Operation is an action of the API exposed as a REST route, RPCs or both. Every transport checks the same roles.
+type Operation struct {
+	// HTTPMethod and Path of the REST route in echo format, empty when the operation has no route
+	HTTPMethod string
+	Path       string
+	// RPCs are full gRPC method names
+	RPCs []string
+	// Public operations can be called without a token
+	Public bool
+	Access entity.Access
+}
+
+// Operations declares roles for every operation, routes and RPCs missing here are rejected
+var Operations = []Operation{
+	{HTTPMethod: http.MethodGet, Path: "/health", Public: true},
+	{HTTPMethod: http.MethodGet, Path: "/.well-known/jwks.json", Public: true},
+
+	{HTTPMethod: http.MethodPost, Path: "/dummyLogin", RPCs: []string{pvz_v1.AuthService_DummyLogin_FullMethodName}, Public: true},
+	{HTTPMethod: http.MethodPost, Path: "/register", RPCs: []string{pvz_v1.AuthService_Register_FullMethodName}, Public: true},
+	{HTTPMethod: http.MethodPost, Path: "/login", RPCs: []string{pvz_v1.AuthService_Login_FullMethodName}, Public: true},
+	{HTTPMethod: http.MethodPost, Path: "/refresh", RPCs: []string{pvz_v1.AuthService_Refresh_FullMethodName}, Public: true},
+	{HTTPMethod: http.MethodPost, Path: "/logout", RPCs: []string{pvz_v1.AuthService_Logout_FullMethodName}},
+	{HTTPMethod: http.MethodPost, Path: "/users/:userId/revoke_sessions", RPCs: []string{pvz_v1.AuthService_RevokeUserSessions_FullMethodName},
+		Access: entity.Access{entity.RoleTypeModerator}},
+
+	{HTTPMethod: http.MethodGet, Path: "/cities", RPCs: []string{pvz_v1.CityService_ListCities_FullMethodName},
+		Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPost, Path: "/cities", RPCs: []string{pvz_v1.CityService_CreateCity_FullMethodName},
+		Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPatch, Path: "/cities/:cityId", RPCs: []string{pvz_v1.CityService_RenameCity_FullMethodName},
+		Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPost, Path: "/cities/:cityId/deactivate", RPCs: []string{pvz_v1.CityService_DeactivateCity_FullMethodName},
+		Access: entity.Access{entity.RoleTypeModerator}},
+
+	{HTTPMethod: http.MethodGet, Path: "/product_types"},
+	{HTTPMethod: http.MethodPost, Path: "/product_types", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPatch, Path: "/product_types/:code", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPost, Path: "/product_types/:code/activate", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPost, Path: "/product_types/:code/deactivate", Access: entity.Access{entity.RoleTypeModerator}},
+
+	{RPCs: []string{pvz_v1.PVZService_GetPVZList_FullMethodName}},
+	{HTTPMethod: http.MethodPost, Path: "/pvz", RPCs: []string{pvz_v1.PVZService_CreatePVZ_FullMethodName},
+		Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodGet, Path: "/pvz", RPCs: []string{
+		pvz_v1.PVZService_GetPVZListExtended_FullMethodName,
+		pvz_v1.PVZService_StreamPVZListExtended_FullMethodName,
+	}},
+	{HTTPMethod: http.MethodGet, Path: "/pvz/nearby", RPCs: []string{pvz_v1.PVZService_GetNearbyPVZ_FullMethodName}},
+	{HTTPMethod: http.MethodGet, Path: "/pvz/:pvzId"},
+	{HTTPMethod: http.MethodPost, Path: "/pvz/:pvzId/suspend", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPost, Path: "/pvz/:pvzId/resume", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPost, Path: "/pvz/:pvzId/close", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPost, Path: "/pvz/:pvzId/close_last_reception", RPCs: []string{pvz_v1.PVZService_CloseLastReception_FullMethodName},
+		Access: entity.Access{entity.RoleTypeEmployee}},
+	{HTTPMethod: http.MethodPost, Path: "/pvz/:pvzId/delete_last_product", RPCs: []string{pvz_v1.PVZService_DeleteLastProduct_FullMethodName},
+		Access: entity.Access{entity.RoleTypeEmployee}},
+	{HTTPMethod: http.MethodDelete, Path: "/pvz/:pvzId/receptions/active/products/:productId", RPCs: []string{pvz_v1.PVZService_DeleteProduct_FullMethodName},
+		Access: entity.Access{entity.RoleTypeEmployee}},
+	{HTTPMethod: http.MethodGet, Path: "/pvz/:pvzId/receptions/active"},
+	{HTTPMethod: http.MethodGet, Path: "/pvz/:pvzId/schedule"},
+	{HTTPMethod: http.MethodPut, Path: "/pvz/:pvzId/schedule", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPost, Path: "/pvz/:pvzId/schedule/override", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodDelete, Path: "/pvz/:pvzId/schedule/override", Access: entity.Access{entity.RoleTypeModerator}},
+
+	{HTTPMethod: http.MethodPost, Path: "/receptions", RPCs: []string{pvz_v1.ReceptionService_CreateReception_FullMethodName},
+		Access: entity.Access{entity.RoleTypeEmployee}},
+	{HTTPMethod: http.MethodGet, Path: "/receptions/:receptionId"},
+	{HTTPMethod: http.MethodPost, Path: "/receptions/:receptionId/reopen", Access: entity.Access{entity.RoleTypeModerator}},
+
+	{HTTPMethod: http.MethodPost, Path: "/products", RPCs: []string{pvz_v1.ProductService_AddProduct_FullMethodName},
+		Access: entity.Access{entity.RoleTypeEmployee}},
+	{HTTPMethod: http.MethodPost, Path: "/products/batch", Access: entity.Access{entity.RoleTypeEmployee}},
+	{HTTPMethod: http.MethodGet, Path: "/products/by-code/:code"},
+	{HTTPMethod: http.MethodGet, Path: "/products/:productId"},
+	{HTTPMethod: http.MethodPost, Path: "/products/:productId/issue", Access: entity.Access{entity.RoleTypeEmployee}},
+	{HTTPMethod: http.MethodPost, Path: "/products/:productId/return", Access: entity.Access{entity.RoleTypeEmployee}},
+
+	{HTTPMethod: http.MethodGet, Path: "/events", RPCs: []string{pvz_v1.EventService_WatchEvents_FullMethodName}},
+
+	{HTTPMethod: http.MethodGet, Path: "/webhooks", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodPost, Path: "/webhooks", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodDelete, Path: "/webhooks/:webhookId", Access: entity.Access{entity.RoleTypeModerator}},
+	{HTTPMethod: http.MethodGet, Path: "/webhooks/:webhookId/deliveries", Access: entity.Access{entity.RoleTypeModerator}},
+}
@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"net/mail"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/spanwalla/pvz/internal/controller/grpc/pvz_v1"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+var roleToProto = map[entity.RoleType]pvz_v1.UserRole{
+	entity.RoleTypeEmployee:  pvz_v1.UserRole_USER_ROLE_EMPLOYEE,
+	entity.RoleTypeModerator: pvz_v1.UserRole_USER_ROLE_MODERATOR,
+}
+
+var roleFromProto = map[pvz_v1.UserRole]entity.RoleType{
+	pvz_v1.UserRole_USER_ROLE_EMPLOYEE:  entity.RoleTypeEmployee,
+	pvz_v1.UserRole_USER_ROLE_MODERATOR: entity.RoleTypeModerator,
+}
+
+type AuthHandler struct {
+	authService service.Auth
+	pvz_v1.UnimplementedAuthServiceServer
+}
+
+func NewAuthHandler(authService service.Auth) *AuthHandler {
+	return &AuthHandler{
+		authService: authService,
+	}
+}
+
+func (h *AuthHandler) DummyLogin(ctx context.Context, req *pvz_v1.DummyLoginRequest) (*pvz_v1.Token, error) {
+	role, ok := roleFromProto[req.GetRole()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "field role must be employee or moderator")
+	}
+
+	token, err := h.authService.DummyLogin(ctx, role)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return &pvz_v1.Token{Token: token}, nil
+}
+
+func (h *AuthHandler) Register(ctx context.Context, req *pvz_v1.RegisterRequest) (*pvz_v1.User, error) {
+	if err := validateCredentials(req.GetEmail(), req.GetPassword()); err != nil {
+		return nil, err
+	}
+
+	role, ok := roleFromProto[req.GetRole()]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "field role must be employee or moderator")
+	}
+
+	user, err := h.authService.Register(ctx, req.GetEmail(), req.GetPassword(), role)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return &pvz_v1.User{
+		Id:    user.ID.String(),
+		Email: user.Email,
+		Role:  roleToProto[user.Role],
+	}, nil
+}
+
+func (h *AuthHandler) Login(ctx context.Context, req *pvz_v1.LoginRequest) (*pvz_v1.Token, error) {
+	if err := validateCredentials(req.GetEmail(), req.GetPassword()); err != nil {
+		return nil, err
+	}
+
+	pair, err := h.authService.Login(ctx, req.GetEmail(), req.GetPassword())
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return tokenPairToProto(pair), nil
+}
+
+func (h *AuthHandler) Refresh(ctx context.Context, req *pvz_v1.RefreshRequest) (*pvz_v1.Token, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "field refresh_token is required")
+	}
+
+	pair, err := h.authService.Refresh(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return tokenPairToProto(pair), nil
+}
+
+func (h *AuthHandler) Logout(ctx context.Context, req *pvz_v1.LogoutRequest) (*emptypb.Empty, error) {
+	claims, ok := ClaimsFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid auth header")
+	}
+
+	if err := h.authService.Logout(ctx, claims, req.GetRefreshToken()); err != nil {
+		return nil, serviceError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *AuthHandler) RevokeUserSessions(ctx context.Context, req *pvz_v1.RevokeUserSessionsRequest) (*emptypb.Empty, error) {
+	userID, err := parseUUID("user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.authService.RevokeUserSessions(ctx, userID); err != nil {
+		// ErrUserNotFound means a failed login elsewhere, here the user in the request does not exist
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, withErrorInfo(status.New(codes.NotFound, err.Error()), service.CodeOf(err)).Err()
+		}
+
+		return nil, serviceError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func tokenPairToProto(pair service.TokenPair) *pvz_v1.Token {
+	return &pvz_v1.Token{
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	}
+}
+
+// validateCredentials applies the same limits as REST API register and login requests
+func validateCredentials(email, password string) error {
+	switch {
+	case email == "":
+		return status.Error(codes.InvalidArgument, "field email is required")
+	case len(email) > 255:
+		return status.Error(codes.InvalidArgument, "field email must be at most 255 characters")
+	case len(password) < 8 || len(password) > 64:
+		return status.Error(codes.InvalidArgument, "field password must be between 8 and 64 characters")
+	}
+
+	if _, err := mail.ParseAddress(email); err != nil {
+		return status.Error(codes.InvalidArgument, "field email must be a valid email address")
+	}
+	return nil
+}
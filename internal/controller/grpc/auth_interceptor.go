@@ -0,0 +1,141 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/spanwalla/pvz/internal/controller/access"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+// publicMethods can be called without a token, methodAccess declares roles allowed to call the other RPCs.
+// Methods missing in both are rejected.
+var publicMethods, methodAccess = methodRules()
+
+func methodRules() (map[string]struct{}, map[string]entity.Access) {
+	public := make(map[string]struct{})
+	rules := make(map[string]entity.Access)
+	for _, op := range access.Operations {
+		for _, method := range op.RPCs {
+			if op.Public {
+				public[method] = struct{}{}
+			} else {
+				rules[method] = op.Access
+			}
+		}
+	}
+
+	return public, rules
+}
+
+type claimsKey struct{}
+
+// ClaimsFromContext returns token claims stored by AuthInterceptor
+func ClaimsFromContext(ctx context.Context) (*entity.TokenClaims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*entity.TokenClaims)
+	return claims, ok
+}
+
+type AuthInterceptor struct {
+	authService service.Auth
+}
+
+func NewAuthInterceptor(authService service.Auth) *AuthInterceptor {
+	return &AuthInterceptor{
+		authService: authService,
+	}
+}
+
+// Unary - interceptor to check authorization of unary RPCs
+//
+// - Reads `authorization` metadata (expected format `Bearer <JWT token>`)
+//
+// - Checks the role against methodAccess and stores token claims in the context
+func (i *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := i.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Stream - interceptor to check authorization of streaming RPCs, works the same way as Unary
+func (i *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (i *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	if _, ok := publicMethods[method]; ok {
+		return ctx, nil
+	}
+
+	access, ok := methodAccess[method]
+	if !ok {
+		log.Errorf("AuthInterceptor.authorize - no access rule for %s", method)
+		return nil, status.Error(codes.PermissionDenied, "no rights")
+	}
+
+	token, ok := bearerToken(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "invalid auth header")
+	}
+
+	claims, err := i.authService.ParseToken(ctx, token)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	if !access.Allows(claims.Role) {
+		return nil, status.Error(codes.PermissionDenied, "no rights")
+	}
+
+	return context.WithValue(ctx, claimsKey{}, claims), nil
+}
+
+func bearerToken(ctx context.Context) (string, bool) {
+	const prefix = "Bearer "
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+
+	header := values[0]
+	if len(header) > len(prefix) && strings.EqualFold(header[:len(prefix)], prefix) {
+		return header[len(prefix):], true
+	}
+
+	return "", false
+}
+
+// authorizedStream overrides stream context so handlers can read token claims
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
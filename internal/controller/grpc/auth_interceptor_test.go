@@ -0,0 +1,159 @@
+package grpc_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	grpccontroller "github.com/spanwalla/pvz/internal/controller/grpc"
+	"github.com/spanwalla/pvz/internal/controller/grpc/pvz_v1"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+	servicemocks "github.com/spanwalla/pvz/internal/service/mocks"
+)
+
+func TestAuthInterceptor_Unary(t *testing.T) {
+	log.SetOutput(io.Discard)
+	const token = "token"
+
+	claims := &entity.TokenClaims{UserID: uuid.New(), Role: entity.RoleTypeEmployee}
+	withToken := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+
+	type MockBehavior func(a *servicemocks.MockAuth)
+
+	for _, tc := range []struct {
+		name         string
+		ctx          context.Context
+		method       string
+		mockBehavior MockBehavior
+		wantClaims   *entity.TokenClaims
+		wantCode     codes.Code
+	}{
+		{
+			name:   "public method without token",
+			ctx:    context.Background(),
+			method: pvz_v1.AuthService_Login_FullMethodName,
+		},
+		{
+			name:   "allowed role",
+			ctx:    withToken,
+			method: pvz_v1.ProductService_AddProduct_FullMethodName,
+			mockBehavior: func(a *servicemocks.MockAuth) {
+				a.EXPECT().ParseToken(gomock.Any(), token).Return(claims, nil)
+			},
+			wantClaims: claims,
+		},
+		{
+			name:   "any authenticated role",
+			ctx:    withToken,
+			method: pvz_v1.PVZService_GetPVZListExtended_FullMethodName,
+			mockBehavior: func(a *servicemocks.MockAuth) {
+				a.EXPECT().ParseToken(gomock.Any(), token).Return(claims, nil)
+			},
+			wantClaims: claims,
+		},
+		{
+			name:     "missing token",
+			ctx:      context.Background(),
+			method:   pvz_v1.ProductService_AddProduct_FullMethodName,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:     "malformed auth header",
+			ctx:      metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", token)),
+			method:   pvz_v1.ProductService_AddProduct_FullMethodName,
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:   "invalid token",
+			ctx:    withToken,
+			method: pvz_v1.ProductService_AddProduct_FullMethodName,
+			mockBehavior: func(a *servicemocks.MockAuth) {
+				a.EXPECT().ParseToken(gomock.Any(), token).Return(nil, service.ErrTokenExpired)
+			},
+			wantCode: codes.Unauthenticated,
+		},
+		{
+			name:   "wrong role",
+			ctx:    withToken,
+			method: pvz_v1.PVZService_CreatePVZ_FullMethodName,
+			mockBehavior: func(a *servicemocks.MockAuth) {
+				a.EXPECT().ParseToken(gomock.Any(), token).Return(claims, nil)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:     "unlisted method",
+			ctx:      withToken,
+			method:   "/pvz.v1.PVZService/Unknown",
+			wantCode: codes.PermissionDenied,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockAuthService := servicemocks.NewMockAuth(ctrl)
+
+			if tc.mockBehavior != nil {
+				tc.mockBehavior(mockAuthService)
+			}
+
+			var called bool
+			handler := func(ctx context.Context, _ any) (any, error) {
+				called = true
+				gotClaims, _ := grpccontroller.ClaimsFromContext(ctx)
+				assert.Equal(t, tc.wantClaims, gotClaims)
+				return nil, nil
+			}
+
+			interceptor := grpccontroller.NewAuthInterceptor(mockAuthService)
+			_, err := interceptor.Unary()(tc.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+
+			assert.Equal(t, tc.wantCode, status.Code(err))
+			assert.Equal(t, tc.wantCode == codes.OK, called)
+		})
+	}
+}
+
+// Every RPC of the API needs an access rule, a method without one is denied to every role
+func TestAuthInterceptor_EveryMethodHasRule(t *testing.T) {
+	log.SetOutput(io.Discard)
+	ctrl := gomock.NewController(t)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token"))
+	handler := func(context.Context, any) (any, error) { return nil, nil }
+
+	interceptors := make([]grpc.UnaryServerInterceptor, 0, 2)
+	for _, role := range []entity.RoleType{entity.RoleTypeEmployee, entity.RoleTypeModerator} {
+		mockAuthService := servicemocks.NewMockAuth(ctrl)
+		mockAuthService.EXPECT().ParseToken(gomock.Any(), "token").Return(&entity.TokenClaims{Role: role}, nil).AnyTimes()
+		interceptors = append(interceptors, grpccontroller.NewAuthInterceptor(mockAuthService).Unary())
+	}
+
+	services := pvz_v1.File_pvz_proto.Services()
+	for i := range services.Len() {
+		methods := services.Get(i).Methods()
+		for j := range methods.Len() {
+			method := fmt.Sprintf("/%s/%s", services.Get(i).FullName(), methods.Get(j).Name())
+
+			allowed := false
+			for _, interceptor := range interceptors {
+				_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+				allowed = allowed || err == nil
+			}
+
+			assert.True(t, allowed, method)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/spanwalla/pvz/internal/controller/grpc/pvz_v1"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+type CityHandler struct {
+	cityService service.City
+	pvz_v1.UnimplementedCityServiceServer
+}
+
+func NewCityHandler(cityService service.City) *CityHandler {
+	return &CityHandler{
+		cityService: cityService,
+	}
+}
+
+func (h *CityHandler) ListCities(ctx context.Context, req *pvz_v1.ListCitiesRequest) (*pvz_v1.ListCitiesResponse, error) {
+	cities, err := h.cityService.GetAll(ctx, req.GetIncludeInactive())
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	out := make([]*pvz_v1.City, len(cities))
+	for i, city := range cities {
+		out[i] = cityToProto(city)
+	}
+	return &pvz_v1.ListCitiesResponse{Cities: out}, nil
+}
+
+func (h *CityHandler) CreateCity(ctx context.Context, req *pvz_v1.CreateCityRequest) (*pvz_v1.City, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "field name is required")
+	}
+
+	city, err := h.cityService.Create(ctx, req.GetName())
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return cityToProto(city), nil
+}
+
+func (h *CityHandler) RenameCity(ctx context.Context, req *pvz_v1.RenameCityRequest) (*pvz_v1.City, error) {
+	if req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "field name is required")
+	}
+
+	city, err := h.cityService.Rename(ctx, int(req.GetId()), req.GetName())
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return cityToProto(city), nil
+}
+
+func (h *CityHandler) DeactivateCity(ctx context.Context, req *pvz_v1.DeactivateCityRequest) (*pvz_v1.City, error) {
+	city, err := h.cityService.Deactivate(ctx, int(req.GetId()))
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return cityToProto(city), nil
+}
+
+func cityToProto(city entity.City) *pvz_v1.City {
+	return &pvz_v1.City{
+		Id:        int32(city.ID),
+		Name:      city.Name,
+		IsActive:  city.IsActive,
+		CreatedAt: timestamppb.New(city.CreatedAt),
+	}
+}
@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+// serviceErrorCodes maps known service errors to gRPC codes, anything else is reported as codes.Internal
+var serviceErrorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{service.ErrCityNotFound, codes.NotFound},
+	{service.ErrPointNotFound, codes.NotFound},
+	{service.ErrProductNotFound, codes.NotFound},
+	{service.ErrProductTypeNotFound, codes.NotFound},
+	{service.ErrClosedReceptionNotFound, codes.NotFound},
+
+	{service.ErrCityAlreadyExists, codes.AlreadyExists},
+	{service.ErrUserAlreadyExists, codes.AlreadyExists},
+	{service.ErrProductTypeAlreadyExists, codes.AlreadyExists},
+	{service.ErrReceptionAlreadyOpened, codes.AlreadyExists},
+	{service.ErrProductAlreadyScanned, codes.AlreadyExists},
+
+	{service.ErrActiveReceptionNotFound, codes.FailedPrecondition},
+	{service.ErrPointSuspended, codes.FailedPrecondition},
+	{service.ErrPointClosed, codes.FailedPrecondition},
+	{service.ErrPointOutsideWorkingHours, codes.FailedPrecondition},
+	{service.ErrInvalidPointTransition, codes.FailedPrecondition},
+	{service.ErrInvalidProductTransition, codes.FailedPrecondition},
+
+	{service.ErrInvalidTimeZone, codes.InvalidArgument},
+	{service.ErrInvalidSchedule, codes.InvalidArgument},
+	{service.ErrInvalidOverride, codes.InvalidArgument},
+	{service.ErrProductTypeNameRequired, codes.InvalidArgument},
+	{service.ErrInvalidCursor, codes.InvalidArgument},
+	{service.ErrIdempotencyKeyReused, codes.InvalidArgument},
+
+	{service.ErrIdempotentRequestInProgress, codes.Aborted},
+
+	{service.ErrUserNotFound, codes.Unauthenticated},
+	{service.ErrWrongPassword, codes.Unauthenticated},
+	{service.ErrTokenExpired, codes.Unauthenticated},
+	{service.ErrCannotAcceptToken, codes.Unauthenticated},
+	{service.ErrTokenRevoked, codes.Unauthenticated},
+	{service.ErrInvalidRefreshToken, codes.Unauthenticated},
+	{service.ErrRefreshTokenReused, codes.Unauthenticated},
+}
+
+// errorDomain identifies this API in ErrorInfo details
+const errorDomain = "pvz"
+
+// statusCodes is used for errors without a service code, e.g. request validation errors in handlers
+var statusCodes = map[codes.Code]service.Code{
+	codes.InvalidArgument:  service.CodeValidationFailed,
+	codes.Unauthenticated:  service.CodeUnauthorized,
+	codes.PermissionDenied: service.CodeForbidden,
+	codes.NotFound:         service.CodeNotFound,
+}
+
+// serviceError converts err to a status with the matching gRPC code and ErrorInfo holding the service code
+func serviceError(err error) error {
+	code := codes.Internal
+	for _, known := range serviceErrorCodes {
+		if errors.Is(err, known.err) {
+			code = known.code
+			break
+		}
+	}
+
+	return withErrorInfo(status.New(code, err.Error()), service.CodeOf(err)).Err()
+}
+
+func withErrorInfo(st *status.Status, code service.Code) *status.Status {
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(code),
+		Domain: errorDomain,
+	})
+	if err != nil {
+		log.Errorf("grpc - withErrorInfo - st.WithDetails: %v", err)
+		return st
+	}
+
+	return detailed
+}
+
+func hasErrorInfo(st *status.Status) bool {
+	for _, detail := range st.Details() {
+		if _, ok := detail.(*errdetails.ErrorInfo); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ErrorInterceptor adds ErrorInfo to errors returned without it, so every error carries a stable code
+type ErrorInterceptor struct{}
+
+func NewErrorInterceptor() *ErrorInterceptor {
+	return &ErrorInterceptor{}
+}
+
+func (i *ErrorInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, detailedError(err)
+		}
+
+		return resp, nil
+	}
+}
+
+func (i *ErrorInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return detailedError(err)
+		}
+
+		return nil
+	}
+}
+
+func detailedError(err error) error {
+	st := status.Convert(err)
+	if st.Code() == codes.OK || hasErrorInfo(st) {
+		return err
+	}
+
+	code, ok := statusCodes[st.Code()]
+	if !ok {
+		code = service.CodeInternal
+	}
+
+	return withErrorInfo(st, code).Err()
+}
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/spanwalla/pvz/internal/controller/grpc/pvz_v1"
+	"github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+var eventTypeToProto = map[entity.EventType]pvz_v1.EventType{
+	entity.EventTypeReceptionCreated: pvz_v1.EventType_EVENT_TYPE_RECEPTION_CREATED,
+	entity.EventTypeReceptionClosed:  pvz_v1.EventType_EVENT_TYPE_RECEPTION_CLOSED,
+	entity.EventTypeProductAdded:     pvz_v1.EventType_EVENT_TYPE_PRODUCT_ADDED,
+	entity.EventTypeProductDeleted:   pvz_v1.EventType_EVENT_TYPE_PRODUCT_DELETED,
+}
+
+type EventHandler struct {
+	eventService service.Event
+	pvz_v1.UnimplementedEventServiceServer
+}
+
+func NewEventHandler(eventService service.Event) *EventHandler {
+	return &EventHandler{
+		eventService: eventService,
+	}
+}
+
+func (h *EventHandler) WatchEvents(req *pvz_v1.WatchEventsRequest, stream grpc.ServerStreamingServer[pvz_v1.Event]) error {
+	var filter dto.EventFilter
+	if req.GetPvzId() != "" {
+		pointID, err := parseUUID("pvz_id", req.GetPvzId())
+		if err != nil {
+			return err
+		}
+		filter.PointID = &pointID
+	}
+	if req.GetCity() != "" {
+		filter.City = lo.ToPtr(req.GetCity())
+	}
+
+	// Channel is closed when the client cancels the stream
+	for event := range h.eventService.Subscribe(stream.Context(), filter) {
+		if err := stream.Send(eventToProto(event)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func eventToProto(event entity.Event) *pvz_v1.Event {
+	out := &pvz_v1.Event{
+		Id:          event.ID.String(),
+		Type:        eventTypeToProto[event.Type],
+		PvzId:       event.PointID.String(),
+		City:        event.City,
+		ReceptionId: event.ReceptionID.String(),
+		OccurredAt:  timestamppb.New(event.OccurredAt),
+	}
+
+	if event.ProductID != nil {
+		out.ProductId = lo.ToPtr(event.ProductID.String())
+	}
+	return out
+}
@@ -9,4 +9,9 @@ import (
 
 func ConfigureHandler(server *grpc.Server, services *service.Services) {
 	pvz_v1.RegisterPVZServiceServer(server, NewPVZHandler(services.Point))
+	pvz_v1.RegisterCityServiceServer(server, NewCityHandler(services.City))
+	pvz_v1.RegisterReceptionServiceServer(server, NewReceptionHandler(services.Reception))
+	pvz_v1.RegisterProductServiceServer(server, NewProductHandler(services.Product))
+	pvz_v1.RegisterEventServiceServer(server, NewEventHandler(services.Event))
+	pvz_v1.RegisterAuthServiceServer(server, NewAuthHandler(services.Auth))
 }
@@ -0,0 +1,200 @@
+package grpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+const (
+	idempotencyKeyField     = "idempotency_key"
+	maxIdempotencyKeyLength = 255
+
+	contentTypeAny    = "application/x-protobuf; type=google.protobuf.Any"
+	contentTypeStatus = "application/x-protobuf; type=google.rpc.Status"
+)
+
+// retryableCodes are not stored, so the call can be retried with the same key
+var retryableCodes = map[codes.Code]struct{}{
+	codes.Canceled:          {},
+	codes.Unknown:           {},
+	codes.DeadlineExceeded:  {},
+	codes.ResourceExhausted: {},
+	codes.Aborted:           {},
+	codes.Internal:          {},
+	codes.Unavailable:       {},
+}
+
+// idempotentRequest is implemented by request messages with the idempotency_key field
+type idempotentRequest interface {
+	proto.Message
+	GetIdempotencyKey() string
+}
+
+type IdempotencyInterceptor struct {
+	idempotencyService service.Idempotency
+}
+
+func NewIdempotencyInterceptor(idempotencyService service.Idempotency) *IdempotencyInterceptor {
+	return &IdempotencyInterceptor{
+		idempotencyService: idempotencyService,
+	}
+}
+
+// Unary - interceptor to make RPCs with idempotency_key idempotent, works the same way as the REST middleware
+//
+// - Replays the first response stored for the user and key
+//
+// - Rejects reuse of the key with another method or request
+//
+// Must be chained after AuthInterceptor
+func (i *IdempotencyInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		msg, ok := req.(idempotentRequest)
+		if !ok || len(msg.GetIdempotencyKey()) == 0 {
+			return handler(ctx, req)
+		}
+
+		key := msg.GetIdempotencyKey()
+		if len(key) > maxIdempotencyKeyLength {
+			return nil, grpcstatus.Errorf(codes.InvalidArgument, "field %s must be at most %d characters", idempotencyKeyField, maxIdempotencyKeyLength)
+		}
+
+		claims, ok := ClaimsFromContext(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		hash, err := requestHash(info.FullMethod, msg)
+		if err != nil {
+			log.Errorf("IdempotencyInterceptor.Unary - requestHash: %v", err)
+			return nil, grpcstatus.Error(codes.Internal, service.ErrCannotProcessIdempotencyKey.Error())
+		}
+
+		stored, err := i.idempotencyService.Begin(ctx, claims.UserID, key, hash)
+		if err != nil {
+			return nil, serviceError(err)
+		}
+
+		if stored != nil {
+			return replay(*stored)
+		}
+
+		// A panicking handler must not keep the key reserved until it expires
+		defer func() {
+			if r := recover(); r != nil {
+				i.idempotencyService.Release(context.WithoutCancel(ctx), claims.UserID, key)
+				panic(r)
+			}
+		}()
+
+		resp, err := handler(ctx, req)
+
+		ctx = context.WithoutCancel(ctx)
+		record, ok := recordResponse(resp, err)
+		if !ok {
+			i.idempotencyService.Release(ctx, claims.UserID, key)
+			return resp, err
+		}
+
+		if completeErr := i.idempotencyService.Complete(ctx, claims.UserID, key, record); completeErr != nil {
+			i.idempotencyService.Release(ctx, claims.UserID, key)
+		}
+
+		return resp, err
+	}
+}
+
+// requestHash identifies the call by method and request without the key itself
+func requestHash(method string, msg proto.Message) (string, error) {
+	clone := proto.Clone(msg)
+	reflection := clone.ProtoReflect()
+	reflection.Clear(reflection.Descriptor().Fields().ByName(idempotencyKeyField))
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(clone)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(method + "\n"))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// recordResponse converts the result of the call to a stored response, ok is false when it must not be stored
+func recordResponse(resp any, callErr error) (entity.IdempotentResponse, bool) {
+	if callErr != nil {
+		st := grpcstatus.Convert(callErr)
+		if _, retryable := retryableCodes[st.Code()]; retryable {
+			return entity.IdempotentResponse{}, false
+		}
+
+		body, err := proto.Marshal(st.Proto())
+		if err != nil {
+			log.Errorf("IdempotencyInterceptor.recordResponse - proto.Marshal: %v", err)
+			return entity.IdempotentResponse{}, false
+		}
+
+		return entity.IdempotentResponse{StatusCode: int(st.Code()), ContentType: contentTypeStatus, Body: body}, true
+	}
+
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return entity.IdempotentResponse{}, false
+	}
+
+	wrapped, err := anypb.New(msg)
+	if err != nil {
+		log.Errorf("IdempotencyInterceptor.recordResponse - anypb.New: %v", err)
+		return entity.IdempotentResponse{}, false
+	}
+
+	body, err := proto.Marshal(wrapped)
+	if err != nil {
+		log.Errorf("IdempotencyInterceptor.recordResponse - proto.Marshal: %v", err)
+		return entity.IdempotentResponse{}, false
+	}
+
+	return entity.IdempotentResponse{StatusCode: int(codes.OK), ContentType: contentTypeAny, Body: body}, true
+}
+
+func replay(stored entity.IdempotentResponse) (any, error) {
+	switch stored.ContentType {
+	case contentTypeStatus:
+		var st status.Status
+		if err := proto.Unmarshal(stored.Body, &st); err != nil {
+			log.Errorf("IdempotencyInterceptor.replay - proto.Unmarshal: %v", err)
+			return nil, grpcstatus.Error(codes.Internal, service.ErrCannotProcessIdempotencyKey.Error())
+		}
+		return nil, grpcstatus.ErrorProto(&st)
+	case contentTypeAny:
+		var wrapped anypb.Any
+		if err := proto.Unmarshal(stored.Body, &wrapped); err != nil {
+			log.Errorf("IdempotencyInterceptor.replay - proto.Unmarshal: %v", err)
+			return nil, grpcstatus.Error(codes.Internal, service.ErrCannotProcessIdempotencyKey.Error())
+		}
+
+		msg, err := wrapped.UnmarshalNew()
+		if err != nil {
+			log.Errorf("IdempotencyInterceptor.replay - wrapped.UnmarshalNew: %v", err)
+			return nil, grpcstatus.Error(codes.Internal, service.ErrCannotProcessIdempotencyKey.Error())
+		}
+		return msg, nil
+	}
+
+	// The key was used for a REST request
+	return nil, serviceError(service.ErrIdempotencyKeyReused)
+}
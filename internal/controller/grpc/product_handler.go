@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/spanwalla/pvz/internal/controller/grpc/pvz_v1"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+type ProductHandler struct {
+	productService service.Product
+	pvz_v1.UnimplementedProductServiceServer
+}
+
+func NewProductHandler(productService service.Product) *ProductHandler {
+	return &ProductHandler{
+		productService: productService,
+	}
+}
+
+func (h *ProductHandler) AddProduct(ctx context.Context, req *pvz_v1.AddProductRequest) (*pvz_v1.Product, error) {
+	pointID, err := parseUUID("pvz_id", req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.GetType() == "":
+		return nil, status.Error(codes.InvalidArgument, "field type is required")
+	case len(req.GetType()) > 32:
+		return nil, status.Error(codes.InvalidArgument, "field type must be at most 32 characters")
+	case req.GetItemCode() == "":
+		return nil, status.Error(codes.InvalidArgument, "field item_code is required")
+	case len(req.GetItemCode()) > 64:
+		return nil, status.Error(codes.InvalidArgument, "field item_code must be at most 64 characters")
+	}
+
+	product, err := h.productService.Create(ctx, pointID, entity.ProductType(req.GetType()), req.GetItemCode())
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return productToProto(product), nil
+}
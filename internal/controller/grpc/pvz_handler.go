@@ -2,13 +2,49 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/spanwalla/pvz/internal/controller/grpc/pvz_v1"
+	"github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
 	"github.com/spanwalla/pvz/internal/service"
 )
 
+var pointStatusToProto = map[entity.PointStatus]pvz_v1.PVZStatus{
+	entity.PointStatusActive:    pvz_v1.PVZStatus_PVZ_STATUS_ACTIVE,
+	entity.PointStatusSuspended: pvz_v1.PVZStatus_PVZ_STATUS_SUSPENDED,
+	entity.PointStatusClosed:    pvz_v1.PVZStatus_PVZ_STATUS_CLOSED,
+}
+
+var pointStatusFromProto = map[pvz_v1.PVZStatus]entity.PointStatus{
+	pvz_v1.PVZStatus_PVZ_STATUS_ACTIVE:    entity.PointStatusActive,
+	pvz_v1.PVZStatus_PVZ_STATUS_SUSPENDED: entity.PointStatusSuspended,
+	pvz_v1.PVZStatus_PVZ_STATUS_CLOSED:    entity.PointStatusClosed,
+}
+
+// maxPageSize is the largest page allowed in listings, the same as in REST API
+const maxPageSize = 30
+
+var receptionStatusToProto = map[entity.ReceptionStatus]pvz_v1.ReceptionStatus{
+	entity.ReceptionStatusInProgress: pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS,
+	entity.ReceptionStatusClosed:     pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED,
+}
+
+var productStatusToProto = map[entity.ProductStatus]pvz_v1.ProductStatus{
+	entity.ProductStatusReceived: pvz_v1.ProductStatus_PRODUCT_STATUS_RECEIVED,
+	entity.ProductStatusStored:   pvz_v1.ProductStatus_PRODUCT_STATUS_STORED,
+	entity.ProductStatusIssued:   pvz_v1.ProductStatus_PRODUCT_STATUS_ISSUED,
+	entity.ProductStatusReturned: pvz_v1.ProductStatus_PRODUCT_STATUS_RETURNED,
+}
+
 type PVZHandler struct {
 	pointService service.Point
 	pvz_v1.UnimplementedPVZServiceServer
@@ -21,18 +57,326 @@ func NewPVZHandler(pointService service.Point) *PVZHandler {
 }
 
 func (h *PVZHandler) GetPVZList(ctx context.Context, req *pvz_v1.GetPVZListRequest) (*pvz_v1.GetPVZListResponse, error) {
-	points, err := h.pointService.GetAll(ctx)
+	var filter *entity.PointStatus
+	if req.GetStatus() != pvz_v1.PVZStatus_PVZ_STATUS_UNSPECIFIED {
+		pointStatus, ok := pointStatusFromProto[req.GetStatus()]
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "unknown pvz status")
+		}
+		filter = &pointStatus
+	}
+
+	points, err := h.pointService.GetAll(ctx, filter)
 	if err != nil {
-		return nil, err
+		return nil, serviceError(err)
 	}
 
 	out := make([]*pvz_v1.PVZ, len(points))
 	for i, point := range points {
-		out[i] = &pvz_v1.PVZ{
-			Id:               point.ID.String(),
-			RegistrationDate: timestamppb.New(point.CreatedAt),
-			City:             point.City,
-		}
+		out[i] = pointToProto(point)
 	}
 	return &pvz_v1.GetPVZListResponse{Pvzs: out}, nil
 }
+
+func (h *PVZHandler) GetNearbyPVZ(ctx context.Context, req *pvz_v1.GetNearbyPVZRequest) (*pvz_v1.GetNearbyPVZResponse, error) {
+	switch {
+	case req.GetLatitude() < -90 || req.GetLatitude() > 90:
+		return nil, status.Error(codes.InvalidArgument, "latitude must be between -90 and 90")
+	case req.GetLongitude() < -180 || req.GetLongitude() > 180:
+		return nil, status.Error(codes.InvalidArgument, "longitude must be between -180 and 180")
+	case req.GetRadius() <= 0 || req.GetRadius() > 100000:
+		return nil, status.Error(codes.InvalidArgument, "radius must be greater than 0 and at most 100000 meters")
+	case req.GetLimit() < 0 || req.GetLimit() > maxPageSize:
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d, 0 means the default", maxPageSize)
+	}
+
+	var limit *int
+	if req.GetLimit() > 0 {
+		limit = lo.ToPtr(int(req.GetLimit()))
+	}
+
+	points, err := h.pointService.GetNearby(ctx, req.GetLatitude(), req.GetLongitude(), req.GetRadius(), limit)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	out := make([]*pvz_v1.NearbyPVZ, len(points))
+	for i, point := range points {
+		out[i] = &pvz_v1.NearbyPVZ{
+			Pvz:      pointToProto(point.Point),
+			Distance: point.Distance,
+		}
+	}
+	return &pvz_v1.GetNearbyPVZResponse{Pvzs: out}, nil
+}
+
+func (h *PVZHandler) CreatePVZ(ctx context.Context, req *pvz_v1.CreatePVZRequest) (*pvz_v1.PVZ, error) {
+	switch {
+	case req.GetCity() == "":
+		return nil, status.Error(codes.InvalidArgument, "field city is required")
+	case len(req.GetCity()) > 64:
+		return nil, status.Error(codes.InvalidArgument, "field city must be at most 64 characters")
+	case len(req.GetAddress()) > 255:
+		return nil, status.Error(codes.InvalidArgument, "field address must be at most 255 characters")
+	case req.Latitude == nil && req.Longitude != nil, req.Latitude != nil && req.Longitude == nil:
+		return nil, status.Error(codes.InvalidArgument, "fields latitude and longitude must be set together")
+	case req.Latitude != nil && (req.GetLatitude() < -90 || req.GetLatitude() > 90):
+		return nil, status.Error(codes.InvalidArgument, "latitude must be between -90 and 90")
+	case req.Longitude != nil && (req.GetLongitude() < -180 || req.GetLongitude() > 180):
+		return nil, status.Error(codes.InvalidArgument, "longitude must be between -180 and 180")
+	}
+
+	point, err := h.pointService.Create(ctx, entity.Point{
+		City:      req.GetCity(),
+		Address:   req.GetAddress(),
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		TimeZone:  req.GetTimeZone(),
+	})
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return pointToProto(point), nil
+}
+
+func (h *PVZHandler) GetPVZListExtended(ctx context.Context, req *pvz_v1.GetPVZListExtendedRequest) (*pvz_v1.GetPVZListExtendedResponse, error) {
+	filter, err := extendedListFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pagination := dto.Pagination{Cursor: req.GetCursor()}
+	if req.GetPage() > 0 {
+		pagination.Page = lo.ToPtr(int(req.GetPage()))
+	}
+	if req.GetLimit() > 0 {
+		pagination.Limit = lo.ToPtr(int(req.GetLimit()))
+	}
+
+	result, err := h.pointService.GetExtended(ctx, filter, pagination)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+
+	out := make([]*pvz_v1.PVZWithReceptions, len(result.Points))
+	for i, point := range result.Points {
+		out[i] = pointOutputToProto(point)
+	}
+	return &pvz_v1.GetPVZListExtendedResponse{Pvzs: out, NextCursor: result.NextCursor}, nil
+}
+
+func (h *PVZHandler) StreamPVZListExtended(req *pvz_v1.GetPVZListExtendedRequest, stream grpc.ServerStreamingServer[pvz_v1.PVZWithReceptions]) error {
+	filter, err := extendedListFilter(req)
+	if err != nil {
+		return err
+	}
+
+	pagination := dto.Pagination{
+		Page:   lo.ToPtr(max(int(req.GetPage()), service.DefaultPage)),
+		Limit:  lo.ToPtr(maxPageSize),
+		Cursor: req.GetCursor(),
+	}
+	if req.GetLimit() > 0 {
+		pagination.Limit = lo.ToPtr(int(req.GetLimit()))
+	}
+
+	// Only the first batch may start from a page number, the rest follow the cursor so points created
+	// during streaming neither repeat nor get skipped
+	for {
+		result, err := h.pointService.GetExtended(stream.Context(), filter, pagination)
+		if err != nil {
+			return serviceError(err)
+		}
+
+		for _, point := range result.Points {
+			if err = stream.Send(pointOutputToProto(point)); err != nil {
+				return err
+			}
+		}
+
+		if len(result.NextCursor) == 0 {
+			return nil
+		}
+		pagination.Cursor = result.NextCursor
+	}
+}
+
+func extendedListFilter(req *pvz_v1.GetPVZListExtendedRequest) (dto.PointFilter, error) {
+	switch {
+	case req.GetPage() < 0:
+		return dto.PointFilter{}, status.Error(codes.InvalidArgument, "page must not be negative, 0 means the first page")
+	case req.GetPage() > 0 && len(req.GetCursor()) > 0:
+		return dto.PointFilter{}, status.Error(codes.InvalidArgument, "page and cursor are mutually exclusive")
+	case req.GetLimit() < 0 || req.GetLimit() > maxPageSize:
+		return dto.PointFilter{}, status.Errorf(codes.InvalidArgument, "limit must be between 0 and %d, 0 means the default", maxPageSize)
+	case req.StartDate != nil && req.EndDate != nil && req.GetStartDate().AsTime().After(req.GetEndDate().AsTime()):
+		return dto.PointFilter{}, status.Error(codes.InvalidArgument, "start_date must not be after end_date")
+	}
+
+	var filter dto.PointFilter
+	if req.StartDate != nil {
+		filter.StartDate = lo.ToPtr(req.GetStartDate().AsTime())
+	}
+	if req.EndDate != nil {
+		filter.EndDate = lo.ToPtr(req.GetEndDate().AsTime())
+	}
+	if req.GetStatus() != pvz_v1.PVZStatus_PVZ_STATUS_UNSPECIFIED {
+		pointStatus, ok := pointStatusFromProto[req.GetStatus()]
+		if !ok {
+			return dto.PointFilter{}, status.Error(codes.InvalidArgument, "unknown pvz status")
+		}
+		filter.Status = &pointStatus
+	}
+
+	return filter, nil
+}
+
+func (h *PVZHandler) CloseLastReception(ctx context.Context, req *pvz_v1.CloseLastReceptionRequest) (*pvz_v1.Reception, error) {
+	pointID, err := parseUUID("pvz_id", req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := h.pointService.CloseLastReception(ctx, pointID)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return receptionToProto(reception), nil
+}
+
+func (h *PVZHandler) DeleteLastProduct(ctx context.Context, req *pvz_v1.DeleteLastProductRequest) (*emptypb.Empty, error) {
+	pointID, err := parseUUID("pvz_id", req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	// Product removed by a concurrent request is reported as success, same as in REST API
+	err = h.pointService.DeleteLastProduct(ctx, pointID)
+	if err != nil && !errors.Is(err, service.ErrProductAlreadyDeleted) {
+		return nil, serviceError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (h *PVZHandler) DeleteProduct(ctx context.Context, req *pvz_v1.DeleteProductRequest) (*emptypb.Empty, error) {
+	pointID, err := parseUUID("pvz_id", req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	productID, err := parseUUID("product_id", req.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = h.pointService.DeleteProduct(ctx, pointID, productID); err != nil {
+		return nil, serviceError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func parseUUID(field, value string) (uuid.UUID, error) {
+	if value == "" {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "field %s is required", field)
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Errorf(codes.InvalidArgument, "field %s must be a valid uuid", field)
+	}
+	return id, nil
+}
+
+func pointToProto(point entity.Point) *pvz_v1.PVZ {
+	return &pvz_v1.PVZ{
+		Id:               point.ID.String(),
+		RegistrationDate: timestamppb.New(point.CreatedAt),
+		City:             point.City,
+		Status:           pointStatusToProto[point.Status],
+		Address:          point.Address,
+		Latitude:         point.Latitude,
+		Longitude:        point.Longitude,
+		TimeZone:         point.TimeZone,
+	}
+}
+
+func pointOutputToProto(output dto.PointOutput) *pvz_v1.PVZWithReceptions {
+	receptions := make([]*pvz_v1.ReceptionWithProducts, len(output.Receptions))
+	for i, reception := range output.Receptions {
+		products := make([]*pvz_v1.Product, len(reception.Products))
+		for j, product := range reception.Products {
+			products[j] = &pvz_v1.Product{
+				Id:          product.ID.String(),
+				DateTime:    timestamppb.New(product.CreatedAt),
+				Type:        string(product.Type),
+				TypeNames:   product.TypeNames,
+				ItemCode:    product.ItemCode,
+				ReceptionId: product.ReceptionID.String(),
+				Status:      productStatusToProto[product.Status],
+			}
+		}
+
+		reopenings := make([]*pvz_v1.ReceptionReopening, len(reception.Reopenings))
+		for j, reopening := range reception.Reopenings {
+			reopenings[j] = &pvz_v1.ReceptionReopening{
+				Id:          reopening.ID.String(),
+				ReceptionId: reopening.ReceptionID.String(),
+				ReopenedBy:  reopening.ReopenedBy.String(),
+				Reason:      reopening.Reason,
+				ReopenedAt:  timestamppb.New(reopening.ReopenedAt),
+			}
+		}
+
+		receptions[i] = &pvz_v1.ReceptionWithProducts{
+			Reception: &pvz_v1.Reception{
+				Id:       reception.Reception.ID.String(),
+				DateTime: timestamppb.New(reception.Reception.CreatedAt),
+				PvzId:    reception.Reception.PointID.String(),
+				Status:   receptionStatusToProto[reception.Reception.Status],
+			},
+			Products:   products,
+			Reopenings: reopenings,
+		}
+	}
+
+	return &pvz_v1.PVZWithReceptions{
+		Pvz: &pvz_v1.PVZ{
+			Id:               output.Point.ID.String(),
+			RegistrationDate: timestamppb.New(output.Point.CreatedAt),
+			City:             output.Point.City,
+			Status:           pointStatusToProto[output.Point.Status],
+			Address:          output.Point.Address,
+			Latitude:         output.Point.Latitude,
+			Longitude:        output.Point.Longitude,
+			TimeZone:         output.Point.TimeZone,
+		},
+		ProductCounts: &pvz_v1.ProductCounts{
+			Received: int32(output.ProductCounts[entity.ProductStatusReceived]),
+			Stored:   int32(output.ProductCounts[entity.ProductStatusStored]),
+			Issued:   int32(output.ProductCounts[entity.ProductStatusIssued]),
+			Returned: int32(output.ProductCounts[entity.ProductStatusReturned]),
+		},
+		Receptions: receptions,
+	}
+}
+
+func receptionToProto(reception entity.Reception) *pvz_v1.Reception {
+	return &pvz_v1.Reception{
+		Id:       reception.ID.String(),
+		DateTime: timestamppb.New(reception.CreatedAt),
+		PvzId:    reception.PointID.String(),
+		Status:   receptionStatusToProto[reception.Status],
+	}
+}
+
+func productToProto(product entity.Product) *pvz_v1.Product {
+	return &pvz_v1.Product{
+		Id:          product.ID.String(),
+		DateTime:    timestamppb.New(product.CreatedAt),
+		Type:        string(product.Type),
+		TypeNames:   product.TypeNames,
+		ItemCode:    product.ItemCode,
+		ReceptionId: product.ReceptionID.String(),
+		Status:      productStatusToProto[product.Status],
+	}
+}
@@ -0,0 +1,32 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/spanwalla/pvz/internal/controller/grpc/pvz_v1"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+type ReceptionHandler struct {
+	receptionService service.Reception
+	pvz_v1.UnimplementedReceptionServiceServer
+}
+
+func NewReceptionHandler(receptionService service.Reception) *ReceptionHandler {
+	return &ReceptionHandler{
+		receptionService: receptionService,
+	}
+}
+
+func (h *ReceptionHandler) CreateReception(ctx context.Context, req *pvz_v1.CreateReceptionRequest) (*pvz_v1.Reception, error) {
+	pointID, err := parseUUID("pvz_id", req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	reception, err := h.receptionService.Create(ctx, pointID)
+	if err != nil {
+		return nil, serviceError(err)
+	}
+	return receptionToProto(reception), nil
+}
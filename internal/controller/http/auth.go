@@ -1,13 +1,15 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
-	"github.com/labstack/echo/v4"
 	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
 
 	"github.com/spanwalla/pvz/internal/controller/http/dto"
+	"github.com/spanwalla/pvz/internal/controller/http/mw"
 	"github.com/spanwalla/pvz/internal/entity"
 	"github.com/spanwalla/pvz/internal/service"
 )
@@ -16,94 +18,124 @@ type authRoutes struct {
 	authService service.Auth
 }
 
-type dummyLoginRequest struct {
-	Role entity.RoleType `json:"role" validate:"required,oneof=employee moderator"`
+func newAuthRoutes(authService service.Auth) *authRoutes {
+	return &authRoutes{authService}
 }
 
-type loginRequest struct {
-	Email    string `json:"email" validate:"required,email,max=255"`
-	Password string `json:"password" validate:"required,min=8,max=64"`
-}
+func (r *authRoutes) DummyLogin(ctx context.Context, request dto.DummyLoginRequestObject) (dto.DummyLoginResponseObject, error) {
+	token, err := r.authService.DummyLogin(ctx, entity.RoleType(request.Body.Role))
+	if err != nil {
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
 
-type registerRequest struct {
-	Email    string          `json:"email" validate:"required,email,max=255"`
-	Password string          `json:"password" validate:"required,min=8,max=64"`
-	Role     entity.RoleType `json:"role" validate:"required,oneof=employee moderator"`
+	return dto.DummyLogin200JSONResponse(token), nil
 }
 
-func newAuthRoutes(g *echo.Group, authService service.Auth) {
-	r := &authRoutes{authService}
+func (r *authRoutes) Register(ctx context.Context, request dto.RegisterRequestObject) (dto.RegisterResponseObject, error) {
+	user, err := r.authService.Register(ctx, string(request.Body.Email), request.Body.Password, entity.RoleType(request.Body.Role))
+	if err != nil {
+		if errors.Is(err, service.ErrUserAlreadyExists) {
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
 
-	g.POST("/dummyLogin", r.dummyLogin)
-	g.POST("/register", r.register)
-	g.POST("/login", r.login)
+	return dto.Register201JSONResponse{
+		Id:    &user.ID,
+		Email: openapi_types.Email(user.Email),
+		Role:  dto.UserRole(user.Role),
+	}, nil
 }
 
-func (r *authRoutes) dummyLogin(c echo.Context) error {
-	var req dummyLoginRequest
+func (r *authRoutes) Login(ctx context.Context, request dto.LoginRequestObject) (dto.LoginResponseObject, error) {
+	pair, err := r.authService.Login(ctx, string(request.Body.Email), request.Body.Password)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) || errors.Is(err, service.ErrWrongPassword) {
+			return nil, newHTTPError(http.StatusUnauthorized, err)
+		}
 
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+		return nil, newHTTPError(http.StatusInternalServerError, err)
 	}
 
-	if err := c.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
-	}
+	return dto.Login200JSONResponse(tokenPairToDTO(pair)), nil
+}
 
-	token, err := r.authService.DummyLogin(c.Request().Context(), req.Role)
+func (r *authRoutes) Refresh(ctx context.Context, request dto.RefreshRequestObject) (dto.RefreshResponseObject, error) {
+	pair, err := r.authService.Refresh(ctx, request.Body.RefreshToken)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, "internal server error")
+		if errors.Is(err, service.ErrInvalidRefreshToken) || errors.Is(err, service.ErrRefreshTokenReused) {
+			return nil, newHTTPError(http.StatusUnauthorized, err)
+		}
+
+		return nil, newHTTPError(http.StatusInternalServerError, err)
 	}
 
-	return c.JSON(http.StatusOK, token)
+	return dto.Refresh200JSONResponse(tokenPairToDTO(pair)), nil
 }
 
-func (r *authRoutes) register(c echo.Context) error {
-	var req registerRequest
-
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+func (r *authRoutes) Logout(ctx context.Context, request dto.LogoutRequestObject) (dto.LogoutResponseObject, error) {
+	claims, ok := mw.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, newHTTPError(http.StatusUnauthorized, mw.ErrInvalidAuthHeader)
 	}
 
-	if err := c.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	var refreshToken string
+	if request.Body != nil {
+		refreshToken = lo.FromPtr(request.Body.RefreshToken)
 	}
 
-	user, err := r.authService.Register(c.Request().Context(), req.Email, req.Password, req.Role)
+	err := r.authService.Logout(ctx, claims, refreshToken)
 	if err != nil {
-		if errors.Is(err, service.ErrUserAlreadyExists) {
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		switch {
+		case errors.Is(err, service.ErrInvalidRefreshToken):
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		case errors.Is(err, service.ErrCannotAcceptToken):
+			return nil, newHTTPError(http.StatusUnauthorized, err)
+		default:
+			return nil, newHTTPError(http.StatusInternalServerError, err)
 		}
-
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
 	}
 
-	return c.JSON(http.StatusCreated, dto.User{
-		Id:    &user.ID,
-		Email: openapi_types.Email(user.Email),
-		Role:  dto.UserRole(user.Role),
-	})
+	return dto.Logout200Response{}, nil
 }
 
-func (r *authRoutes) login(c echo.Context) error {
-	var req loginRequest
+func (r *authRoutes) RevokeUserSessions(ctx context.Context, request dto.RevokeUserSessionsRequestObject) (dto.RevokeUserSessionsResponseObject, error) {
+	err := r.authService.RevokeUserSessions(ctx, request.UserId)
+	if err != nil {
+		if errors.Is(err, service.ErrUserNotFound) {
+			return nil, newHTTPError(http.StatusNotFound, err)
+		}
 
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+		return nil, newHTTPError(http.StatusInternalServerError, err)
 	}
 
-	if err := c.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	return dto.RevokeUserSessions200Response{}, nil
+}
+
+func (r *authRoutes) GetJwks(ctx context.Context, _ dto.GetJwksRequestObject) (dto.GetJwksResponseObject, error) {
+	keys := r.authService.JWKS(ctx)
+
+	response := dto.GetJwks200JSONResponse{Keys: make([]dto.JWK, 0, len(keys))}
+	for _, key := range keys {
+		response.Keys = append(response.Keys, dto.JWK{
+			Kty: dto.JWKKty(key.KeyType),
+			Kid: key.KeyID,
+			Use: dto.JWKUse(key.Use),
+			Alg: dto.JWKAlg(key.Algorithm),
+			N:   lo.EmptyableToPtr(key.N),
+			E:   lo.EmptyableToPtr(key.E),
+			Crv: lo.EmptyableToPtr(dto.JWKCrv(key.Curve)),
+			X:   lo.EmptyableToPtr(key.X),
+		})
 	}
 
-	token, err := r.authService.Login(c.Request().Context(), req.Email, req.Password)
-	if err != nil {
-		if errors.Is(err, service.ErrUserNotFound) || errors.Is(err, service.ErrWrongPassword) {
-			return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
-		}
+	return response, nil
+}
 
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+func tokenPairToDTO(pair service.TokenPair) dto.TokenPair {
+	return dto.TokenPair{
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
 	}
-
-	return c.JSON(http.StatusOK, token)
 }
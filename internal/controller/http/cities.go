@@ -0,0 +1,86 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/samber/lo"
+
+	"github.com/spanwalla/pvz/internal/controller/http/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+type cityRoutes struct {
+	cityService service.City
+}
+
+func newCityRoutes(cityService service.City) *cityRoutes {
+	return &cityRoutes{cityService}
+}
+
+func (r *cityRoutes) ListCities(ctx context.Context, request dto.ListCitiesRequestObject) (dto.ListCitiesResponseObject, error) {
+	cities, err := r.cityService.GetAll(ctx, lo.FromPtr(request.Params.IncludeInactive))
+	if err != nil {
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	response := make(dto.ListCities200JSONResponse, 0, len(cities))
+	for _, city := range cities {
+		response = append(response, cityToDTO(city))
+	}
+
+	return response, nil
+}
+
+func (r *cityRoutes) CreateCity(ctx context.Context, request dto.CreateCityRequestObject) (dto.CreateCityResponseObject, error) {
+	city, err := r.cityService.Create(ctx, request.Body.Name)
+	if err != nil {
+		if errors.Is(err, service.ErrCityAlreadyExists) {
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return dto.CreateCity201JSONResponse(cityToDTO(city)), nil
+}
+
+func (r *cityRoutes) RenameCity(ctx context.Context, request dto.RenameCityRequestObject) (dto.RenameCityResponseObject, error) {
+	city, err := r.cityService.Rename(ctx, request.CityId, request.Body.Name)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrCityNotFound):
+			return nil, newHTTPError(http.StatusNotFound, err)
+		case errors.Is(err, service.ErrCityAlreadyExists):
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		default:
+			return nil, newHTTPError(http.StatusInternalServerError, err)
+		}
+	}
+
+	return dto.RenameCity200JSONResponse(cityToDTO(city)), nil
+}
+
+func (r *cityRoutes) DeactivateCity(ctx context.Context, request dto.DeactivateCityRequestObject) (dto.DeactivateCityResponseObject, error) {
+	city, err := r.cityService.Deactivate(ctx, request.CityId)
+	if err != nil {
+		if errors.Is(err, service.ErrCityNotFound) {
+			return nil, newHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return dto.DeactivateCity200JSONResponse(cityToDTO(city)), nil
+}
+
+func cityToDTO(city entity.City) dto.City {
+	return dto.City{
+		Id:        city.ID,
+		Name:      city.Name,
+		IsActive:  city.IsActive,
+		CreatedAt: &city.CreatedAt,
+	}
+}
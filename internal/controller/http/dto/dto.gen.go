@@ -21,24 +21,101 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for PVZCity.
+// Defines values for ErrorCode.
 const (
-	Казань         PVZCity = "Казань"
-	Москва         PVZCity = "Москва"
-	СанктПетербург PVZCity = "Санкт-Петербург"
+	CITYALREADYEXISTS           ErrorCode = "CITY_ALREADY_EXISTS"
+	CITYNOTFOUND                ErrorCode = "CITY_NOT_FOUND"
+	CLOSEDRECEPTIONNOTFOUND     ErrorCode = "CLOSED_RECEPTION_NOT_FOUND"
+	DUPLICATEITEMCODE           ErrorCode = "DUPLICATE_ITEM_CODE"
+	FORBIDDEN                   ErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYREUSED        ErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	IDEMPOTENTREQUESTINPROGRESS ErrorCode = "IDEMPOTENT_REQUEST_IN_PROGRESS"
+	INTERNALERROR               ErrorCode = "INTERNAL_ERROR"
+	INVALIDCURSOR               ErrorCode = "INVALID_CURSOR"
+	INVALIDOVERRIDE             ErrorCode = "INVALID_OVERRIDE"
+	INVALIDPRODUCTTRANSITION    ErrorCode = "INVALID_PRODUCT_TRANSITION"
+	INVALIDPVZTRANSITION        ErrorCode = "INVALID_PVZ_TRANSITION"
+	INVALIDREFRESHTOKEN         ErrorCode = "INVALID_REFRESH_TOKEN"
+	INVALIDREQUEST              ErrorCode = "INVALID_REQUEST"
+	INVALIDSCHEDULE             ErrorCode = "INVALID_SCHEDULE"
+	INVALIDTIMEZONE             ErrorCode = "INVALID_TIME_ZONE"
+	INVALIDTOKEN                ErrorCode = "INVALID_TOKEN"
+	INVALIDWEBHOOKURL           ErrorCode = "INVALID_WEBHOOK_URL"
+	METHODNOTALLOWED            ErrorCode = "METHOD_NOT_ALLOWED"
+	NOTFOUND                    ErrorCode = "NOT_FOUND"
+	PRODUCTALREADYDELETED       ErrorCode = "PRODUCT_ALREADY_DELETED"
+	PRODUCTALREADYSCANNED       ErrorCode = "PRODUCT_ALREADY_SCANNED"
+	PRODUCTNOTFOUND             ErrorCode = "PRODUCT_NOT_FOUND"
+	PRODUCTTYPEALREADYEXISTS    ErrorCode = "PRODUCT_TYPE_ALREADY_EXISTS"
+	PRODUCTTYPENAMEREQUIRED     ErrorCode = "PRODUCT_TYPE_NAME_REQUIRED"
+	PRODUCTTYPENOTFOUND         ErrorCode = "PRODUCT_TYPE_NOT_FOUND"
+	PVZCLOSED                   ErrorCode = "PVZ_CLOSED"
+	PVZNOTFOUND                 ErrorCode = "PVZ_NOT_FOUND"
+	PVZOUTSIDEWORKINGHOURS      ErrorCode = "PVZ_OUTSIDE_WORKING_HOURS"
+	PVZSUSPENDED                ErrorCode = "PVZ_SUSPENDED"
+	RECEPTIONALREADYOPENED      ErrorCode = "RECEPTION_ALREADY_OPENED"
+	RECEPTIONNOTACTIVE          ErrorCode = "RECEPTION_NOT_ACTIVE"
+	RECEPTIONNOTFOUND           ErrorCode = "RECEPTION_NOT_FOUND"
+	REFRESHTOKENREUSED          ErrorCode = "REFRESH_TOKEN_REUSED"
+	TOKENEXPIRED                ErrorCode = "TOKEN_EXPIRED"
+	TOKENREVOKED                ErrorCode = "TOKEN_REVOKED"
+	UNAUTHORIZED                ErrorCode = "UNAUTHORIZED"
+	USERALREADYEXISTS           ErrorCode = "USER_ALREADY_EXISTS"
+	USERNOTFOUND                ErrorCode = "USER_NOT_FOUND"
+	VALIDATIONFAILED            ErrorCode = "VALIDATION_FAILED"
+	WEBHOOKNOTFOUND             ErrorCode = "WEBHOOK_NOT_FOUND"
+	WRONGPASSWORD               ErrorCode = "WRONG_PASSWORD"
 )
 
-// Defines values for ProductType.
+// Defines values for EventType.
 const (
-	ProductTypeОбувь       ProductType = "обувь"
-	ProductTypeОдежда      ProductType = "одежда"
-	ProductTypeЭлектроника ProductType = "электроника"
+	ProductAdded     EventType = "product_added"
+	ProductDeleted   EventType = "product_deleted"
+	ReceptionClosed  EventType = "reception_closed"
+	ReceptionCreated EventType = "reception_created"
+)
+
+// Defines values for JWKAlg.
+const (
+	EdDSA JWKAlg = "EdDSA"
+	RS256 JWKAlg = "RS256"
+)
+
+// Defines values for JWKCrv.
+const (
+	Ed25519 JWKCrv = "Ed25519"
+)
+
+// Defines values for JWKKty.
+const (
+	OKP JWKKty = "OKP"
+	RSA JWKKty = "RSA"
+)
+
+// Defines values for JWKUse.
+const (
+	Sig JWKUse = "sig"
+)
+
+// Defines values for PVZStatus.
+const (
+	PVZStatusActive    PVZStatus = "active"
+	PVZStatusClosed    PVZStatus = "closed"
+	PVZStatusSuspended PVZStatus = "suspended"
+)
+
+// Defines values for ProductStatus.
+const (
+	Issued   ProductStatus = "issued"
+	Received ProductStatus = "received"
+	Returned ProductStatus = "returned"
+	Stored   ProductStatus = "stored"
 )
 
 // Defines values for ReceptionStatus.
 const (
-	Close      ReceptionStatus = "close"
-	InProgress ReceptionStatus = "in_progress"
+	ReceptionStatusClose      ReceptionStatus = "close"
+	ReceptionStatusInProgress ReceptionStatus = "in_progress"
 )
 
 // Defines values for UserRole.
@@ -47,50 +124,225 @@ const (
 	UserRoleModerator UserRole = "moderator"
 )
 
-// Defines values for PostDummyLoginJSONBodyRole.
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDead      WebhookDeliveryStatus = "dead"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+)
+
+// Defines values for DummyLoginJSONBodyRole.
+const (
+	DummyLoginJSONBodyRoleEmployee  DummyLoginJSONBodyRole = "employee"
+	DummyLoginJSONBodyRoleModerator DummyLoginJSONBodyRole = "moderator"
+)
+
+// Defines values for ListPvzParamsStatus.
+const (
+	ListPvzParamsStatusActive    ListPvzParamsStatus = "active"
+	ListPvzParamsStatusClosed    ListPvzParamsStatus = "closed"
+	ListPvzParamsStatusSuspended ListPvzParamsStatus = "suspended"
+)
+
+// Defines values for ListPvzParamsReceptionStatus.
 const (
-	PostDummyLoginJSONBodyRoleEmployee  PostDummyLoginJSONBodyRole = "employee"
-	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
+	ListPvzParamsReceptionStatusClose      ListPvzParamsReceptionStatus = "close"
+	ListPvzParamsReceptionStatusInProgress ListPvzParamsReceptionStatus = "in_progress"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for ListPvzParamsSort.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	ListPvzParamsSortCity           ListPvzParamsSort = "city"
+	ListPvzParamsSortCreatedAt      ListPvzParamsSort = "createdAt"
+	ListPvzParamsSortMinusCity      ListPvzParamsSort = "-city"
+	ListPvzParamsSortMinusCreatedAt ListPvzParamsSort = "-createdAt"
 )
 
-// Defines values for PostRegisterJSONBodyRole.
+// Defines values for RegisterJSONBodyRole.
 const (
-	Employee  PostRegisterJSONBodyRole = "employee"
-	Moderator PostRegisterJSONBodyRole = "moderator"
+	Employee  RegisterJSONBodyRole = "employee"
+	Moderator RegisterJSONBodyRole = "moderator"
 )
 
+// Defines values for ListWebhookDeliveriesParamsStatus.
+const (
+	ListWebhookDeliveriesParamsStatusDead      ListWebhookDeliveriesParamsStatus = "dead"
+	ListWebhookDeliveriesParamsStatusDelivered ListWebhookDeliveriesParamsStatus = "delivered"
+	ListWebhookDeliveriesParamsStatusPending   ListWebhookDeliveriesParamsStatus = "pending"
+)
+
+// City defines model for City.
+type City struct {
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	Id        int        `json:"id"`
+	IsActive  bool       `json:"isActive"`
+	Name      string     `json:"name"`
+}
+
 // Error defines model for Error.
 type Error struct {
-	Message string `json:"message"`
+	// Code Стабильный код ошибки, по которому клиенту следует различать ошибки
+	Code ErrorCode `json:"code"`
+
+	// Details Подробности, например список невалидных полей
+	Details *[]ErrorDetail `json:"details,omitempty"`
+	Message string         `json:"message"`
+}
+
+// ErrorCode Стабильный код ошибки, по которому клиенту следует различать ошибки
+type ErrorCode string
+
+// ErrorDetail defines model for ErrorDetail.
+type ErrorDetail struct {
+	Field   *string `json:"field,omitempty"`
+	Message string  `json:"message"`
+}
+
+// Event Событие приемки, отправляемое после фиксации изменения. Событие может быть доставлено повторно, повторы распознаются по id.
+type Event struct {
+	City       string             `json:"city"`
+	Id         openapi_types.UUID `json:"id"`
+	OccurredAt time.Time          `json:"occurredAt"`
+
+	// ProductId Заполняется только для событий товаров
+	ProductId   *openapi_types.UUID `json:"productId,omitempty"`
+	PvzId       openapi_types.UUID  `json:"pvzId"`
+	ReceptionId openapi_types.UUID  `json:"receptionId"`
+	Type        EventType           `json:"type"`
+}
+
+// EventType defines model for EventType.
+type EventType string
+
+// Holiday Исключение из недельного расписания; без времени открытия и закрытия ПВЗ не работает весь день
+type Holiday struct {
+	ClosesAt *string `json:"closesAt,omitempty"`
+
+	// Date Дата по местному времени ПВЗ
+	Date    string  `json:"date"`
+	OpensAt *string `json:"opensAt,omitempty"`
+}
+
+// JWK Открытый ключ для проверки токенов (RFC 7517), для Ed25519 — RFC 8037
+type JWK struct {
+	Alg JWKAlg  `json:"alg"`
+	Crv *JWKCrv `json:"crv,omitempty"`
+
+	// E Экспонента RSA-ключа, base64url
+	E   *string `json:"e,omitempty"`
+	Kid string  `json:"kid"`
+	Kty JWKKty  `json:"kty"`
+
+	// N Модуль RSA-ключа, base64url
+	N   *string `json:"n,omitempty"`
+	Use JWKUse  `json:"use"`
+
+	// X Открытый ключ Ed25519, base64url
+	X *string `json:"x,omitempty"`
+}
+
+// JWKAlg defines model for JWK.Alg.
+type JWKAlg string
+
+// JWKCrv defines model for JWK.Crv.
+type JWKCrv string
+
+// JWKKty defines model for JWK.Kty.
+type JWKKty string
+
+// JWKUse defines model for JWK.Use.
+type JWKUse string
+
+// JWKS defines model for JWKS.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// NearbyPVZ defines model for NearbyPVZ.
+type NearbyPVZ struct {
+	// Distance Расстояние до ПВЗ в метрах
+	Distance float64 `json:"distance"`
+	Pvz      PVZ     `json:"pvz"`
 }
 
 // PVZ defines model for PVZ.
 type PVZ struct {
-	City             PVZCity             `json:"city"`
+	Address          *string             `json:"address,omitempty"`
+	City             string              `json:"city"`
 	Id               *openapi_types.UUID `json:"id,omitempty"`
+	Latitude         *float64            `json:"latitude,omitempty"`
+	Longitude        *float64            `json:"longitude,omitempty"`
 	RegistrationDate *time.Time          `json:"registrationDate,omitempty"`
+	Status           *PVZStatus          `json:"status,omitempty"`
+
+	// TimeZone Часовой пояс ПВЗ в формате IANA (по умолчанию Europe/Moscow)
+	TimeZone *string `json:"timeZone,omitempty"`
 }
 
-// PVZCity defines model for PVZ.City.
-type PVZCity string
+// PVZStatus defines model for PVZ.Status.
+type PVZStatus string
+
+// PVZReception defines model for PVZReception.
+type PVZReception struct {
+	Products   []Product            `json:"products"`
+	Reception  Reception            `json:"reception"`
+	Reopenings []ReceptionReopening `json:"reopenings"`
+}
+
+// PVZWithReceptions defines model for PVZWithReceptions.
+type PVZWithReceptions struct {
+	// ProductCounts Количество товаров ПВЗ в каждом статусе
+	ProductCounts ProductCounts  `json:"productCounts"`
+	Pvz           PVZ            `json:"pvz"`
+	Receptions    []PVZReception `json:"receptions"`
+}
 
 // Product defines model for Product.
 type Product struct {
-	DateTime    *time.Time          `json:"dateTime,omitempty"`
-	Id          *openapi_types.UUID `json:"id,omitempty"`
-	ReceptionId openapi_types.UUID  `json:"receptionId"`
-	Type        ProductType         `json:"type"`
+	DateTime *time.Time          `json:"dateTime,omitempty"`
+	Id       *openapi_types.UUID `json:"id,omitempty"`
+
+	// ItemCode Штрихкод или трек-номер товара
+	ItemCode    string             `json:"itemCode"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+
+	// Status Статус товара (received → stored → issued или returned)
+	Status *ProductStatus `json:"status,omitempty"`
+
+	// Type Код типа товара из справочника
+	Type string `json:"type"`
+
+	// TypeName Название типа товара на языке из заголовка Accept-Language
+	TypeName *string `json:"typeName,omitempty"`
+}
+
+// ProductStatus Статус товара (received → stored → issued или returned)
+type ProductStatus string
+
+// ProductCounts Количество товаров ПВЗ в каждом статусе
+type ProductCounts struct {
+	Issued   int `json:"issued"`
+	Received int `json:"received"`
+	Returned int `json:"returned"`
+	Stored   int `json:"stored"`
+}
+
+// ProductLookup defines model for ProductLookup.
+type ProductLookup struct {
+	Product   Product   `json:"product"`
+	Pvz       PVZ       `json:"pvz"`
+	Reception Reception `json:"reception"`
 }
 
-// ProductType defines model for Product.Type.
-type ProductType string
+// ProductType defines model for ProductType.
+type ProductType struct {
+	Code      string     `json:"code"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	IsActive  bool       `json:"isActive"`
+
+	// Names Названия типа товара по языкам, обязательно наличие языка по умолчанию (ru)
+	Names map[string]string `json:"names"`
+}
 
 // Reception defines model for Reception.
 type Reception struct {
@@ -103,9 +355,46 @@ type Reception struct {
 // ReceptionStatus defines model for Reception.Status.
 type ReceptionStatus string
 
+// ReceptionReopening defines model for ReceptionReopening.
+type ReceptionReopening struct {
+	Id          openapi_types.UUID `json:"id"`
+	Reason      string             `json:"reason"`
+	ReceptionId openapi_types.UUID `json:"receptionId"`
+	ReopenedAt  time.Time          `json:"reopenedAt"`
+	ReopenedBy  openapi_types.UUID `json:"reopenedBy"`
+}
+
+// ReceptionWithProducts defines model for ReceptionWithProducts.
+type ReceptionWithProducts struct {
+	Products  []Product `json:"products"`
+	Reception Reception `json:"reception"`
+}
+
+// Schedule Расписание ПВЗ; если рабочие часы не заданы, ПВЗ считается круглосуточным
+type Schedule struct {
+	Holidays []Holiday `json:"holidays"`
+
+	// OverrideUntil До этого момента приемки и товары разрешены вне рабочих часов
+	OverrideUntil *time.Time     `json:"overrideUntil,omitempty"`
+	TimeZone      string         `json:"timeZone"`
+	WorkingHours  []WorkingHours `json:"workingHours"`
+}
+
+// ScheduleOverride defines model for ScheduleOverride.
+type ScheduleOverride struct {
+	Until time.Time `json:"until"`
+}
+
 // Token defines model for Token.
 type Token = string
 
+// TokenPair defines model for TokenPair.
+type TokenPair struct {
+	// RefreshToken Одноразовый токен для получения новой пары, повторное использование отзывает всю сессию
+	RefreshToken string `json:"refreshToken"`
+	Token        Token  `json:"token"`
+}
+
 // User defines model for User.
 type User struct {
 	Email openapi_types.Email `json:"email"`
@@ -116,108 +405,595 @@ type User struct {
 // UserRole defines model for User.Role.
 type UserRole string
 
-// PostDummyLoginJSONBody defines parameters for PostDummyLogin.
-type PostDummyLoginJSONBody struct {
-	Role PostDummyLoginJSONBodyRole `json:"role"`
+// Webhook Подписка на события. Запрос с событием подписывается заголовком X-Webhook-Signature: sha256=<hex HMAC-SHA256 строки "<X-Webhook-Timestamp>.<тело>" по секрету>.
+type Webhook struct {
+	// City Только события ПВЗ в указанном городе
+	City       *string            `json:"city,omitempty"`
+	CreatedAt  time.Time          `json:"createdAt"`
+	EventTypes []EventType        `json:"eventTypes"`
+	Id         openapi_types.UUID `json:"id"`
+
+	// PvzId Только события указанного ПВЗ
+	PvzId *openapi_types.UUID `json:"pvzId,omitempty"`
+	Url   string              `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int        `json:"attempts"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+
+	// EventId Совпадает с заголовком X-Webhook-Id и не меняется между попытками
+	EventId       openapi_types.UUID `json:"eventId"`
+	EventType     EventType          `json:"eventType"`
+	Id            openapi_types.UUID `json:"id"`
+	LastError     *string            `json:"lastError,omitempty"`
+	NextAttemptAt time.Time          `json:"nextAttemptAt"`
+
+	// ResponseStatus HTTP статус ответа на последнюю попытку
+	ResponseStatus *int                  `json:"responseStatus,omitempty"`
+	Status         WebhookDeliveryStatus `json:"status"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WorkingHours defines model for WorkingHours.
+type WorkingHours struct {
+	// ClosesAt Время закрытия по местному времени ПВЗ (не включительно)
+	ClosesAt string `json:"closesAt"`
+
+	// OpensAt Время открытия по местному времени ПВЗ
+	OpensAt string `json:"opensAt"`
+
+	// Weekday День недели по ISO 8601 (1 — понедельник, 7 — воскресенье)
+	Weekday int `json:"weekday"`
+}
+
+// IdempotencyKey defines model for IdempotencyKey.
+type IdempotencyKey = string
+
+// ListCitiesParams defines parameters for ListCities.
+type ListCitiesParams struct {
+	// IncludeInactive Включать деактивированные города
+	IncludeInactive *bool `form:"includeInactive,omitempty" json:"includeInactive,omitempty"`
+}
+
+// CreateCityJSONBody defines parameters for CreateCity.
+type CreateCityJSONBody struct {
+	Name string `json:"name"`
+}
+
+// CreateCityParams defines parameters for CreateCity.
+type CreateCityParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RenameCityJSONBody defines parameters for RenameCity.
+type RenameCityJSONBody struct {
+	Name string `json:"name"`
 }
 
-// PostDummyLoginJSONBodyRole defines parameters for PostDummyLogin.
-type PostDummyLoginJSONBodyRole string
+// DeactivateCityParams defines parameters for DeactivateCity.
+type DeactivateCityParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DummyLoginJSONBody defines parameters for DummyLogin.
+type DummyLoginJSONBody struct {
+	Role DummyLoginJSONBodyRole `json:"role"`
+}
+
+// DummyLoginJSONBodyRole defines parameters for DummyLogin.
+type DummyLoginJSONBodyRole string
+
+// WatchEventsParams defines parameters for WatchEvents.
+type WatchEventsParams struct {
+	// PvzId Только события указанного ПВЗ
+	PvzId *openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
+
+	// City Только события ПВЗ в указанном городе
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+}
 
-// PostLoginJSONBody defines parameters for PostLogin.
-type PostLoginJSONBody struct {
+// LoginJSONBody defines parameters for Login.
+type LoginJSONBody struct {
 	Email    openapi_types.Email `json:"email"`
 	Password string              `json:"password"`
 }
 
-// PostProductsJSONBody defines parameters for PostProducts.
-type PostProductsJSONBody struct {
-	PvzId openapi_types.UUID       `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
+// LogoutJSONBody defines parameters for Logout.
+type LogoutJSONBody struct {
+	RefreshToken *string `json:"refreshToken,omitempty"`
 }
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+// ListProductTypesParams defines parameters for ListProductTypes.
+type ListProductTypesParams struct {
+	// IncludeInactive Включать деактивированные типы товаров
+	IncludeInactive *bool `form:"includeInactive,omitempty" json:"includeInactive,omitempty"`
+}
+
+// CreateProductTypeJSONBody defines parameters for CreateProductType.
+type CreateProductTypeJSONBody struct {
+	Code string `json:"code"`
+
+	// Names Названия по двухбуквенным кодам языков
+	Names map[string]string `json:"names"`
+}
 
-// GetPvzParams defines parameters for GetPvz.
-type GetPvzParams struct {
+// CreateProductTypeParams defines parameters for CreateProductType.
+type CreateProductTypeParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// UpdateProductTypeNamesJSONBody defines parameters for UpdateProductTypeNames.
+type UpdateProductTypeNamesJSONBody struct {
+	// Names Названия по двухбуквенным кодам языков
+	Names map[string]string `json:"names"`
+}
+
+// ActivateProductTypeParams defines parameters for ActivateProductType.
+type ActivateProductTypeParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeactivateProductTypeParams defines parameters for DeactivateProductType.
+type DeactivateProductTypeParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateProductJSONBody defines parameters for CreateProduct.
+type CreateProductJSONBody struct {
+	// ItemCode Штрихкод или трек-номер товара
+	ItemCode string             `json:"itemCode"`
+	PvzId    openapi_types.UUID `json:"pvzId"`
+
+	// Type Код активного типа товара из справочника, прежние значения электроника, одежда и обувь принимаются для старых клиентов
+	Type string `json:"type"`
+}
+
+// CreateProductParams defines parameters for CreateProduct.
+type CreateProductParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// AcceptLanguage Язык названия типа товара (по умолчанию ru)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// CreateProductBatchJSONBody defines parameters for CreateProductBatch.
+type CreateProductBatchJSONBody struct {
+	Products []struct {
+		// ItemCode Штрихкод или трек-номер товара, уникальный в пределах запроса
+		ItemCode string `json:"itemCode"`
+
+		// Type Код активного типа товара из справочника, прежние значения электроника, одежда и обувь принимаются для старых клиентов
+		Type string `json:"type"`
+	} `json:"products"`
+	PvzId openapi_types.UUID `json:"pvzId"`
+}
+
+// CreateProductBatchParams defines parameters for CreateProductBatch.
+type CreateProductBatchParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// AcceptLanguage Язык названия типа товара (по умолчанию ru)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// GetProductByCodeParams defines parameters for GetProductByCode.
+type GetProductByCodeParams struct {
+	// AcceptLanguage Язык названия типа товара (по умолчанию ru)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// GetProductParams defines parameters for GetProduct.
+type GetProductParams struct {
+	// AcceptLanguage Язык названия типа товара (по умолчанию ru)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// IssueProductParams defines parameters for IssueProduct.
+type IssueProductParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// AcceptLanguage Язык названия типа товара (по умолчанию ru)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// ReturnProductParams defines parameters for ReturnProduct.
+type ReturnProductParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+
+	// AcceptLanguage Язык названия типа товара (по умолчанию ru)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// ListPvzParams defines parameters for ListPvz.
+type ListPvzParams struct {
 	// StartDate Начальная дата диапазона
 	StartDate *time.Time `form:"startDate,omitempty" json:"startDate,omitempty"`
 
 	// EndDate Конечная дата диапазона
 	EndDate *time.Time `form:"endDate,omitempty" json:"endDate,omitempty"`
 
-	// Page Номер страницы
+	// Page Номер страницы (устаревший способ, нельзя передавать вместе с cursor)
 	Page *int `form:"page,omitempty" json:"page,omitempty"`
 
+	// Cursor Курсор из заголовка X-Next-Cursor предыдущего ответа; страницы не сдвигаются при добавлении ПВЗ
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
 	// Limit Количество элементов на странице
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Status Статус ПВЗ
+	Status *ListPvzParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// City Город ПВЗ
+	City *string `form:"city,omitempty" json:"city,omitempty"`
+
+	// PvzId Идентификаторы ПВЗ (параметр можно повторять)
+	PvzId *[]openapi_types.UUID `form:"pvzId,omitempty" json:"pvzId,omitempty"`
+
+	// ReceptionStatus Только ПВЗ с приемкой в этом статусе; в ответе остаются только такие приемки
+	ReceptionStatus *ListPvzParamsReceptionStatus `form:"receptionStatus,omitempty" json:"receptionStatus,omitempty"`
+
+	// ProductType Только ПВЗ с приемкой, содержащей товар этого типа; вместе с receptionStatus условия относятся к одной приемке
+	ProductType *string `form:"productType,omitempty" json:"productType,omitempty"`
+
+	// Sort Порядок сортировки, минус означает обратный порядок; курсор действителен только для того же порядка
+	Sort *ListPvzParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// AcceptLanguage Язык названий типов товаров (по умолчанию ru)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
 }
 
-// PostReceptionsJSONBody defines parameters for PostReceptions.
-type PostReceptionsJSONBody struct {
+// ListPvzParamsStatus defines parameters for ListPvz.
+type ListPvzParamsStatus string
+
+// ListPvzParamsReceptionStatus defines parameters for ListPvz.
+type ListPvzParamsReceptionStatus string
+
+// ListPvzParamsSort defines parameters for ListPvz.
+type ListPvzParamsSort string
+
+// CreatePvzParams defines parameters for CreatePvz.
+type CreatePvzParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ListNearbyPvzParams defines parameters for ListNearbyPvz.
+type ListNearbyPvzParams struct {
+	// Lat Широта
+	Lat float64 `form:"lat" json:"lat"`
+
+	// Lon Долгота
+	Lon float64 `form:"lon" json:"lon"`
+
+	// Radius Радиус поиска в метрах
+	Radius float64 `form:"radius" json:"radius"`
+
+	// Limit Максимальное количество ПВЗ в ответе
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ClosePvzParams defines parameters for ClosePvz.
+type ClosePvzParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CloseLastReceptionParams defines parameters for CloseLastReception.
+type CloseLastReceptionParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// DeleteLastProductParams defines parameters for DeleteLastProduct.
+type DeleteLastProductParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetActiveReceptionParams defines parameters for GetActiveReception.
+type GetActiveReceptionParams struct {
+	// AcceptLanguage Язык названия типа товара (по умолчанию ru)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// ResumePvzParams defines parameters for ResumePvz.
+type ResumePvzParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// SetScheduleOverrideParams defines parameters for SetScheduleOverride.
+type SetScheduleOverrideParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// SuspendPvzParams defines parameters for SuspendPvz.
+type SuspendPvzParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// CreateReceptionJSONBody defines parameters for CreateReception.
+type CreateReceptionJSONBody struct {
 	PvzId openapi_types.UUID `json:"pvzId"`
 }
 
-// PostRegisterJSONBody defines parameters for PostRegister.
-type PostRegisterJSONBody struct {
-	Email    openapi_types.Email      `json:"email"`
-	Password string                   `json:"password"`
-	Role     PostRegisterJSONBodyRole `json:"role"`
+// CreateReceptionParams defines parameters for CreateReception.
+type CreateReceptionParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// GetReceptionParams defines parameters for GetReception.
+type GetReceptionParams struct {
+	// AcceptLanguage Язык названия типа товара (по умолчанию ru)
+	AcceptLanguage *string `json:"Accept-Language,omitempty"`
+}
+
+// ReopenReceptionJSONBody defines parameters for ReopenReception.
+type ReopenReceptionJSONBody struct {
+	Reason string `json:"reason"`
 }
 
-// PostRegisterJSONBodyRole defines parameters for PostRegister.
-type PostRegisterJSONBodyRole string
+// ReopenReceptionParams defines parameters for ReopenReception.
+type ReopenReceptionParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// RefreshJSONBody defines parameters for Refresh.
+type RefreshJSONBody struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+// RegisterJSONBody defines parameters for Register.
+type RegisterJSONBody struct {
+	Email    openapi_types.Email  `json:"email"`
+	Password string               `json:"password"`
+	Role     RegisterJSONBodyRole `json:"role"`
+}
+
+// RegisterJSONBodyRole defines parameters for Register.
+type RegisterJSONBodyRole string
+
+// CreateWebhookJSONBody defines parameters for CreateWebhook.
+type CreateWebhookJSONBody struct {
+	City       *string             `json:"city,omitempty"`
+	EventTypes []EventType         `json:"eventTypes"`
+	PvzId      *openapi_types.UUID `json:"pvzId,omitempty"`
+
+	// Secret Ключ подписи запросов, в ответах не возвращается
+	Secret string `json:"secret"`
+
+	// Url Адрес http или https; должен разрешаться только в публичные IP-адреса
+	Url string `json:"url"`
+}
+
+// CreateWebhookParams defines parameters for CreateWebhook.
+type CreateWebhookParams struct {
+	// IdempotencyKey Ключ идемпотентности. Повторный запрос с тем же ключом возвращает первый ответ с заголовком Idempotent-Replayed: true. Повтор ключа с другим запросом отклоняется с кодом 422, повтор во время обработки первого запроса — с кодом 409.
+	IdempotencyKey *IdempotencyKey `json:"Idempotency-Key,omitempty"`
+}
+
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	Status *ListWebhookDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit  *int                               `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListWebhookDeliveriesParamsStatus defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParamsStatus string
+
+// CreateCityJSONRequestBody defines body for CreateCity for application/json ContentType.
+type CreateCityJSONRequestBody CreateCityJSONBody
+
+// RenameCityJSONRequestBody defines body for RenameCity for application/json ContentType.
+type RenameCityJSONRequestBody RenameCityJSONBody
+
+// DummyLoginJSONRequestBody defines body for DummyLogin for application/json ContentType.
+type DummyLoginJSONRequestBody DummyLoginJSONBody
+
+// LoginJSONRequestBody defines body for Login for application/json ContentType.
+type LoginJSONRequestBody LoginJSONBody
+
+// LogoutJSONRequestBody defines body for Logout for application/json ContentType.
+type LogoutJSONRequestBody LogoutJSONBody
+
+// CreateProductTypeJSONRequestBody defines body for CreateProductType for application/json ContentType.
+type CreateProductTypeJSONRequestBody CreateProductTypeJSONBody
+
+// UpdateProductTypeNamesJSONRequestBody defines body for UpdateProductTypeNames for application/json ContentType.
+type UpdateProductTypeNamesJSONRequestBody UpdateProductTypeNamesJSONBody
+
+// CreateProductJSONRequestBody defines body for CreateProduct for application/json ContentType.
+type CreateProductJSONRequestBody CreateProductJSONBody
+
+// CreateProductBatchJSONRequestBody defines body for CreateProductBatch for application/json ContentType.
+type CreateProductBatchJSONRequestBody CreateProductBatchJSONBody
+
+// CreatePvzJSONRequestBody defines body for CreatePvz for application/json ContentType.
+type CreatePvzJSONRequestBody = PVZ
+
+// UpdateScheduleJSONRequestBody defines body for UpdateSchedule for application/json ContentType.
+type UpdateScheduleJSONRequestBody = Schedule
 
-// PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
-type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
+// SetScheduleOverrideJSONRequestBody defines body for SetScheduleOverride for application/json ContentType.
+type SetScheduleOverrideJSONRequestBody = ScheduleOverride
 
-// PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
-type PostLoginJSONRequestBody PostLoginJSONBody
+// CreateReceptionJSONRequestBody defines body for CreateReception for application/json ContentType.
+type CreateReceptionJSONRequestBody CreateReceptionJSONBody
 
-// PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
-type PostProductsJSONRequestBody PostProductsJSONBody
+// ReopenReceptionJSONRequestBody defines body for ReopenReception for application/json ContentType.
+type ReopenReceptionJSONRequestBody ReopenReceptionJSONBody
 
-// PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
-type PostPvzJSONRequestBody = PVZ
+// RefreshJSONRequestBody defines body for Refresh for application/json ContentType.
+type RefreshJSONRequestBody RefreshJSONBody
 
-// PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
-type PostReceptionsJSONRequestBody PostReceptionsJSONBody
+// RegisterJSONRequestBody defines body for Register for application/json ContentType.
+type RegisterJSONRequestBody RegisterJSONBody
 
-// PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
-type PostRegisterJSONRequestBody PostRegisterJSONBody
+// CreateWebhookJSONRequestBody defines body for CreateWebhook for application/json ContentType.
+type CreateWebhookJSONRequestBody CreateWebhookJSONBody
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZ724TSRJ/lVHffeCkASfHffK3u+M4cUK6iONYCRShwe44A54/9LSza5Cl2N6FRWSX",
-	"1QoJCS1iWV5gcGJinHjyCtVvtKrqGXvGHsdOYmUNn2zPVHdXVf+q6lflx6zkOb7nclcGrPiYBaVN7lj0",
-	"9V9CeAK/+MLzuZA2p8cODwKrwvGrrPucFVkghe1WWKNhMsEf1mzBy6x4Zyi4biaC3r37vCRZw2Rrt25P",
-	"7lyyZR0/uVtzcAP4BSLVhD50IGQmg3cQwgD6qnUR3kJXtaCrtuGDaqtt2MX3ryGEfZRRO6lDE+1MZpdx",
-	"9w1POJZkRVar2WWWIyZ4xQ6ksKTtuVcsyTOLypbkF6Xt8MmVY+aTNbm2C69cK8lJ+3Hvm7j1nAeewKIS",
-	"99Gca/PJ6weji1A/wAF00fNqGyIYQA/6+koi2IMufIS95OcH1YZOrv/H3ENvs6rlOetG8v4c3eVvPZrT",
-	"UYG0ZC1Iu8p27/rCqwgeBMxkpaoX8Nm+GFqSnD3cOc8lN70H3M0JP5P9P+A5Acsdy65mzNFPzoAnr5rB",
-	"B3f8qlfnqL/jlbmwpCdmW51oQbtNGoru5aWasGX9f5iUtDH3uCW4+HtNbo5+XU30/c9XN9F1JM2K8duR",
-	"AZtS+qyBG9vuhkcQ4kFJ2DG+MMFgRulATzUN2IMD9cJQbThS2xBCh0JgAD31woC38DO8MqBn0MsedOEQ",
-	"+hDBJ0O1IMJ8RYHSwbNtWSVlrNID7paNgIstu4Su2uIi0AevXlq5tIKO9XzuWr7NiuwyPTKZb8lNMrxQ",
-	"rjlO/bpXsXUoeAFlELxoKwlttuYF8spITvubB/IfXpkya8lzJXdpoeX7VbtESwv3Ax1fOvlPImgx9z3t",
-	"njNiUtQ4PQh8zw308X9dWTmR8n8WfIMV2Z8Ko9JWiOtaQQcPHTp2+e9VE46gq76HAYR4ySF08Dbpgvch",
-	"VE/w7vGW/rZAfXSRzdPnDXShQ4AcqOfwyaDShnCLVFNHR81xLFFH2bcQwYFqq6caotA1qDo2YzRGsAuR",
-	"hmafJELaoFCdjabFAukEqci3guBrT5Rn84xki+GKLwNjq+eOsa6hIaRa8U8s6zDQP8Yh91Oe5gYcERJ3",
-	"YD9Ogy3oYh7VePM18wmOh9xaIrUo1M1fz8+R+GilTgfVxUEj4aJ54PgtqWSIgwg+jIrgciRBA3pwgDV4",
-	"gJDFWOqrFvSgAwMqxZna3NM6Xz4HnV+icqqFzGGkb1c9055L0RpWvJMlNHfWG+uZIHuZ9XuS2ROGERrQ",
-	"oUwPfdVWz1Rb/ZixWrWNCySOEdmHaEhqmhAhpFUb9mJQR9CJac1f4ljdeoQuqPCcKP03l2tbjyjlCsvh",
-	"kouAbJm4vFA9hZBOj/PdHqWEEL/00DPUsUVUkZCVsSJ7WOOizkzmWo4OIktI6sLM1MXM145NKPSajuqq",
-	"p6dWh7vlRSnzBiI4RGgbhJZtSrU99UQ9n3K2j810+uAy37BqVcmKqyZzbNd2MGmtDs+2XckrXEz1xAH0",
-	"KNsjS+ggP9DJ7hCRpkGGoRWOqQfdKepVbceWU/RbMZljfaMVvLwyQ9v1M5ZmW3InyK0CM7PhrduZdjk4",
-	"brtULRuKzJVqhyZbQlj1zIGz9hh1w41GTmeY3XcOicnk9Q6OsP1BphjngxOmrBwq2oz37GOk6dZJNQ31",
-	"LeZvtaPBhfwBupS1dZ4i6jCWw3XHBSHsQo9COF5EfHE6n6BUdVoqMRMv51ywkyPH7i1xK0Swr2nbsnQq",
-	"n2HZfTfyIiE49m5uLYVDzQUJxJoQR9AZFdHCY2J6jQLNgu5WrUDezcT7scBdw7X/xJXXrUCOwn+i9FJG",
-	"9i25maoX8SgpC87cypXPh8+ciedNZTlwToV9qK+zr7bVc6zWS8Y+jzKqqjZ8RMTkaPyZBcGrlAUUBEc0",
-	"jUeKgKSRcnWkWkOZSco9Ngwjsoo8gjylvkvXl0yklHmVyzhU/NSwfGagXKGFGClJsf1D42RqP0W8O1ym",
-	"Xsqcs4sa67nGL3g4Mx2aN5pnfGbwf5+2IQ/+u7oGZBu0QXrWNmzSerCfatNimpNx64Xr167+1zRO26xl",
-	"Gev0QLkxkjvv4crYFGQ5xh8nKUJpbrV0RYi6OLVDcZmtPTSUSxvyZTCyQTzYnlVzLpw+pCp2IOP/044J",
-	"qFhquSbki/6LbniUeZZ/cRYXt/RHZ34blDd+3lnKxig7T/+VSkovGbbMnqc3Gr8HAAD//82HIsO8IQAA",
+	"H4sIAAAAAAAC/+x9eXPbRvbgV0Fh84e9C1qUfMR2av9QJCbmWJa0pBTnsFcFi20JI14BQNmKoyod4ySz",
+	"zlipbGoztTszmcz8sfPP1tKyaNM6qK/Q+ArzSX71XncDDaDBQ6IOx5yaikWg0cfrd/W7+ok+XylVK2VS",
+	"dh395hO9atpmibjExl/ZAilVKy4pz6/cJivwpECceduqulalrN/U6f+me95z71uNNukObdB9ekhb3gZt",
+	"0ANvgx7QlrfubdDmJY3+Qlt029ugLW+NHnjP6BuNvqZ1euitQSMN/g+f7Wv0FW1odJf1S1vwZJu26Gu6",
+	"7a3RuvdHWqcNb0Ojh7ThrdFt1hWMuY3PvXXW8UvaonswJt3FTvyVuKkcqRbNFVK4qbl2jYTnFoxcx652",
+	"vDVvk76kTbrP+uUTZhODYXdxmANvC4b31r0t/A4G3cFGV0ZGDJitNMQ2bWm4nAbd97Y02qIvYG30Be+w",
+	"6a+OtmAh4ZHr2r/XfooOkr5x6V5ZN3QLdmWRmAVi64ZeNktEvynvYgq20dCd+UVSMmE/S+bjCVJecBf1",
+	"myNXrxq6u1KFTxzXtsoL+urqqmiM+DBmuYgFVbtSJbZrEXw6bxPTJYVRF348rNgl09Vv6gXTJSnXKhE9",
+	"1quhWwVoyx9bZZcsEBufO6PzrrVMpLcPKpUiMcv6qljPk9gkDd0mX9YsmxT0m19A37yp1N99fxKVB78n",
+	"8y50l7Htiq1YTqVAFKj+q7eBe9Ske973AodxCwARvqNN+gL2jm02vuHo3qL73iYiFm0yyvA2NW+d7tEG",
+	"3fE2GdrC/r+GFoB63ob3fahT3dBJuVaC1WUnZzK5ydGJuUwuN5XTDT07+cnoRHZ8Lpf5b7OZ/Ixu6Ph7",
+	"dCY7NTn30Wh2IjOuG/rs5OjszK2pXPZz/PnRVO7D7Ph4ZlI39MmpmbmPpmYn4fmdzMytqfE5eDQ6MTF1",
+	"NzMujTAzdRs/wH/nMp9OZ3OZcf93LvPJ1O1Q+1zmo1wmf8v/LvR7LpeZzbOp5TO5OXkSd3NTkx/PTY/m",
+	"83encn6L0YlcZnT8s7nMp9n8TF439LHszGeh7/BBrNn0J5+HWsHv/Gx+OjM5nhG/xyam8v6PqdmZfHY8",
+	"M3d3Knc7O/nx3K2p2VxeWha0mcmNTuazAGLpxdhsLo9bksuMZaYR/vLA4aejYzPZTzKhx2LqU9OZSZwN",
+	"m9acurvp3NT47NiM8pnoaTwzkZnJqN7kx0Yn2SDjs9MT2bHRmcxcdiZzZ25sajwjL5Z/F1qw//Cz6Yxy",
+	"fHwR34nQZ6N3MoizHIl8JMveycx9PjUpTyI/diszPjshP5r6JJPLZdlUxzN3pqdmMpNjn83dznwWIJb/",
+	"YkYQx1x2Elb0cS6ThwndzXx4a2rqdmgJYgDxbjY3ITGPgIMViGtaRUfBKECc7CDdvwhEoKHRA87Em3Qf",
+	"uDuwgEPaRFmyC28bdJvWkUnsAHvxnjKxAWzijW7olktKONx7Nnmo39T/01AguYc4jx5CljaOU9NX/Vmb",
+	"tm2uwO8ScRxzoQseijwwaJ/IPflQMR760CLFgmKYHqbQduxlUnZVPBpl6TMAOGgRDNqgljDGDBz5EFnt",
+	"Nt0DiU33aQsbomTdow3N+wNt0l0Qs943tAmiuElfw4bhBh3Qprd1SYuOA728YmoJPgXuvcN3HsfCT1sh",
+	"NQAehBUD7xmKAUQLUHgOaN17znUKeKRZBSbkI+KKy+QEGevL41oNBWOsWWV+vmbbvYnvql0p1ObdbEGx",
+	"Bz/TOsfbkFK0AY+870EuAnD2UFGSwPiGNdmmdSSdbd3oPPXq8lfZ7hZpk3mCM+yyPXvQgdYACWegoVID",
+	"wS7EHA22TeGJhGCfiOYzfCpCAfB7mON6l9zr3Hyx4uAjvkVzZqEQ+l0gRQIfqXjarUrRKpgqPf/P3rqv",
+	"GSMZANo36WvGt0D1R6WI66s+GgN3qzOq+UCjL2iDvvY1X9YPV6K9NYYGgOpNpu+Gnv1Cf6Q/42CapCrz",
+	"owBo/uuM5kC9+j5OIgAUhyE4eWyWqkVY9/C1m+k0tDVdl9iwzP9+4Yv08P0v0qkb978e+SKdunz/4s0v",
+	"0qmr7NF7KkQBMlHA6ydQ4mid64L7OEE8EDFdMAICXJ1uSHMbSY9cTaWvptI3whPEiTy5sppif4wEfyhn",
+	"V6mScnzd6WOvO4LvCAQVAv/u7m0FcP4W7LjQovkpkvEFdtTBbV3D4xByhl3GROm2diH30Zj2/tXh9y8a",
+	"4pNMYeTq1eEbeDSCt9fTl9+PoYFZXJAJKZcfuXpNN/RMYTw/qqSHeXtZ/oAPomyqQoL/h4IEeOEBPxHX",
+	"tVx+NBWcMQ3tgemQa1dqdlG1fUuWWoYuuSvyvHL5Ud3Qp25PK2dWVszsL6iibALR9jajmhPiRY61oBzz",
+	"cS+7zsHaduQIwi0hL11CPgtTMnBvEzAwH1dPlsgK/tuVTgVIHNOlohOCDlXjTxLTfrAy/cnn8UkULMc1",
+	"y/MqzPk78E9kGS1vS7DbHdrirEKj24ypbCCnfSrLykKl9qAoCe1yrfSAna2ry191WirMM7o0+MwIJqta",
+	"pHJ5ZqFgE8fpwshg+DqM1PDaFUMvWWXxc9g4soJTNF3LrRVIqLEPppL52CoBNt9I44DsR+pG2u8pgGCx",
+	"Ul7opqvh66G+hq+rOrPJguW4tgl7Pm66kT7baV+Oa7o1R6ZDkxk5DN2pOVVSZhKfqwL3YSizMFUurug3",
+	"weKl6BFG+rxSVqHi/0VR3kJb1BumKW956xIien9AdXYfJV5Dy45OjmoXUO55m6gZ7wFfQSXguZapAY4M",
+	"3ak485VHF0Mib9SxzKHPyJLpEtsqP6jZCx3ZAKJNAkLmhEoUx0yuCnXPAabZB6oTlS0P066PYD74FUhm",
+	"q7zQ/Rz873Pi245MKZibEaw5NHgC7O5a7qI/npMIwLFKrex2nPh0qHEPfEgCbg9bJe98JwAx1hZeTWhU",
+	"JXxYcwU/N10yY5VIr1bQjgwMFj6mNkr+C0VA03sqLJFNMCBo+LRBd1NM5WTmhuB4VWfMSma1xz4xBUxJ",
+	"ZTcFm6e3HpqDdgGGsJZJQfv3Nz9qjlux+Z+W49RIQazFJm7NLpPCRckIKr7UDZ19h6Ze+Ar3j33QHefj",
+	"p6uYXwOgiQfTQ1oPzxvPPaDYcUtCy/sWRfQuwlU5wqRZUo3yVzT6bjPuSBtJ4x3Aky362nsGSjAbP+bk",
+	"qGuj87BjqQmzvFADw0kn7skPqD52hfe8DeoHdK+AGjNhs/MOODrCp3pZgdmldfqKOzC89QBLaCOmufO9",
+	"VboMfFxIeMuRQfmWI4/inYKVdoVwSTCbqFSWatVEVtqDBDoC++xBNkU5JB/XCAuT5a/arVVYLNRuFYnx",
+	"XB4JH27N1Ff34T/p1I25+/9ZeaI9iq+po09J6KsWrM8sTofmrbD8JhOxt5VExKgQCSKu032D+f226Gum",
+	"OAkDCpI7pyLkCf43WpJSdcGuXdRj26G26LLldnCMtdGdTkzIdW/Li6u/VnmualcW8LjB9V7FsVRhtJhh",
+	"cxY2Ot5zW5AE6lcMNla3tkjTYaA9ttBlqlxv5CC++XCliyFUhs2wCVPqzl9aaGJtoQma5rSkjp8vRb0b",
+	"fVq1vPz8IinUiokne9kyShtcJn6ggdRk6pswcXIWAC7hde8Zs3+i5N+Bj71nhpCn3jq0FSZR5jLY5bEL",
+	"e+iJ2ERjwrfwFd2PCdhFZvntHtLCVLyKmmSWfXL52rU44CvLxLatApktu1ZRAZGfgKn9CWbHYh32mcbK",
+	"7WVhH45GmxJb5Q4T+hqUXe87/OYZmINDZmKAy1MOw6hjQSaUng7JwbE1dKJV0dujir1klRduVWp29+C9",
+	"K38UgvH7Hc40/iwjIxvBHrdD2Sm+W3FirIn964bNRCbFvlWNO1NZImpeiG+mTUsRoWGThzZxFv1vo5ZG",
+	"cKCiUQIkc4sHCQVG5MDOjIJ0U/g04NGBbOpAHDNibjvUwbllF6T2a4aQgprBMwEie9v3T3jr3nM4MYBW",
+	"vA4iW3lOEKtphxdsybE9x6dGGC4qaM86RAFOUjIjO8ueHF2Y25ViyE5MStViZYWgkaxSILbpVuzOElrM",
+	"AntTLecuebBYqSwl+eA5m931z1CSvxHduD9HItDWZbcuBHgdBt0EG8q4qyrI7NMUn1Eqby2UTbdmk5ua",
+	"s2iOXL32X+/V0unL84vksXbrzuhYKn9rdOTqNTz3wASQud3TWaOgG9BPHNcsVfEFucTeM3WRttjDezpX",
+	"DNfhxI+scMPbFB8k+4sjEPuH5J8NA0q29W0CNHHtB8y0oAEQcAU7eG7rh8JOhNuze34p+WLjEqhXDbR7",
+	"yMTg8dK30XfjvwZXR3fhbMwpIgFGBmwb0hgnRWuZ2IqgPTh2laquoz4YH2HXCmyoI2y1Euq/Il0dcl2n",
+	"XWhnQC7ZAnqPD1hMRiMUgIAPXoHXi5H1IW4iHsUwvq7jZhHZGd81Lnbto3BcPxwx9rZMHrujbL960/Sd",
+	"aqXskHyCae7WzMx0yPIiBdIKnhmExmBU0nPveQh83qZuKNAnfkQDrwRMS8IT/NssdJYDCDKBKfJG+AMZ",
+	"ATpHodWRUCLqWXLkQARBfwwCeGPhCt17/bULDF23fRdsU7YGhF0kI8N9iFiQYgKSVxQLyjhiHEP6Rh9m",
+	"/IiQJXVYyk8s4EOKQmHB0y0tm5/Srl9LD2sXhjEowHfDB7EqTbpraO+zt9uI5Ux6rrNOaeOi7NN7X/Lo",
+	"DRud7IViygG4jQCX7qtsNQ6Zr9mWuwKKOLdIPSCmTezRmrsY/PpIUP7v7s6IQG60aOHbAHqLrltl4dtW",
+	"+WFFyV9ZhHnTWxf6sLcZRMcFGjEX/s3wUaylCthyLRd3/YE5v0TKBc0h9rI1T3RDXya2wwYevpS+lBZ4",
+	"aFYt/aZ+GR8hkiziwocuPSLFYmqpXHlUHvr9oyXn0u+5xWSBIOICiZrCTqJ/TNzfPVpy9IDjYS8j6bSO",
+	"dseyy6MFzWq1aM3jh0OixyAUvkMMQJ6BU2H9exHOHIBwTdzRWqlk2ivx2Acpv6HZZcwLy1IQMYprLPqJ",
+	"RyhyDr4W65kHIAZBhNtBzOkubfCDDVeP2ZkGec8uGAtCyi/Tb9gAEL4qT897BlomLHho3hJ8U7lPE5bj",
+	"jrEmRijR5Is4K/LXURfhlA1ksqCaA9Ku+WcuPq1ADa2LPIgva6D5+GkQVnm+WCuQbDnwlvt7XyAPzVrR",
+	"1W8+NIsOMWJ24tX7x0SurrRYTK+IOy3jWPerHDkcrByocNXQr6Qv9w3xmVKimsNPDL+AaQQJKg3vj4AT",
+	"IY6G+yvzsi/ur94Pkccv4XM4bSS41yJr1S6ookrRgLSDdFT3MzC2L6KGX3EUaDmG+sEYi8+MoKUKNEGT",
+	"oUh+FMOSL2vEcT+sFFZ62oSw3iEyXXoJiImIIOxCIWpCzcDUtRpD7eG+oQ/DaAX2/E+xkRjXRF8EUodh",
+	"cPoUMPivGHLfUGekce9zgHEgHl8hanqbgOXMw8nyd95KqvspDHekO5mNCnkRpcMeyS4QDENPwPaQLayy",
+	"REN3fjFOjDkCeKsmRmTqoCQEPJ11qEdROpTh1k5r+40QbPpUCZYlJzZYIgu3mNbPM+Fi4Ekd1Cq6z/yr",
+	"UtQF3X8bKBtmceUUZiFtM+qEAKw3LLC+Z6GuxJI4mzk2OxkqEFTpeBClWsqP+236IumNk2NHZ0jZiVr2",
+	"gAyOSgY/hUCKZzZvK0QABvc6sXNMEB91wHjTOiaD7YiD0AG3X7z2to5AOYVaqbQyUVmwym0oJWjTL+nY",
+	"H39Qgh/odAWk8MDFceafeLgGRzRk7m1poDXxDWjS12Lrz4eUjBooFCewDS4IW34ZAskkUWfohKZY+cAf",
+	"TRtDa2I+n0lFHSfrSvO+yHfd12CPDc0q+MUOgldWwQD6KJiuGegiO7JvDkb6H2jI2NBYqqgRwfG7oH1m",
+	"2Ow72SKO7P9RGSH8UCN/cztG4JyUq041PZ6lqKwPoQgT7kJgueSxy/Ak5bg2MUs9oDZ8pUTtX1hxBbob",
+	"ySE9L8QFsxg+nVlE2YwvsHu1wCgBKht94W083yNP7GVip/Kk7GqcoJA1FNsLmf7Kl+QIho6pR1XTcR5V",
+	"7EL7w9v1bmMV/O7OhZzC8JnjyqrhUyenhsZEkbfBf7J4N/YjKrp+UM1ciwbncKfalo+clZrbFjvhfd/U",
+	"n0i8UierQAxxEhBFIRd2RRQco2d+zvWenRpnzLG1puRwq4OgGMMBSvw99MU3vY2kfXp+uqjXDxb6I5Ts",
+	"AOFqxEPAfLfKH4NKBxw2zVBgWFihCXQKOwZVoYKJvH5XxMskul6k6PwTcMDgi0MIyYx6Bd9OX4wErZ5d",
+	"MgwW6KKIQOOE/CJtBmzv9ZBXeW6cH/3JFOmY2NFbsnGHrA8WILEDZkPvKX2B2vc2bXDy2BfF2up0P8jo",
+	"YORRssryvIZ7SuQ4a/9OiEoUVPEPwMtIJsy59vcwSgrP+F1w/CRkLfXNAxQSU0NPAInbuYFmq4Uwd5rk",
+	"eUtduIRELmOiBfYYbKW/DqPfIns6Dl9KnxpfisGHF2sTNOE9Ox9s6V2zu6vkxTEN8H8O128Tnfm7T98k",
+	"ML/+MLmhzq6pUd6in8qYcVa88YwIWoU4A0/WyVDUDzF31okSUG/e3QER9VtbH7iET4qQ1L5hJTGFnMTh",
+	"FNggd9b/UjpjNfvhNpYTsbswJ/SD8CLQ//9MKY3I7mTWk1QrgZVKUJZJj9dPCVDmxI4Bp1HbR2ZA9+49",
+	"Hkmn7t17/H7m/n9573iFGNrWzpEQ+yCKnt2W0zGY1RhsxVxzY1Vxg3xd70+or+/yLMrgO8RlLC9Tx9yF",
+	"Fh56tr3vWZdNbLovB8eLmrQimN57GqrZLo5Dibz9QoS5f62e29fy1L4O5nXxvSMU7GF7dU6MQAlck+/0",
+	"ObX7IF/FLO0wvr6JVx5ItA8pxMD5EY03TkU0ik2OhFoGN1RwGxqSL3pJaFMQ3nY43SwG+0Z/zFthq5bv",
+	"kPE2veeh8bxNtYAE2z6QsrdJdzgxBwWtInJy6IEwawlpGYHY/8GbHVohovC2pEwdcAqF5Lyh0T3xEaub",
+	"THcEZ1UUl/driUSWFuQqsrfRu0hCN43oRjsp/yGucSDquxX1ymo2J60NGAAZLnrki0u2hWhlWZN172l8",
+	"74+lRgyUg7NVDlS5zkEVmatpVqGW/xyOF03oVg2Ml9TMFtrXR+q/cnK82lDtBJr3LMSi/RJDLC9+zdui",
+	"O5GajAEBDfSb36R+wwUv6je467t+PuIZKzm40+s4H6a7YOGrSP3NTrqPr1e8YbIFpCnTGb7BVm/6oR2t",
+	"pMCIJrkBk/K6haqxIg48fXX/9SrV3hJl5WSth7ySaYfDXoxbXDlVOj1+8lKLpcmrqnh+F1bFvM2AecaU",
+	"MXi57RfOaLBQ9kgdhaB0RphMnvjX7HRDI11Rh99jWxLpPST93SODngngfLiS3z4yjKWlRMiRXz16gBkQ",
+	"f2B6NL9QazOZoIawenOyPTsLr/tozh7Q4hnSInhNdmj9fFHiu+Q5a8MPTl+HpwcJOjrWiHzKdV7GcJq9",
+	"B4Fjqua3qvrfnJX5Me590KRljsbK0CeztBy+H/C03whPC12K3RjwtgFvO2neJmHcRoS7yTerNvvA35a/",
+	"SjzxYCoLu7inbQaLmmUE0X7xZIkTZxuG6gYYHIZVJKwzUzO/OnKHNgGreRHrg8S6Zo5r2i7eIKbksW2r",
+	"dCvt82BG+vbI0yHlQr8m89fApbHuG6Oa3jfeM+0C1Ctl1njgRnhV+htW3o5dz//CCAe9hBKrtkVWU+Ro",
+	"Pl+znYp9MWFlVXMhIVNpuFNxyDicvU1vDUlhLfFCn09Tk+SxmxrDSfn+GtAwWCICyzaXSrZ+EIcTq4W/",
+	"DqHftElfhq4UBjNEzLzNLj1ul8LOgJSUJT5y9Vq3eBa7Koj5RPYDn4qo2y0vKjF3vWiVLDdhf9JSJc/L",
+	"6Z53S77Dqi1s/Hq0wSS6vKOvM8ikmiRtt6dDCn+H5FNFDYek4/WzoIbtIRME4lJKcSV29NJrbwvIjhW1",
+	"rRbR0cl0w+5KJfh+no6hSdKdFOm4b8txV7BOKXSjdyiw4F+wobDbbYvgu+gFVh/4Bn9GmY14Mc6QaPRj",
+	"JqKXlifssX8TST4Z37q9FOeIqzdQkPOIwVdcCZVrwqoCEz+IcdzISjSEH2OBTVGImF2iv+XfayL7KCJO",
+	"lARMCsUE9ylQ11BdOsBdgqKQgreGZLPGWTrcQb8P7mVebNv3WPOLIsDfy8IvuWJ9KPf4gUZ3ZakBZPmG",
+	"M0+uc2FKsVLpEjvBHFSy8zJRrajYCdxUqqgdXMknP0vJPzg/SkUu6+zbWarbayEjl1r2XGZVXNLDKjE9",
+	"i1XGaEa1SbkCC3MaB5fQIM8Ql6n6ZZ90g6uXuJ6Q+FeFUkjIIEq0g0dPUGJEFfgAiYndBCSnMKrqvCs6",
+	"QOJKVm1Xz1FZo2PmVweXhvjMDwXfnvc9A4jwhBoqGhcOnUNU57DggWjfMRlbdao5vSTszhdDn25U6Sef",
+	"K/dfbIooizawfBwjFfjXAIrSVWRHyRBY/mqojHedtz248+vQuzi+/4vRlLeRKKCKptudlfEId30rpDtE",
+	"XOzRl+2nVCkfdUpd3BmumNTfsZxKk+kTh7QV8K7YDfFKPdIsWDWn7ZTJ4/lizbGWyR0xN9ai3VLS8D9p",
+	"NV0t5S8YZLLOIu/E/Reson38qCiVSZS07JM+GJ6KqsJJhF3h2puK8gLgBMo4fQMGEe8ph9OAQR6rND0L",
+	"BJED6LxnPmxRr1sLyJDfAKfx6xaZ0o+KV0RV4KVzoCO0Oq7hJY9YcdHbYnbHgLU+wcNw+zAQFVNVuWj4",
+	"sfro7pkT9X60lfrvclSFD4E+R1SIjruMpQiQcYjZFJJTAuF1P3Ra47eHx9KNUQPmfBZ+u0RqOi2fHS+P",
+	"zvx0gSkRC+HK1mZ2ZxVcuMYcHk263yPJ/yzfTnZcHT9E+3Nwbd1c6GblNqxgwnTcnHyF8jvHFOSrpRUY",
+	"IVky6yEWcV6SCfy7FQ5DUxVh74oZv2XaXoxU4lax9mH8TVW8PXOM7LIkKznQV6KnAikSlxMUN1e3q3YB",
+	"jYGeTjia56SoKTm/YhONEW9xgjBvHUUD/4Y9f3lBgd23jEj+Ka9BRSQvI4mt2PJALiIfTu8LiqM24mC9",
+	"MJH9aMrQjhFJ4tOYL6icIe6MbXOcwqJMpI28OhF6eacj33xgg6tmWiQQqrD5B4kU695WGGfqg5PhDq8t",
+	"zLJSNrWALLviZcc/U3bilcJ0EnYGy5hM9/k82pNwYrYME6hJsrOnnJl+UPWJxcq+exJWCjFQ4Dy/OOfN",
+	"b0S0AohAStJtLv+OLEf7I0GdWom0CzGH9wObj5I/s4jtSKHRgfVnYP05UevPjyqsY7EOa/zu6g0pku9Y",
+	"RiFYd6FWbKtX50Wbt99X4S9FtcN/x1Cf4PbswPI2UE/7lAq6FgWxt6VCalhpteYmFRk/C4Tsf4BOGBdP",
+	"r9B27zQQZUWtc2bbBJa7iy6vNVagh8chxNGNNgZyc+eIVlZWkbseDol8SVtBUGTdCIj5W1bKxG+rCSN0",
+	"HaOXDjA6hDvTfUNtPyXaUGWZ2LYlKnOpj5ZjRWLagiCmxAe/fTlHXyMufhccW8BGHtRcbw2U3LeXWP/m",
+	"b2Wd0WNos2MSdxvHSybc3sgxKV42T9zOdHaeTpwnJ/D99Z8zwR9nCn4VhnMn8vdZniHEvWh4v1qLHvim",
+	"8y2/WGLL+w5TU/YHnOQonESJFLEking+orclG7uYU7UDl4FOEi7nlfaa1o+rHbBMvmR7WJ41GBjElPjE",
+	"9p4nxg2MYgOj2KkZxX5RYN4urQcc5VgmscBJ1em+hP6FQ/WvSvFx6r6edSX6XgKs5AyicxdghU5RcW9H",
+	"KK4qwdv9tucdHXATQKd4qiO7sSTP8RP/7w4h9b1Ff0i9DmJATj4GJEzNssR6ByNAorBoxABy3IiOMFV2",
+	"F7mRRHNDNqlUSdtSZfD+pIOF+0Ww9/t3T7zJW0iVCYbTI52rdoRkMe/mrO/g7FEYMwlQDwfY1gdHgbM4",
+	"CvzcVuNQM5jTOiX86Ofl87DzHnQlVQhRRN3gnbKMvV0uOv2MPbQF7aCm8ZJVT5ewVVFO/Qh8l5fKEdmv",
+	"Uv/ilot62/jz9bDhA9/wRmAowVoURznTPLSJs9iOZbMG/eOF2N9MZYkJit4YoPTtWbNBnMW0aSVxHYaI",
+	"W5qoocSQc5d5Tuj2eWKAwyc/C45GqQAI4ryvLnbjx7gysm2yCnCs6ltwU+Vq2KWhCIahh+I6xRD0Dc1W",
+	"TOi1cGB6W7QhyhKJ6xn3BcEsWI5L7HYUw1v0i2RIybSKIYWFPTHC9dmuqi79Mx3nUcUutK8Xdl3xpV1h",
+	"8T6iDhApVYuVFYIFASoFWG/FVtadClGsmKg/D97xWRsUZh2SZKsKIRnDSO/7c1mXZDVihodQ8Kao7sMv",
+	"HaWHygVtMWSuOaBVP4F/mPq+XFkicw5xnPZWrhw2BCDmRdtuTtJsnFMIi/4VlYd1VvOwhSTOj9eDC+DP",
+	"ygitpqt+uLHBiLLN79YDf9G6v/usZFPgoGShJMzWDPVImhGxkEguR9CsHpEHi5XKktO2bs5d0eg0KpDw",
+	"wXqvP3KIy/QfvLUFP9rVBIusUVQoxdp9qKN7W0cOcojzBZatwYKbgAB2fKfFNrPDhItr+sUl16GY6i5X",
+	"hjAKB/i8VFeHcxlRvpGXdAxS+VCVAiB4a6AaCU/4Ibpd2NFjNzqdcJV7lgYpO24KxCxculdOuFVSYN25",
+	"8YRgwcIe66caOlkmZaw06XRNbxnxib7a7lI+Q6+VrS9rhL/mqk/31zU7ZN4mrvJOxj3vufdtGLebITHE",
+	"dOFwuSVMZ0a2HK5FXxc6cVjxHB65HgbeNcUca3ZRMcEf4MgNzFpbdN2q0PnhbwcLMrboHn3Fal/KEUpY",
+	"4zlebJWFc2yyakk+aWWnU0APbJzo9Zcj6SvXe7PBwUJCyODD/6xVWp+7J0jfHYnbnUcf2cAtf9TweXln",
+	"Z3MTfRBdsv4y9IT/1VUCZiK3VxwH/H5P4UQQowA5Y7/+LmrjMjSO71lSFQ+Qhoh7lugrKHOLY+6xqrmy",
+	"0gFaSH9QFipwWMvEtkhXmvh40Pr0UNh4oqxv2KboPESfwbewszhhrDoPiph+v+v+u62fOJw+FwUUwzu0",
+	"0vsxJoJgA6I/LtH/r4CE4+Qb5QBGtKAIZxNAymhfpc1eSX519T8GAJE+Egjn5AAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
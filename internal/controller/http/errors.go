@@ -0,0 +1,92 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/controller/http/dto"
+	"github.com/spanwalla/pvz/internal/service"
+	"github.com/spanwalla/pvz/pkg/validator"
+)
+
+// statusCodes is used for errors without a service code, e.g. echo routing errors or invalid request bodies
+var statusCodes = map[int]service.Code{
+	http.StatusBadRequest:       service.CodeInvalidRequest,
+	http.StatusUnauthorized:     service.CodeUnauthorized,
+	http.StatusForbidden:        service.CodeForbidden,
+	http.StatusNotFound:         service.CodeNotFound,
+	http.StatusMethodNotAllowed: service.CodeMethodNotAllowed,
+}
+
+// newHTTPError keeps err as the cause, so errorHandler can render its code
+func newHTTPError(status int, err error) *echo.HTTPError {
+	return echo.NewHTTPError(status, err.Error()).SetInternal(err)
+}
+
+// newValidationError reports a request rule that the OpenAPI schema cannot express
+func newValidationError(field, message string) *echo.HTTPError {
+	err := &validator.ValidationError{Fields: []validator.FieldError{{Field: field, Message: message}}}
+	return newHTTPError(http.StatusBadRequest, err)
+}
+
+// errorHandler renders every error as the Error schema with a stable code
+func errorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	status, body := errorResponse(err)
+
+	var respErr error
+	if c.Request().Method == http.MethodHead {
+		respErr = c.NoContent(status)
+	} else {
+		respErr = c.JSON(status, body)
+	}
+	if respErr != nil {
+		log.Errorf("http - errorHandler - c.JSON: %v", respErr)
+	}
+}
+
+func errorResponse(err error) (int, dto.Error) {
+	var httpErr *echo.HTTPError
+	if !errors.As(err, &httpErr) {
+		return http.StatusInternalServerError, dto.Error{
+			Code:    dto.ErrorCode(service.CodeInternal),
+			Message: "internal server error",
+		}
+	}
+
+	body := dto.Error{
+		Code:    dto.ErrorCode(service.CodeInternal),
+		Message: fmt.Sprint(httpErr.Message),
+	}
+
+	var (
+		serviceErr    *service.Error
+		validationErr *validator.ValidationError
+	)
+	switch {
+	case errors.As(httpErr.Internal, &validationErr):
+		body.Code = dto.ErrorCode(service.CodeValidationFailed)
+		details := make([]dto.ErrorDetail, len(validationErr.Fields))
+		for i, field := range validationErr.Fields {
+			details[i] = dto.ErrorDetail{Field: &field.Field, Message: field.Message}
+		}
+		body.Details = &details
+	case errors.As(httpErr.Internal, &serviceErr):
+		body.Code = dto.ErrorCode(serviceErr.Code())
+	default:
+		if code, ok := statusCodes[httpErr.Code]; ok {
+			body.Code = dto.ErrorCode(code)
+		} else if httpErr.Code < http.StatusInternalServerError {
+			body.Code = dto.ErrorCode(service.CodeInvalidRequest)
+		}
+	}
+
+	return httpErr.Code, body
+}
@@ -0,0 +1,81 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spanwalla/pvz/internal/controller/http/dto"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+func TestErrorResponse(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		err        error
+		wantStatus int
+		want       dto.Error
+	}{
+		{
+			name:       "service error",
+			err:        newHTTPError(http.StatusBadRequest, service.ErrPointClosed),
+			wantStatus: http.StatusBadRequest,
+			want: dto.Error{
+				Code:    dto.ErrorCode(service.CodePointClosed),
+				Message: service.ErrPointClosed.Error(),
+			},
+		},
+		{
+			name:       "validation error",
+			err:        newValidationError("latitude", "field latitude must be set together with longitude"),
+			wantStatus: http.StatusBadRequest,
+			want: dto.Error{
+				Code:    dto.ErrorCode(service.CodeValidationFailed),
+				Message: "field latitude must be set together with longitude",
+				Details: &[]dto.ErrorDetail{
+					{Field: lo.ToPtr("latitude"), Message: "field latitude must be set together with longitude"},
+				},
+			},
+		},
+		{
+			name:       "error without code",
+			err:        newHTTPError(http.StatusConflict, errors.New("arbitrary error")),
+			wantStatus: http.StatusConflict,
+			want: dto.Error{
+				Code:    dto.ErrorCode(service.CodeInvalidRequest),
+				Message: "arbitrary error",
+			},
+		},
+		{
+			name:       "echo error",
+			err:        echo.ErrNotFound,
+			wantStatus: http.StatusNotFound,
+			want: dto.Error{
+				Code:    dto.ErrorCode(service.CodeNotFound),
+				Message: "Not Found",
+			},
+		},
+		{
+			name:       "unexpected error",
+			err:        errors.New("arbitrary error"),
+			wantStatus: http.StatusInternalServerError,
+			want: dto.Error{
+				Code:    dto.ErrorCode(service.CodeInternal),
+				Message: "internal server error",
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, got := errorResponse(tc.err)
+
+			assert.Equal(t, tc.wantStatus, status)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
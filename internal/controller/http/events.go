@@ -0,0 +1,97 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/controller/http/dto"
+	internaldto "github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+// keepAliveInterval prevents proxies from closing idle event streams
+const keepAliveInterval = 15 * time.Second
+
+type eventRoutes struct {
+	eventService service.Event
+}
+
+func newEventRoutes(eventService service.Event) *eventRoutes {
+	return &eventRoutes{eventService}
+}
+
+// eventStreamResponse writes events as Server-Sent Events until the subscription ends
+type eventStreamResponse struct {
+	events <-chan entity.Event
+}
+
+func (r *eventRoutes) WatchEvents(ctx context.Context, request dto.WatchEventsRequestObject) (dto.WatchEventsResponseObject, error) {
+	filter := internaldto.EventFilter{
+		PointID: request.Params.PvzId,
+		City:    lo.EmptyableToPtr(lo.FromPtr(request.Params.City)),
+	}
+
+	return eventStreamResponse{events: r.eventService.Subscribe(ctx, filter)}, nil
+}
+
+func (response eventStreamResponse) VisitWatchEventsResponse(w http.ResponseWriter) error {
+	rc := http.NewResponseController(w)
+
+	// Stream lives longer than the server write timeout, so the deadline is lifted for this response only
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		log.Warnf("eventStreamResponse.VisitWatchEventsResponse - SetWriteDeadline: %v", err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	_ = rc.Flush()
+
+	ticker := time.NewTicker(keepAliveInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case event, ok := <-response.events:
+			if !ok {
+				return nil
+			}
+
+			data, err := json.Marshal(eventToDTO(event))
+			if err != nil {
+				log.Errorf("eventStreamResponse.VisitWatchEventsResponse - json.Marshal: %v", err)
+				continue
+			}
+
+			if _, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data); err != nil {
+				return nil
+			}
+		case <-ticker.C:
+			if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+		}
+
+		_ = rc.Flush()
+	}
+}
+
+func eventToDTO(event entity.Event) dto.Event {
+	return dto.Event{
+		Id:          event.ID,
+		Type:        dto.EventType(event.Type),
+		PvzId:       event.PointID,
+		City:        event.City,
+		ReceptionId: event.ReceptionID,
+		ProductId:   event.ProductID,
+		OccurredAt:  event.OccurredAt,
+	}
+}
@@ -1,6 +1,7 @@
 package mw
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"strings"
@@ -13,8 +14,8 @@ import (
 )
 
 var (
-	ErrInvalidAuthHeader = errors.New("invalid auth header")
-	ErrNoRights          = errors.New("no rights")
+	ErrInvalidAuthHeader = service.NewError(service.CodeUnauthorized, "invalid auth header")
+	ErrNoRights          = service.NewError(service.CodeForbidden, "no rights")
 )
 
 const (
@@ -32,47 +33,68 @@ func NewAuth(authService service.Auth) *Auth {
 	}
 }
 
-// UserIdentity - middleware to check authorization
+type claimsKey struct{}
+
+// ClaimsFromContext returns token claims stored by Authorize in the request context
+func ClaimsFromContext(ctx context.Context) (*entity.TokenClaims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*entity.TokenClaims)
+	return claims, ok
+}
+
+// Authorize - middleware to check authorization and user role
+//
+// - Routes are matched by `<method> <path>` as registered in echo, e.g. `POST /pvz/:pvzId/close`
+//
+// - Parse `Authorization` header (expected format `Bearer <JWT token>`) for routes missing in public
 //
-// - Parse `Authorization` header (expected format `Bearer <JWT token>`)
+// - Checks the role against access and sets {"userID": <uuid.UUID>, "role": <entity.RoleType>} in `c echo.Context`,
+// token claims are also stored in the request context
 //
-// - Sets {"userID": <uuid.UUID>, "role": <entity.RoleType>} in `c echo.Context`
-func (m *Auth) UserIdentity() echo.MiddlewareFunc {
+// Requests that matched no route are passed to echo, so it answers with 404 or 405.
+func (m *Auth) Authorize(public map[string]struct{}, access map[string]entity.Access) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
+			route := RouteKey(c.Request().Method, c.Path())
+			if _, ok := public[route]; ok {
+				return next(c)
+			}
+
+			allowed, ok := access[route]
+			if !ok {
+				return next(c)
+			}
+
 			token, err := bearerToken(c.Request())
 			if err != nil {
-				log.Errorf("AuthMW.UserIdentity - bearerToken: %v", err)
-				return echo.NewHTTPError(http.StatusUnauthorized, ErrInvalidAuthHeader.Error())
+				log.Errorf("AuthMW.Authorize - bearerToken: %v", err)
+				return echo.NewHTTPError(http.StatusUnauthorized, ErrInvalidAuthHeader.Error()).SetInternal(ErrInvalidAuthHeader)
 			}
 
-			claims, err := m.authService.ParseToken(token)
+			claims, err := m.authService.ParseToken(c.Request().Context(), token)
 			if err != nil {
-				return echo.NewHTTPError(http.StatusUnauthorized, err.Error())
+				if errors.Is(err, service.ErrCannotCheckToken) {
+					return echo.NewHTTPError(http.StatusInternalServerError, err.Error()).SetInternal(err)
+				}
+
+				return echo.NewHTTPError(http.StatusUnauthorized, err.Error()).SetInternal(err)
+			}
+
+			if !allowed.Allows(claims.Role) {
+				return echo.NewHTTPError(http.StatusForbidden, ErrNoRights.Error()).SetInternal(ErrNoRights)
 			}
 
 			c.Set(UserIDKey, claims.UserID)
 			c.Set(RoleKey, claims.Role)
+			c.SetRequest(c.Request().WithContext(context.WithValue(c.Request().Context(), claimsKey{}, claims)))
 
 			return next(c)
 		}
 	}
 }
 
-// CheckRole - middleware to check user role
-//
-// Expect that value from `c echo.Context` by "role" key is `entity.RoleTypeModerator`
-func (m *Auth) CheckRole(required entity.RoleType) echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			role, ok := c.Get(RoleKey).(entity.RoleType)
-			if !ok || role != required {
-				return echo.NewHTTPError(http.StatusForbidden, ErrNoRights.Error())
-			}
-
-			return next(c)
-		}
-	}
+// RouteKey builds the key used by Authorize to look up route rules
+func RouteKey(method, path string) string {
+	return method + " " + path
 }
 
 func bearerToken(req *http.Request) (string, error) {
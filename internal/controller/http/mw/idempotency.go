@@ -0,0 +1,162 @@
+package mw
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+const (
+	HeaderIdempotencyKey     = "Idempotency-Key"
+	HeaderIdempotentReplayed = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+var ErrInvalidIdempotencyKey = service.NewError(service.CodeInvalidRequest, "idempotency key must be at most 255 characters")
+
+type Idempotency struct {
+	idempotencyService service.Idempotency
+}
+
+func NewIdempotency(idempotencyService service.Idempotency) *Idempotency {
+	return &Idempotency{
+		idempotencyService: idempotencyService,
+	}
+}
+
+// Handle - middleware to make POST requests idempotent
+//
+// - Reads `Idempotency-Key` header, requests without it are passed as is
+//
+// - Replays the first response stored for the user and key, marking it with `Idempotent-Replayed: true`
+//
+// - Rejects reuse of the key with another method, path or body
+//
+// Must be used after Authorize and before request validation. Server errors and panics are not stored, so the request can be retried with the same key.
+func (m *Idempotency) Handle() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(HeaderIdempotencyKey)
+			if c.Request().Method != http.MethodPost || len(key) == 0 {
+				return next(c)
+			}
+
+			if len(key) > maxIdempotencyKeyLength {
+				return echo.NewHTTPError(http.StatusBadRequest, ErrInvalidIdempotencyKey.Error()).SetInternal(ErrInvalidIdempotencyKey)
+			}
+
+			userID, ok := c.Get(UserIDKey).(uuid.UUID)
+			if !ok {
+				return next(c)
+			}
+
+			hash, err := requestHash(c.Request())
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+			}
+
+			ctx := c.Request().Context()
+			stored, err := m.idempotencyService.Begin(ctx, userID, key, hash)
+			if err != nil {
+				switch {
+				case errors.Is(err, service.ErrIdempotencyKeyReused):
+					return echo.NewHTTPError(http.StatusUnprocessableEntity, err.Error()).SetInternal(err)
+				case errors.Is(err, service.ErrIdempotentRequestInProgress):
+					return echo.NewHTTPError(http.StatusConflict, err.Error()).SetInternal(err)
+				}
+				return echo.NewHTTPError(http.StatusInternalServerError, err.Error()).SetInternal(err)
+			}
+
+			if stored != nil {
+				c.Response().Header().Set(HeaderIdempotentReplayed, "true")
+				if len(stored.ContentType) == 0 {
+					return c.NoContent(stored.StatusCode)
+				}
+				return c.Blob(stored.StatusCode, stored.ContentType, stored.Body)
+			}
+
+			// Recover is outside this middleware, so the key of a panicking request is released here
+			defer func() {
+				if r := recover(); r != nil {
+					m.idempotencyService.Release(context.WithoutCancel(ctx), userID, key)
+					panic(r)
+				}
+			}()
+
+			recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+
+			// Error is rendered here to capture the body, the echo error handler skips committed responses
+			err = next(c)
+			if err != nil {
+				c.Error(err)
+			}
+
+			// The response is already sent, so storing it must not depend on the client staying connected
+			ctx = context.WithoutCancel(ctx)
+			res := c.Response()
+			if res.Status >= http.StatusInternalServerError {
+				m.idempotencyService.Release(ctx, userID, key)
+				return err
+			}
+
+			completeErr := m.idempotencyService.Complete(ctx, userID, key, entity.IdempotentResponse{
+				StatusCode:  res.Status,
+				ContentType: res.Header().Get(echo.HeaderContentType),
+				Body:        recorder.body.Bytes(),
+			})
+			if completeErr != nil {
+				log.Errorf("IdempotencyMW.Handle - m.idempotencyService.Complete: %v", completeErr)
+				m.idempotencyService.Release(ctx, userID, key)
+			}
+
+			return err
+		}
+	}
+}
+
+// requestHash identifies the request by method, path, query and body. The body is restored for the handler.
+func requestHash(req *http.Request) (string, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return "", err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	h := sha256.New()
+	h.Write([]byte(req.Method + " " + req.URL.RequestURI() + "\n"))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// responseRecorder copies the response body while writing it to the client
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *responseRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,41 @@
+package mw_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/spanwalla/pvz/internal/controller/http/mw"
+	servicemocks "github.com/spanwalla/pvz/internal/service/mocks"
+)
+
+func TestIdempotency_Handle_ReleasesKeyOnPanic(t *testing.T) {
+	log.SetOutput(io.Discard)
+	const key = "8d5c1a4e-create-pvz"
+	userID := uuid.New()
+
+	ctrl := gomock.NewController(t)
+	mockIdempotencyService := servicemocks.NewMockIdempotency(ctrl)
+	mockIdempotencyService.EXPECT().Begin(gomock.Any(), userID, key, gomock.Any()).Return(nil, nil)
+	mockIdempotencyService.EXPECT().Release(gomock.Any(), userID, key)
+
+	req := httptest.NewRequest(http.MethodPost, "/pvz", strings.NewReader(`{"city":"Москва"}`))
+	req.Header.Set(mw.HeaderIdempotencyKey, key)
+
+	c := echo.New().NewContext(req, httptest.NewRecorder())
+	c.Set(mw.UserIDKey, userID)
+
+	handler := mw.NewIdempotency(mockIdempotencyService).Handle()(func(echo.Context) error {
+		panic("handler failed")
+	})
+
+	assert.PanicsWithValue(t, "handler failed", func() { _ = handler(c) })
+}
@@ -0,0 +1,226 @@
+package mw
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/gorillamux"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	echomiddleware "github.com/oapi-codegen/echo-middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/pkg/validator"
+)
+
+var ErrResponseContract = errors.New("response does not match the API contract")
+
+func init() {
+	openapi3.DefineStringFormat("email", openapi3.FormatOfStringForEmail)
+	openapi3.DefineStringFormatCallback("uuid", func(value string) error {
+		_, err := uuid.Parse(value)
+		return err
+	})
+}
+
+type OpenAPI struct {
+	swagger *openapi3.T
+	router  routers.Router
+	routes  map[string]struct{}
+}
+
+// NewOpenAPI prepares middlewares enforcing the contract described by swagger. Servers must be cleared,
+// otherwise requests are matched against the server host as well.
+func NewOpenAPI(swagger *openapi3.T) (*OpenAPI, error) {
+	router, err := gorillamux.NewRouter(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("OpenAPI - gorillamux.NewRouter: %w", err)
+	}
+
+	routes := make(map[string]struct{})
+	for path, item := range swagger.Paths.Map() {
+		// echo declares path parameters as `:name` instead of `{name}`
+		path = strings.NewReplacer("{", ":", "}", "").Replace(path)
+		for method := range item.Operations() {
+			routes[RouteKey(method, path)] = struct{}{}
+		}
+	}
+
+	return &OpenAPI{
+		swagger: swagger,
+		router:  router,
+		routes:  routes,
+	}, nil
+}
+
+// ValidateRequest - middleware to check parameters and body of the request against the operation schema
+//
+// Invalid requests are rejected with 400 listing every invalid field. Routes missing in the spec are passed as is.
+func (m *OpenAPI) ValidateRequest() echo.MiddlewareFunc {
+	return echomiddleware.OapiRequestValidatorWithOptions(m.swagger, &echomiddleware.Options{
+		Options: openapi3filter.Options{
+			MultiError:         true,
+			AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+		},
+		Skipper: m.undocumented,
+		MultiErrorHandler: func(errs openapi3.MultiError) *echo.HTTPError {
+			err := &validator.ValidationError{Fields: requestFieldErrors(errs)}
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error()).SetInternal(err)
+		},
+	})
+}
+
+// ValidateResponse - middleware to check the response against the operation schema before sending it
+//
+// - Buffers the response, so streaming operations (`text/event-stream`) are passed as is
+//
+// - Responses with statuses missing in the spec are not checked
+//
+// A response that breaks the contract is replaced with 500, it is meant for non-production environments.
+func (m *OpenAPI) ValidateResponse() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if m.undocumented(c) {
+				return next(c)
+			}
+
+			route, pathParams, err := m.router.FindRoute(c.Request())
+			if err != nil || isStream(route.Operation) {
+				return next(c)
+			}
+
+			res := c.Response()
+			writer := res.Writer
+			buffer := &bufferedWriter{ResponseWriter: writer}
+			res.Writer = buffer
+
+			err = next(c)
+			res.Writer = writer
+
+			if !res.Committed {
+				return err
+			}
+
+			contractErr := openapi3filter.ValidateResponse(c.Request().Context(), &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{
+					Request:    c.Request(),
+					PathParams: pathParams,
+					Route:      route,
+				},
+				Status: buffer.status,
+				Header: res.Header(),
+				Body:   io.NopCloser(bytes.NewReader(buffer.body.Bytes())),
+			})
+			if contractErr != nil {
+				log.Errorf("OpenAPIMW.ValidateResponse - %s %s: %v", c.Request().Method, c.Path(), contractErr)
+
+				res.Committed = false
+				res.Status = http.StatusOK
+				res.Size = 0
+				res.Header().Del(echo.HeaderContentType)
+				res.Header().Del(echo.HeaderContentLength)
+
+				return echo.NewHTTPError(http.StatusInternalServerError, ErrResponseContract.Error()).SetInternal(contractErr)
+			}
+
+			writer.WriteHeader(buffer.status)
+			if _, writeErr := writer.Write(buffer.body.Bytes()); writeErr != nil {
+				log.Errorf("OpenAPIMW.ValidateResponse - writer.Write: %v", writeErr)
+			}
+
+			return err
+		}
+	}
+}
+
+func (m *OpenAPI) undocumented(c echo.Context) bool {
+	_, ok := m.routes[RouteKey(c.Request().Method, c.Path())]
+	return !ok
+}
+
+func isStream(operation *openapi3.Operation) bool {
+	response := operation.Responses.Status(http.StatusOK)
+	return response != nil && response.Value != nil && response.Value.Content.Get("text/event-stream") != nil
+}
+
+// requestFieldErrors flattens validation errors of parameters and body, a field is named by its parameter name
+// or by its path in the body joined with dots
+func requestFieldErrors(errs openapi3.MultiError) []validator.FieldError {
+	fields := make([]validator.FieldError, 0, len(errs))
+	for _, err := range errs {
+		var requestErr *openapi3filter.RequestError
+		if !errors.As(err, &requestErr) {
+			fields = append(fields, validator.FieldError{Message: err.Error()})
+			continue
+		}
+
+		field := "body"
+		if requestErr.Parameter != nil {
+			field = requestErr.Parameter.Name
+		}
+
+		var (
+			schemaErrs []*openapi3.SchemaError
+			multiErr   openapi3.MultiError
+			schemaErr  *openapi3.SchemaError
+		)
+		// MultiError is matched first, otherwise errors.As would pick only its first schema error
+		switch {
+		case errors.As(requestErr.Err, &multiErr):
+			for _, e := range multiErr {
+				if errors.As(e, &schemaErr) {
+					schemaErrs = append(schemaErrs, schemaErr)
+				}
+			}
+		case errors.As(requestErr.Err, &schemaErr):
+			schemaErrs = append(schemaErrs, schemaErr)
+		}
+
+		if len(schemaErrs) == 0 {
+			message, _, _ := strings.Cut(requestErr.Error(), "\n")
+			fields = append(fields, validator.FieldError{Field: field, Message: message})
+			continue
+		}
+
+		for _, schemaErr := range schemaErrs {
+			name := field
+			if pointer := schemaErr.JSONPointer(); len(pointer) > 0 && requestErr.Parameter == nil {
+				name = strings.Join(pointer, ".")
+			}
+
+			fields = append(fields, validator.FieldError{
+				Field:   name,
+				Message: fmt.Sprintf("field %s: %s", name, schemaErr.Reason),
+			})
+		}
+	}
+
+	return fields
+}
+
+// bufferedWriter holds the response until it is validated, headers are shared with the wrapped writer
+type bufferedWriter struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(status int) {
+	w.status = status
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.body.Write(b)
+}
+
+func (w *bufferedWriter) Flush() {}
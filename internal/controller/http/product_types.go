@@ -0,0 +1,137 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/samber/lo"
+
+	"github.com/spanwalla/pvz/internal/controller/http/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+type productTypeRoutes struct {
+	productTypeService service.ProductType
+}
+
+func newProductTypeRoutes(productTypeService service.ProductType) *productTypeRoutes {
+	return &productTypeRoutes{productTypeService}
+}
+
+func (r *productTypeRoutes) ListProductTypes(ctx context.Context, request dto.ListProductTypesRequestObject) (dto.ListProductTypesResponseObject, error) {
+	productTypes, err := r.productTypeService.GetAll(ctx, lo.FromPtr(request.Params.IncludeInactive))
+	if err != nil {
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	response := make(dto.ListProductTypes200JSONResponse, 0, len(productTypes))
+	for _, productType := range productTypes {
+		response = append(response, productTypeToDTO(productType))
+	}
+
+	return response, nil
+}
+
+func (r *productTypeRoutes) CreateProductType(ctx context.Context, request dto.CreateProductTypeRequestObject) (dto.CreateProductTypeResponseObject, error) {
+	if err := validateNameLocales(request.Body.Names); err != nil {
+		return nil, err
+	}
+
+	productType, err := r.productTypeService.Create(ctx, entity.ProductType(request.Body.Code), request.Body.Names)
+	if err != nil {
+		if errors.Is(err, service.ErrProductTypeAlreadyExists) || errors.Is(err, service.ErrProductTypeNameRequired) {
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return dto.CreateProductType201JSONResponse(productTypeToDTO(productType)), nil
+}
+
+func (r *productTypeRoutes) UpdateProductTypeNames(ctx context.Context, request dto.UpdateProductTypeNamesRequestObject) (dto.UpdateProductTypeNamesResponseObject, error) {
+	if err := validateNameLocales(request.Body.Names); err != nil {
+		return nil, err
+	}
+
+	productType, err := r.productTypeService.UpdateNames(ctx, entity.ProductType(request.Code), request.Body.Names)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrProductTypeNotFound):
+			return nil, newHTTPError(http.StatusNotFound, err)
+		case errors.Is(err, service.ErrProductTypeNameRequired):
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		default:
+			return nil, newHTTPError(http.StatusInternalServerError, err)
+		}
+	}
+
+	return dto.UpdateProductTypeNames200JSONResponse(productTypeToDTO(productType)), nil
+}
+
+func (r *productTypeRoutes) ActivateProductType(ctx context.Context, request dto.ActivateProductTypeRequestObject) (dto.ActivateProductTypeResponseObject, error) {
+	productType, err := r.setActive(ctx, request.Code, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ActivateProductType200JSONResponse(productType), nil
+}
+
+func (r *productTypeRoutes) DeactivateProductType(ctx context.Context, request dto.DeactivateProductTypeRequestObject) (dto.DeactivateProductTypeResponseObject, error) {
+	productType, err := r.setActive(ctx, request.Code, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.DeactivateProductType200JSONResponse(productType), nil
+}
+
+func (r *productTypeRoutes) setActive(ctx context.Context, code string, active bool) (dto.ProductType, error) {
+	productType, err := r.productTypeService.SetActive(ctx, entity.ProductType(code), active)
+	if err != nil {
+		if errors.Is(err, service.ErrProductTypeNotFound) {
+			return dto.ProductType{}, newHTTPError(http.StatusNotFound, err)
+		}
+
+		return dto.ProductType{}, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return productTypeToDTO(productType), nil
+}
+
+// validateNameLocales checks that names are keyed by two-letter language codes, the schema cannot express it
+func validateNameLocales(names map[string]string) error {
+	for locale := range names {
+		if len(locale) != 2 {
+			return newValidationError("names", "field names must be keyed by two-letter language codes")
+		}
+	}
+
+	return nil
+}
+
+func productTypeToDTO(productType entity.ProductTypeEntry) dto.ProductType {
+	return dto.ProductType{
+		Code:      string(productType.Code),
+		Names:     productType.Names,
+		IsActive:  productType.IsActive,
+		CreatedAt: &productType.CreatedAt,
+	}
+}
+
+// requestLocale returns primary language subtag of the first `Accept-Language` entry
+func requestLocale(header *string) string {
+	tag, _, _ := strings.Cut(lo.FromPtr(header), ",")
+	tag, _, _ = strings.Cut(tag, ";")
+	tag, _, _ = strings.Cut(strings.TrimSpace(tag), "-")
+
+	if len(tag) == 0 || tag == "*" {
+		return entity.DefaultLocale
+	}
+
+	return strings.ToLower(tag)
+}
@@ -1,57 +1,156 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
 	"github.com/google/uuid"
-	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 
 	"github.com/spanwalla/pvz/internal/controller/http/dto"
-	"github.com/spanwalla/pvz/internal/controller/http/mw"
+	internaldto "github.com/spanwalla/pvz/internal/dto"
 	"github.com/spanwalla/pvz/internal/entity"
 	"github.com/spanwalla/pvz/internal/service"
 )
 
-type productRequest struct {
-	Type    entity.ProductType `json:"type" validate:"required,oneof=электроника одежда обувь"`
-	PointID uuid.UUID          `json:"pvzId" validate:"required,uuid"`
-}
-
 type productRoutes struct {
 	productService service.Product
 }
 
-func newProductRoutes(g *echo.Group, productService service.Product, authMW *mw.Auth) {
-	r := &productRoutes{productService}
+func newProductRoutes(productService service.Product) *productRoutes {
+	return &productRoutes{productService}
+}
+
+func (r *productRoutes) CreateProduct(ctx context.Context, request dto.CreateProductRequestObject) (dto.CreateProductResponseObject, error) {
+	product, err := r.productService.Create(ctx, request.Body.PvzId, entity.ProductType(request.Body.Type), request.Body.ItemCode)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrActiveReceptionNotFound), errors.Is(err, service.ErrProductTypeNotFound),
+			errors.Is(err, service.ErrPointNotFound), errors.Is(err, service.ErrPointSuspended), errors.Is(err, service.ErrPointClosed),
+			errors.Is(err, service.ErrPointOutsideWorkingHours):
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		case errors.Is(err, service.ErrProductAlreadyScanned):
+			return nil, newHTTPError(http.StatusConflict, err)
+		default:
+			return nil, newHTTPError(http.StatusInternalServerError, err)
+		}
+	}
 
-	g.POST("", r.root, authMW.CheckRole(entity.RoleTypeEmployee))
+	return dto.CreateProduct201JSONResponse(productToDTO(product, requestLocale(request.Params.AcceptLanguage))), nil
 }
 
-func (r *productRoutes) root(c echo.Context) error {
-	var req productRequest
+func (r *productRoutes) CreateProductBatch(ctx context.Context, request dto.CreateProductBatchRequestObject) (dto.CreateProductBatchResponseObject, error) {
+	inputs := make([]internaldto.ProductInput, len(request.Body.Products))
+	for i, item := range request.Body.Products {
+		inputs[i] = internaldto.ProductInput{Type: entity.ProductType(item.Type), ItemCode: item.ItemCode}
+	}
+
+	products, err := r.productService.CreateBatch(ctx, request.Body.PvzId, inputs)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrActiveReceptionNotFound), errors.Is(err, service.ErrProductTypeNotFound),
+			errors.Is(err, service.ErrPointNotFound), errors.Is(err, service.ErrPointSuspended), errors.Is(err, service.ErrPointClosed),
+			errors.Is(err, service.ErrPointOutsideWorkingHours), errors.Is(err, service.ErrDuplicateItemCode):
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		case errors.Is(err, service.ErrProductAlreadyScanned):
+			return nil, newHTTPError(http.StatusConflict, err)
+		default:
+			return nil, newHTTPError(http.StatusInternalServerError, err)
+		}
+	}
+
+	locale := requestLocale(request.Params.AcceptLanguage)
 
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	response := make(dto.CreateProductBatch201JSONResponse, len(products))
+	for i, product := range products {
+		response[i] = productToDTO(product, locale)
 	}
 
-	if err := c.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	return response, nil
+}
+
+func (r *productRoutes) GetProductByCode(ctx context.Context, request dto.GetProductByCodeRequestObject) (dto.GetProductByCodeResponseObject, error) {
+	lookup, err := r.productService.GetByItemCode(ctx, request.Code)
+	if err != nil {
+		if errors.Is(err, service.ErrProductNotFound) {
+			return nil, newHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, newHTTPError(http.StatusInternalServerError, err)
 	}
 
-	product, err := r.productService.Create(c.Request().Context(), req.PointID, req.Type)
+	return dto.GetProductByCode200JSONResponse{
+		Product:   productToDTO(lookup.Product, requestLocale(request.Params.AcceptLanguage)),
+		Reception: receptionToDTO(lookup.Reception),
+		Pvz: dto.PVZ{
+			Id:               &lookup.Point.ID,
+			RegistrationDate: &lookup.Point.CreatedAt,
+			City:             lookup.Point.City,
+		},
+	}, nil
+}
+
+func (r *productRoutes) GetProduct(ctx context.Context, request dto.GetProductRequestObject) (dto.GetProductResponseObject, error) {
+	product, err := r.productService.GetByID(ctx, request.ProductId)
 	if err != nil {
-		if errors.Is(err, service.ErrActiveReceptionNotFound) {
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		if errors.Is(err, service.ErrProductNotFound) {
+			return nil, newHTTPError(http.StatusNotFound, err)
 		}
 
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return nil, newHTTPError(http.StatusInternalServerError, err)
 	}
 
-	return c.JSON(http.StatusCreated, dto.Product{
+	return dto.GetProduct200JSONResponse(productToDTO(product, requestLocale(request.Params.AcceptLanguage))), nil
+}
+
+func (r *productRoutes) IssueProduct(ctx context.Context, request dto.IssueProductRequestObject) (dto.IssueProductResponseObject, error) {
+	product, err := r.changeProductStatus(ctx, request.ProductId, request.Params.AcceptLanguage, r.productService.Issue)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.IssueProduct200JSONResponse(product), nil
+}
+
+func (r *productRoutes) ReturnProduct(ctx context.Context, request dto.ReturnProductRequestObject) (dto.ReturnProductResponseObject, error) {
+	product, err := r.changeProductStatus(ctx, request.ProductId, request.Params.AcceptLanguage, r.productService.Return)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ReturnProduct200JSONResponse(product), nil
+}
+
+func (r *productRoutes) changeProductStatus(
+	ctx context.Context,
+	productID uuid.UUID,
+	acceptLanguage *string,
+	change func(ctx context.Context, productID uuid.UUID) (entity.Product, error),
+) (dto.Product, error) {
+	product, err := change(ctx, productID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrProductNotFound):
+			return dto.Product{}, newHTTPError(http.StatusNotFound, err)
+		case errors.Is(err, service.ErrInvalidProductTransition):
+			return dto.Product{}, newHTTPError(http.StatusConflict, err)
+		default:
+			return dto.Product{}, newHTTPError(http.StatusInternalServerError, err)
+		}
+	}
+
+	return productToDTO(product, requestLocale(acceptLanguage)), nil
+}
+
+func productToDTO(product entity.Product, locale string) dto.Product {
+	return dto.Product{
 		Id:          &product.ID,
 		DateTime:    &product.CreatedAt,
-		Type:        dto.ProductType(product.Type),
+		Type:        string(product.Type),
+		TypeName:    lo.ToPtr(entity.DisplayName(product.Type, product.TypeNames, locale)),
+		ItemCode:    product.ItemCode,
 		ReceptionId: product.ReceptionID,
-	})
+		Status:      lo.ToPtr(dto.ProductStatus(product.Status)),
+	}
 }
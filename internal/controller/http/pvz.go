@@ -1,107 +1,115 @@
 package http
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
-	"time"
 
 	"github.com/google/uuid"
-	"github.com/labstack/echo/v4"
+	"github.com/samber/lo"
 
 	"github.com/spanwalla/pvz/internal/controller/http/dto"
-	"github.com/spanwalla/pvz/internal/controller/http/mw"
+	internaldto "github.com/spanwalla/pvz/internal/dto"
 	"github.com/spanwalla/pvz/internal/entity"
 	"github.com/spanwalla/pvz/internal/service"
 )
 
-type pvzPostRequest struct {
-	City string `json:"city" validate:"required,oneof=Москва Санкт-Петербург Казань"`
-}
-
-type pvzGetRequest struct {
-	StartDate *time.Time `query:"startDate"`
-	EndDate   *time.Time `query:"endDate"`
-	Page      *int       `query:"page" validate:"omitnil,gte=1"`
-	Limit     *int       `query:"limit" validate:"omitnil,gte=1,lte=30"`
-}
-
-type receptionResult struct {
-	Reception dto.Reception `json:"reception"`
-	Products  []dto.Product `json:"products"`
-}
+const headerNextCursor = "X-Next-Cursor"
 
-type pvzGetResponse struct {
-	Pvz        dto.PVZ           `json:"pvz"`
-	Receptions []receptionResult `json:"receptions"`
+// pvzListResponse sets the cursor header only when there is a next page, the generated response always sets it
+type pvzListResponse struct {
+	body       []dto.PVZWithReceptions
+	nextCursor string
 }
 
-type closeLastReceptionRequest struct {
-	PointID uuid.UUID `param:"pvzId" validate:"required,uuid"`
-}
+func (response pvzListResponse) VisitListPvzResponse(w http.ResponseWriter) error {
+	// Body stays a plain array for older clients, so the cursor travels in a header
+	if len(response.nextCursor) > 0 {
+		w.Header().Set(headerNextCursor, response.nextCursor)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
 
-type deleteLastProductRequest struct {
-	PointID uuid.UUID `param:"pvzId" validate:"required,uuid"`
+	return json.NewEncoder(w).Encode(response.body)
 }
 
 type pvzRoutes struct {
 	pointService service.Point
 }
 
-func newPvzRoutes(g *echo.Group, pointService service.Point, authMW *mw.Auth) {
-	r := &pvzRoutes{pointService: pointService}
-
-	g.POST("", r.postRoot, authMW.CheckRole(entity.RoleTypeModerator))
-	g.GET("", r.getRoot)
-	g.POST("/:pvzId/close_last_reception", r.closeLastReception, authMW.CheckRole(entity.RoleTypeEmployee))
-	g.POST("/:pvzId/delete_last_product", r.deleteLastProduct, authMW.CheckRole(entity.RoleTypeEmployee))
+func newPvzRoutes(pointService service.Point) *pvzRoutes {
+	return &pvzRoutes{pointService: pointService}
 }
 
-func (r *pvzRoutes) postRoot(c echo.Context) error {
-	var req pvzPostRequest
-
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
-	}
-
-	if err := c.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+func (r *pvzRoutes) CreatePvz(ctx context.Context, request dto.CreatePvzRequestObject) (dto.CreatePvzResponseObject, error) {
+	if (request.Body.Latitude == nil) != (request.Body.Longitude == nil) {
+		return nil, newValidationError("latitude", "field latitude must be set together with longitude")
 	}
 
-	point, err := r.pointService.Create(c.Request().Context(), req.City)
+	point, err := r.pointService.Create(ctx, entity.Point{
+		City:      request.Body.City,
+		Address:   lo.FromPtr(request.Body.Address),
+		Latitude:  request.Body.Latitude,
+		Longitude: request.Body.Longitude,
+		TimeZone:  lo.FromPtr(request.Body.TimeZone),
+	})
 	if err != nil {
-		if errors.Is(err, service.ErrCityNotFound) {
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		if errors.Is(err, service.ErrCityNotFound) || errors.Is(err, service.ErrInvalidTimeZone) {
+			return nil, newHTTPError(http.StatusBadRequest, err)
 		}
 
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return nil, newHTTPError(http.StatusInternalServerError, err)
 	}
 
-	return c.JSON(http.StatusCreated, dto.PVZ{
-		Id:               &point.ID,
-		RegistrationDate: &point.CreatedAt,
-		City:             dto.PVZCity(point.City),
-	})
+	return dto.CreatePvz201JSONResponse(pointToDTO(point)), nil
 }
 
-func (r *pvzRoutes) getRoot(c echo.Context) error {
-	var req pvzGetRequest
+func (r *pvzRoutes) ListPvz(ctx context.Context, request dto.ListPvzRequestObject) (dto.ListPvzResponseObject, error) {
+	params := request.Params
 
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	if params.Page != nil && params.Cursor != nil {
+		return nil, newValidationError("page", "field page cannot be used together with cursor")
 	}
 
-	if err := c.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	filter := internaldto.PointFilter{
+		StartDate: params.StartDate,
+		EndDate:   params.EndDate,
+		City:      params.City,
+		PointIDs:  lo.FromPtr(params.PvzId),
+		Sort:      internaldto.PointSort(lo.FromPtr(params.Sort)),
 	}
 
-	points, err := r.pointService.GetExtended(c.Request().Context(), req.StartDate, req.EndDate, req.Page, req.Limit)
+	if params.Status != nil {
+		filter.Status = lo.ToPtr(entity.PointStatus(*params.Status))
+	}
+
+	if params.ReceptionStatus != nil {
+		filter.ReceptionStatus = lo.ToPtr(entity.ReceptionStatus(*params.ReceptionStatus))
+	}
+
+	if params.ProductType != nil {
+		filter.ProductType = lo.ToPtr(entity.ProductType(*params.ProductType))
+	}
+
+	result, err := r.pointService.GetExtended(ctx, filter, internaldto.Pagination{
+		Page:   params.Page,
+		Limit:  params.Limit,
+		Cursor: lo.FromPtr(params.Cursor),
+	})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		if errors.Is(err, service.ErrInvalidCursor) {
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, newHTTPError(http.StatusInternalServerError, err)
 	}
 
-	response := make([]pvzGetResponse, 0, len(points))
-	for _, point := range points {
-		receptions := make([]receptionResult, 0, len(point.Receptions))
+	locale := requestLocale(params.AcceptLanguage)
+
+	response := make([]dto.PVZWithReceptions, 0, len(result.Points))
+	for _, point := range result.Points {
+		receptions := make([]dto.PVZReception, 0, len(point.Receptions))
 		for _, reception := range point.Receptions {
 			products := make([]dto.Product, 0, len(reception.Products))
 			for _, product := range reception.Products {
@@ -109,86 +117,191 @@ func (r *pvzRoutes) getRoot(c echo.Context) error {
 					Id:          &product.ID,
 					ReceptionId: product.ReceptionID,
 					DateTime:    &product.CreatedAt,
-					Type:        dto.ProductType(product.Type),
+					Type:        string(product.Type),
+					TypeName:    lo.ToPtr(entity.DisplayName(product.Type, product.TypeNames, locale)),
+					ItemCode:    product.ItemCode,
+					Status:      lo.ToPtr(dto.ProductStatus(product.Status)),
 				})
 			}
 
-			receptions = append(receptions, receptionResult{
+			reopenings := make([]dto.ReceptionReopening, 0, len(reception.Reopenings))
+			for _, reopening := range reception.Reopenings {
+				reopenings = append(reopenings, dto.ReceptionReopening{
+					Id:          reopening.ID,
+					ReceptionId: reopening.ReceptionID,
+					ReopenedBy:  reopening.ReopenedBy,
+					Reason:      reopening.Reason,
+					ReopenedAt:  reopening.ReopenedAt,
+				})
+			}
+
+			receptions = append(receptions, dto.PVZReception{
 				Reception: dto.Reception{
 					Id:       &reception.Reception.ID,
 					DateTime: reception.Reception.CreatedAt,
 					PvzId:    reception.Reception.PointID,
 					Status:   dto.ReceptionStatus(reception.Reception.Status),
 				},
-				Products: products,
+				Products:   products,
+				Reopenings: reopenings,
 			})
 		}
 
-		response = append(response, pvzGetResponse{
+		response = append(response, dto.PVZWithReceptions{
 			Pvz: dto.PVZ{
 				Id:               &point.Point.ID,
 				RegistrationDate: &point.Point.CreatedAt,
-				City:             dto.PVZCity(point.Point.City),
+				City:             point.Point.City,
+				Status:           lo.ToPtr(dto.PVZStatus(point.Point.Status)),
+				Address:          lo.ToPtr(point.Point.Address),
+				Latitude:         point.Point.Latitude,
+				Longitude:        point.Point.Longitude,
+				TimeZone:         lo.ToPtr(point.Point.TimeZone),
+			},
+			ProductCounts: dto.ProductCounts{
+				Received: point.ProductCounts[entity.ProductStatusReceived],
+				Stored:   point.ProductCounts[entity.ProductStatusStored],
+				Issued:   point.ProductCounts[entity.ProductStatusIssued],
+				Returned: point.ProductCounts[entity.ProductStatusReturned],
 			},
 			Receptions: receptions,
 		})
 	}
 
-	return c.JSON(http.StatusOK, response)
+	return pvzListResponse{body: response, nextCursor: result.NextCursor}, nil
 }
 
-func (r *pvzRoutes) closeLastReception(c echo.Context) error {
-	var req closeLastReceptionRequest
+func (r *pvzRoutes) ListNearbyPvz(ctx context.Context, request dto.ListNearbyPvzRequestObject) (dto.ListNearbyPvzResponseObject, error) {
+	params := request.Params
 
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+	points, err := r.pointService.GetNearby(ctx, params.Lat, params.Lon, params.Radius, params.Limit)
+	if err != nil {
+		return nil, newHTTPError(http.StatusInternalServerError, err)
 	}
 
-	if err := c.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	response := make(dto.ListNearbyPvz200JSONResponse, 0, len(points))
+	for _, point := range points {
+		response = append(response, dto.NearbyPVZ{
+			Pvz:      pointToDTO(point.Point),
+			Distance: point.Distance,
+		})
 	}
 
-	reception, err := r.pointService.CloseLastReception(c.Request().Context(), req.PointID)
+	return response, nil
+}
+
+func (r *pvzRoutes) GetPvz(ctx context.Context, request dto.GetPvzRequestObject) (dto.GetPvzResponseObject, error) {
+	point, err := r.pointService.GetByID(ctx, request.PvzId)
 	if err != nil {
-		if errors.Is(err, service.ErrActiveReceptionNotFound) {
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		if errors.Is(err, service.ErrPointNotFound) {
+			return nil, newHTTPError(http.StatusNotFound, err)
 		}
 
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return nil, newHTTPError(http.StatusInternalServerError, err)
 	}
 
-	return c.JSON(http.StatusOK, dto.Reception{
-		Id:       &reception.ID,
-		DateTime: reception.CreatedAt,
-		PvzId:    reception.PointID,
-		Status:   dto.ReceptionStatus(reception.Status),
-	})
+	return dto.GetPvz200JSONResponse(pointToDTO(point)), nil
+}
+
+func (r *pvzRoutes) SuspendPvz(ctx context.Context, request dto.SuspendPvzRequestObject) (dto.SuspendPvzResponseObject, error) {
+	point, err := r.changePointStatus(ctx, request.PvzId, entity.PointStatusSuspended)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.SuspendPvz200JSONResponse(point), nil
 }
 
-func (r *pvzRoutes) deleteLastProduct(c echo.Context) error {
-	var req deleteLastProductRequest
+func (r *pvzRoutes) ResumePvz(ctx context.Context, request dto.ResumePvzRequestObject) (dto.ResumePvzResponseObject, error) {
+	point, err := r.changePointStatus(ctx, request.PvzId, entity.PointStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	return dto.ResumePvz200JSONResponse(point), nil
+}
 
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+func (r *pvzRoutes) ClosePvz(ctx context.Context, request dto.ClosePvzRequestObject) (dto.ClosePvzResponseObject, error) {
+	point, err := r.changePointStatus(ctx, request.PvzId, entity.PointStatusClosed)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := c.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	return dto.ClosePvz200JSONResponse(point), nil
+}
+
+func (r *pvzRoutes) changePointStatus(ctx context.Context, pointID uuid.UUID, status entity.PointStatus) (dto.PVZ, error) {
+	point, err := r.pointService.ChangeStatus(ctx, pointID, status)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrPointNotFound):
+			return dto.PVZ{}, newHTTPError(http.StatusNotFound, err)
+		case errors.Is(err, service.ErrInvalidPointTransition):
+			return dto.PVZ{}, newHTTPError(http.StatusConflict, err)
+		default:
+			return dto.PVZ{}, newHTTPError(http.StatusInternalServerError, err)
+		}
 	}
 
-	err := r.pointService.DeleteLastProduct(c.Request().Context(), req.PointID)
+	return pointToDTO(point), nil
+}
+
+func (r *pvzRoutes) CloseLastReception(ctx context.Context, request dto.CloseLastReceptionRequestObject) (dto.CloseLastReceptionResponseObject, error) {
+	reception, err := r.pointService.CloseLastReception(ctx, request.PvzId)
+	if err != nil {
+		if errors.Is(err, service.ErrActiveReceptionNotFound) {
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		}
+
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return dto.CloseLastReception200JSONResponse(receptionToDTO(reception)), nil
+}
+
+func (r *pvzRoutes) DeleteLastProduct(ctx context.Context, request dto.DeleteLastProductRequestObject) (dto.DeleteLastProductResponseObject, error) {
+	err := r.pointService.DeleteLastProduct(ctx, request.PvzId)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrActiveReceptionNotFound):
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			return nil, newHTTPError(http.StatusBadRequest, err)
 		case errors.Is(err, service.ErrProductNotFound):
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+			return nil, newHTTPError(http.StatusBadRequest, err)
 		case errors.Is(err, service.ErrProductAlreadyDeleted):
 			break
 		default:
-			return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+			return nil, newHTTPError(http.StatusInternalServerError, err)
 		}
 	}
 
-	return c.NoContent(http.StatusOK)
+	return dto.DeleteLastProduct200Response{}, nil
+}
+
+func (r *pvzRoutes) DeleteProduct(ctx context.Context, request dto.DeleteProductRequestObject) (dto.DeleteProductResponseObject, error) {
+	err := r.pointService.DeleteProduct(ctx, request.PvzId, request.ProductId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrActiveReceptionNotFound):
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		case errors.Is(err, service.ErrProductNotFound):
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		default:
+			return nil, newHTTPError(http.StatusInternalServerError, err)
+		}
+	}
+
+	return dto.DeleteProduct200Response{}, nil
+}
+
+func pointToDTO(point entity.Point) dto.PVZ {
+	return dto.PVZ{
+		Id:               &point.ID,
+		RegistrationDate: &point.CreatedAt,
+		City:             point.City,
+		Status:           lo.ToPtr(dto.PVZStatus(point.Status)),
+		Address:          lo.ToPtr(point.Address),
+		Latitude:         point.Latitude,
+		Longitude:        point.Longitude,
+		TimeZone:         lo.ToPtr(point.TimeZone),
+	}
 }
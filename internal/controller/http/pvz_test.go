@@ -0,0 +1,44 @@
+package http
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spanwalla/pvz/internal/controller/http/dto"
+)
+
+func TestPvzListResponse_VisitListPvzResponse(t *testing.T) {
+	for _, tc := range []struct {
+		name       string
+		response   pvzListResponse
+		wantBody   string
+		wantCursor string
+	}{
+		{
+			name:       "next page",
+			response:   pvzListResponse{body: []dto.PVZWithReceptions{}, nextCursor: "opaque"},
+			wantBody:   "[]\n",
+			wantCursor: "opaque",
+		},
+		{
+			name:     "last page",
+			response: pvzListResponse{body: []dto.PVZWithReceptions{}},
+			wantBody: "[]\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rec := httptest.NewRecorder()
+
+			assert.NoError(t, tc.response.VisitListPvzResponse(rec))
+
+			// Body stays an array, older clients that page with `page` keep working
+			assert.Equal(t, tc.wantBody, rec.Body.String())
+			assert.Equal(t, tc.wantCursor, rec.Header().Get(headerNextCursor))
+			_, sent := rec.Header()[headerNextCursor]
+			assert.Equal(t, len(tc.wantCursor) > 0, sent)
+		})
+	}
+}
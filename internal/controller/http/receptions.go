@@ -1,56 +1,105 @@
 package http
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
-	"github.com/google/uuid"
-	"github.com/labstack/echo/v4"
-
 	"github.com/spanwalla/pvz/internal/controller/http/dto"
 	"github.com/spanwalla/pvz/internal/controller/http/mw"
+	internaldto "github.com/spanwalla/pvz/internal/dto"
 	"github.com/spanwalla/pvz/internal/entity"
 	"github.com/spanwalla/pvz/internal/service"
 )
 
-type receptionRequest struct {
-	PointID uuid.UUID `json:"pvzId" validate:"required,uuid"`
-}
-
 type receptionRoutes struct {
 	receptionService service.Reception
 }
 
-func newReceptionRoutes(g *echo.Group, receptionService service.Reception, authMW *mw.Auth) {
-	r := &receptionRoutes{receptionService}
+func newReceptionRoutes(receptionService service.Reception) *receptionRoutes {
+	return &receptionRoutes{receptionService}
+}
+
+func (r *receptionRoutes) CreateReception(ctx context.Context, request dto.CreateReceptionRequestObject) (dto.CreateReceptionResponseObject, error) {
+	reception, err := r.receptionService.Create(ctx, request.Body.PvzId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrReceptionAlreadyOpened), errors.Is(err, service.ErrPointNotFound),
+			errors.Is(err, service.ErrPointSuspended), errors.Is(err, service.ErrPointClosed),
+			errors.Is(err, service.ErrPointOutsideWorkingHours):
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		default:
+			return nil, newHTTPError(http.StatusInternalServerError, err)
+		}
+	}
 
-	g.POST("", r.root, authMW.CheckRole(entity.RoleTypeEmployee))
+	return dto.CreateReception201JSONResponse(receptionToDTO(reception)), nil
 }
 
-func (r *receptionRoutes) root(c echo.Context) error {
-	var req receptionRequest
+func (r *receptionRoutes) GetReception(ctx context.Context, request dto.GetReceptionRequestObject) (dto.GetReceptionResponseObject, error) {
+	details, err := r.receptionService.GetByID(ctx, request.ReceptionId)
+	if err != nil {
+		if errors.Is(err, service.ErrReceptionNotFound) {
+			return nil, newHTTPError(http.StatusNotFound, err)
+		}
 
-	if err := c.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, "invalid request body")
+		return nil, newHTTPError(http.StatusInternalServerError, err)
 	}
 
-	if err := c.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
-	}
+	return dto.GetReception200JSONResponse(receptionDetailsToDTO(details, requestLocale(request.Params.AcceptLanguage))), nil
+}
 
-	reception, err := r.receptionService.Create(c.Request().Context(), req.PointID)
+func (r *receptionRoutes) GetActiveReception(ctx context.Context, request dto.GetActiveReceptionRequestObject) (dto.GetActiveReceptionResponseObject, error) {
+	details, err := r.receptionService.GetActive(ctx, request.PvzId)
 	if err != nil {
-		if errors.Is(err, service.ErrReceptionAlreadyOpened) {
-			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		if errors.Is(err, service.ErrPointNotFound) || errors.Is(err, service.ErrActiveReceptionNotFound) {
+			return nil, newHTTPError(http.StatusNotFound, err)
 		}
 
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return dto.GetActiveReception200JSONResponse(receptionDetailsToDTO(details, requestLocale(request.Params.AcceptLanguage))), nil
+}
+
+func (r *receptionRoutes) ReopenReception(ctx context.Context, request dto.ReopenReceptionRequestObject) (dto.ReopenReceptionResponseObject, error) {
+	claims, ok := mw.ClaimsFromContext(ctx)
+	if !ok {
+		return nil, newHTTPError(http.StatusUnauthorized, mw.ErrInvalidAuthHeader)
 	}
 
-	return c.JSON(http.StatusCreated, dto.Reception{
+	reception, err := r.receptionService.Reopen(ctx, request.ReceptionId, claims.UserID, request.Body.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrClosedReceptionNotFound):
+			return nil, newHTTPError(http.StatusNotFound, err)
+		case errors.Is(err, service.ErrReceptionAlreadyOpened), errors.Is(err, service.ErrProductAlreadyScanned):
+			return nil, newHTTPError(http.StatusConflict, err)
+		default:
+			return nil, newHTTPError(http.StatusInternalServerError, err)
+		}
+	}
+
+	return dto.ReopenReception200JSONResponse(receptionToDTO(reception)), nil
+}
+
+func receptionToDTO(reception entity.Reception) dto.Reception {
+	return dto.Reception{
 		Id:       &reception.ID,
 		DateTime: reception.CreatedAt,
 		PvzId:    reception.PointID,
 		Status:   dto.ReceptionStatus(reception.Status),
-	})
+	}
+}
+
+func receptionDetailsToDTO(details internaldto.ReceptionDetails, locale string) dto.ReceptionWithProducts {
+	products := make([]dto.Product, 0, len(details.Products))
+	for _, product := range details.Products {
+		products = append(products, productToDTO(product, locale))
+	}
+
+	return dto.ReceptionWithProducts{
+		Reception: receptionToDTO(details.Reception),
+		Products:  products,
+	}
 }
@@ -10,24 +10,87 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/spanwalla/pvz/internal/controller/access"
 	"github.com/spanwalla/pvz/internal/controller/http/dto"
 	"github.com/spanwalla/pvz/internal/controller/http/mw"
+	"github.com/spanwalla/pvz/internal/entity"
 	"github.com/spanwalla/pvz/internal/service"
 )
 
 //go:generate go tool oapi-codegen --config=dto.cfg.yaml ../../../api/swagger.yaml
+//go:generate go tool oapi-codegen --config=server.cfg.yaml ../../../api/swagger.yaml
+
+// publicRoutes can be called without a token, routeAccess declares roles allowed to call the other routes.
+// ConfigureRouter panics if a registered route is missing in both.
+var publicRoutes, routeAccess = routeRules()
+
+func routeRules() (map[string]struct{}, map[string]entity.Access) {
+	public := make(map[string]struct{})
+	rules := make(map[string]entity.Access)
+	for _, op := range access.Operations {
+		if len(op.Path) == 0 {
+			continue
+		}
+
+		key := mw.RouteKey(op.HTTPMethod, op.Path)
+		if op.Public {
+			public[key] = struct{}{}
+		} else {
+			rules[key] = op.Access
+		}
+	}
+
+	return public, rules
+}
 
+// Server implements the generated strict server interface, every route group serves its own operations
 type Server struct {
+	*authRoutes
+	*cityRoutes
+	*productTypeRoutes
+	*pvzRoutes
+	*scheduleRoutes
+	*receptionRoutes
+	*productRoutes
+	*eventRoutes
+	*webhookRoutes
 }
 
-func ConfigureRouter(handler *echo.Echo, services *service.Services) {
+var _ dto.StrictServerInterface = (*Server)(nil)
+
+func newServer(services *service.Services) *Server {
+	return &Server{
+		authRoutes:        newAuthRoutes(services.Auth),
+		cityRoutes:        newCityRoutes(services.City),
+		productTypeRoutes: newProductTypeRoutes(services.ProductType),
+		pvzRoutes:         newPvzRoutes(services.Point),
+		scheduleRoutes:    newScheduleRoutes(services.Schedule),
+		receptionRoutes:   newReceptionRoutes(services.Reception),
+		productRoutes:     newProductRoutes(services.Product),
+		eventRoutes:       newEventRoutes(services.Event),
+		webhookRoutes:     newWebhookRoutes(services.Webhook),
+	}
+}
+
+// ConfigureRouter registers operations of api/swagger.yaml. Requests are validated against the spec,
+// responses are validated too when validateResponses is set.
+func ConfigureRouter(handler *echo.Echo, services *service.Services, validateResponses bool) {
 	swagger, err := dto.GetSwagger()
 	if err != nil {
 		panic(fmt.Errorf("app - ConfigureRouter - GetSwagger: %w", err))
 	}
 	swagger.Servers = nil
 
-	handler.Use(middleware.CORS())
+	openAPIMW, err := mw.NewOpenAPI(swagger)
+	if err != nil {
+		panic(fmt.Errorf("app - ConfigureRouter - mw.NewOpenAPI: %w", err))
+	}
+
+	handler.HTTPErrorHandler = errorHandler
+
+	handler.Use(middleware.CORSWithConfig(middleware.CORSConfig{
+		ExposeHeaders: []string{headerNextCursor, mw.HeaderIdempotentReplayed},
+	}))
 	handler.Use(middleware.LoggerWithConfig(middleware.LoggerConfig{
 		Format: `{"time":"${time_rfc3339_nano}", "method":"${method}","uri":"${uri}", "status":${status},"error":"${error}"}` + "\n",
 		Output: setLogsFile(),
@@ -36,21 +99,29 @@ func ConfigureRouter(handler *echo.Echo, services *service.Services) {
 	handler.Use(middleware.Recover())
 	handler.Use(echoprometheus.NewMiddleware("app"))
 
-	handler.GET("/health", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
-
-	authGroup := handler.Group("")
-	newAuthRoutes(authGroup, services.Auth)
-
 	authMW := mw.NewAuth(services.Auth)
+	idempotencyMW := mw.NewIdempotency(services.Idempotency)
 
-	pvzGroup := handler.Group("/pvz", authMW.UserIdentity())
-	newPvzRoutes(pvzGroup, services.Point, authMW)
+	handler.Use(authMW.Authorize(publicRoutes, routeAccess))
+	handler.Use(idempotencyMW.Handle())
+	handler.Use(openAPIMW.ValidateRequest())
+	if validateResponses {
+		handler.Use(openAPIMW.ValidateResponse())
+	}
+
+	handler.GET("/health", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
 
-	receptionsGroup := handler.Group("/receptions", authMW.UserIdentity())
-	newReceptionRoutes(receptionsGroup, services.Reception, authMW)
+	dto.RegisterHandlers(handler, dto.NewStrictHandler(newServer(services), nil))
 
-	productsGroup := handler.Group("/products", authMW.UserIdentity())
-	newProductRoutes(productsGroup, services.Product, authMW)
+	for _, route := range handler.Routes() {
+		key := mw.RouteKey(route.Method, route.Path)
+		if _, ok := publicRoutes[key]; ok {
+			continue
+		}
+		if _, ok := routeAccess[key]; !ok {
+			panic(fmt.Errorf("app - ConfigureRouter - no access rule for %s", key))
+		}
+	}
 }
 
 func setLogsFile() *os.File {
@@ -0,0 +1,127 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/spanwalla/pvz/internal/controller/http/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+type scheduleRoutes struct {
+	scheduleService service.Schedule
+}
+
+func newScheduleRoutes(scheduleService service.Schedule) *scheduleRoutes {
+	return &scheduleRoutes{scheduleService}
+}
+
+func (r *scheduleRoutes) GetSchedule(ctx context.Context, request dto.GetScheduleRequestObject) (dto.GetScheduleResponseObject, error) {
+	schedule, err := r.scheduleService.Get(ctx, request.PvzId)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	return dto.GetSchedule200JSONResponse(scheduleToDTO(schedule)), nil
+}
+
+func (r *scheduleRoutes) UpdateSchedule(ctx context.Context, request dto.UpdateScheduleRequestObject) (dto.UpdateScheduleResponseObject, error) {
+	input := entity.Schedule{
+		TimeZone:     request.Body.TimeZone,
+		WorkingHours: make([]entity.WorkingHours, 0, len(request.Body.WorkingHours)),
+		Holidays:     make([]entity.Holiday, 0, len(request.Body.Holidays)),
+	}
+
+	for _, hours := range request.Body.WorkingHours {
+		input.WorkingHours = append(input.WorkingHours, entity.WorkingHours{
+			Weekday:  time.Weekday(hours.Weekday % 7),
+			OpensAt:  hours.OpensAt,
+			ClosesAt: hours.ClosesAt,
+		})
+	}
+
+	for _, holiday := range request.Body.Holidays {
+		// Shortened hours need both bounds, a holiday without them is a day off
+		if (holiday.OpensAt == nil) != (holiday.ClosesAt == nil) {
+			return nil, newValidationError("holidays", "field holidays must set both opensAt and closesAt or neither")
+		}
+
+		input.Holidays = append(input.Holidays, entity.Holiday{
+			Date:     holiday.Date,
+			OpensAt:  holiday.OpensAt,
+			ClosesAt: holiday.ClosesAt,
+		})
+	}
+
+	schedule, err := r.scheduleService.Update(ctx, request.PvzId, input)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	return dto.UpdateSchedule200JSONResponse(scheduleToDTO(schedule)), nil
+}
+
+func (r *scheduleRoutes) SetScheduleOverride(ctx context.Context, request dto.SetScheduleOverrideRequestObject) (dto.SetScheduleOverrideResponseObject, error) {
+	schedule, err := r.scheduleService.SetOverride(ctx, request.PvzId, request.Body.Until)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	return dto.SetScheduleOverride200JSONResponse(scheduleToDTO(schedule)), nil
+}
+
+func (r *scheduleRoutes) ClearScheduleOverride(ctx context.Context, request dto.ClearScheduleOverrideRequestObject) (dto.ClearScheduleOverrideResponseObject, error) {
+	schedule, err := r.scheduleService.ClearOverride(ctx, request.PvzId)
+	if err != nil {
+		return nil, scheduleError(err)
+	}
+
+	return dto.ClearScheduleOverride200JSONResponse(scheduleToDTO(schedule)), nil
+}
+
+func scheduleError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrPointNotFound):
+		return newHTTPError(http.StatusNotFound, err)
+	case errors.Is(err, service.ErrInvalidTimeZone), errors.Is(err, service.ErrInvalidSchedule),
+		errors.Is(err, service.ErrInvalidOverride):
+		return newHTTPError(http.StatusBadRequest, err)
+	default:
+		return newHTTPError(http.StatusInternalServerError, err)
+	}
+}
+
+func scheduleToDTO(schedule entity.Schedule) dto.Schedule {
+	out := dto.Schedule{
+		TimeZone:      schedule.TimeZone,
+		WorkingHours:  make([]dto.WorkingHours, 0, len(schedule.WorkingHours)),
+		Holidays:      make([]dto.Holiday, 0, len(schedule.Holidays)),
+		OverrideUntil: schedule.OverrideUntil,
+	}
+
+	for _, hours := range schedule.WorkingHours {
+		weekday := int(hours.Weekday)
+		if hours.Weekday == time.Sunday {
+			weekday = 7
+		}
+
+		out.WorkingHours = append(out.WorkingHours, dto.WorkingHours{
+			Weekday:  weekday,
+			OpensAt:  hours.OpensAt,
+			ClosesAt: hours.ClosesAt,
+		})
+	}
+
+	for _, holiday := range schedule.Holidays {
+		out.Holidays = append(out.Holidays, dto.Holiday{
+			Date:     holiday.Date,
+			OpensAt:  holiday.OpensAt,
+			ClosesAt: holiday.ClosesAt,
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,124 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/spanwalla/pvz/internal/controller/http/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+type webhookRoutes struct {
+	webhookService service.Webhook
+}
+
+func newWebhookRoutes(webhookService service.Webhook) *webhookRoutes {
+	return &webhookRoutes{webhookService}
+}
+
+func (r *webhookRoutes) ListWebhooks(ctx context.Context, _ dto.ListWebhooksRequestObject) (dto.ListWebhooksResponseObject, error) {
+	webhooks, err := r.webhookService.GetAll(ctx)
+	if err != nil {
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	response := make(dto.ListWebhooks200JSONResponse, 0, len(webhooks))
+	for _, webhook := range webhooks {
+		response = append(response, webhookToDTO(webhook))
+	}
+
+	return response, nil
+}
+
+func (r *webhookRoutes) CreateWebhook(ctx context.Context, request dto.CreateWebhookRequestObject) (dto.CreateWebhookResponseObject, error) {
+	eventTypes := make([]entity.EventType, len(request.Body.EventTypes))
+	for i, eventType := range request.Body.EventTypes {
+		eventTypes[i] = entity.EventType(eventType)
+	}
+
+	webhook, err := r.webhookService.Create(ctx, entity.Webhook{
+		URL:        request.Body.Url,
+		EventTypes: eventTypes,
+		PointID:    request.Body.PvzId,
+		City:       request.Body.City,
+		Secret:     request.Body.Secret,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidWebhookURL), errors.Is(err, service.ErrForbiddenWebhookURL):
+			return nil, newHTTPError(http.StatusBadRequest, err)
+		case errors.Is(err, service.ErrPointNotFound):
+			return nil, newHTTPError(http.StatusNotFound, err)
+		default:
+			return nil, newHTTPError(http.StatusInternalServerError, err)
+		}
+	}
+
+	return dto.CreateWebhook201JSONResponse(webhookToDTO(webhook)), nil
+}
+
+func (r *webhookRoutes) DeleteWebhook(ctx context.Context, request dto.DeleteWebhookRequestObject) (dto.DeleteWebhookResponseObject, error) {
+	if err := r.webhookService.Delete(ctx, request.WebhookId); err != nil {
+		if errors.Is(err, service.ErrWebhookNotFound) {
+			return nil, newHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	return dto.DeleteWebhook200Response{}, nil
+}
+
+func (r *webhookRoutes) ListWebhookDeliveries(ctx context.Context, request dto.ListWebhookDeliveriesRequestObject) (dto.ListWebhookDeliveriesResponseObject, error) {
+	var status *entity.WebhookDeliveryStatus
+	if request.Params.Status != nil {
+		value := entity.WebhookDeliveryStatus(*request.Params.Status)
+		status = &value
+	}
+
+	deliveries, err := r.webhookService.GetDeliveries(ctx, request.WebhookId, status, request.Params.Limit)
+	if err != nil {
+		if errors.Is(err, service.ErrWebhookNotFound) {
+			return nil, newHTTPError(http.StatusNotFound, err)
+		}
+
+		return nil, newHTTPError(http.StatusInternalServerError, err)
+	}
+
+	response := make(dto.ListWebhookDeliveries200JSONResponse, 0, len(deliveries))
+	for _, delivery := range deliveries {
+		response = append(response, dto.WebhookDelivery{
+			Id:             delivery.ID,
+			EventId:        delivery.EventID,
+			EventType:      dto.EventType(delivery.EventType),
+			Status:         dto.WebhookDeliveryStatus(delivery.Status),
+			Attempts:       delivery.Attempts,
+			NextAttemptAt:  delivery.NextAttemptAt,
+			ResponseStatus: delivery.ResponseStatus,
+			LastError:      delivery.LastError,
+			CreatedAt:      delivery.CreatedAt,
+			DeliveredAt:    delivery.DeliveredAt,
+		})
+	}
+
+	return response, nil
+}
+
+// webhookToDTO leaves the secret out, it is never returned after creation
+func webhookToDTO(webhook entity.Webhook) dto.Webhook {
+	eventTypes := make([]dto.EventType, len(webhook.EventTypes))
+	for i, eventType := range webhook.EventTypes {
+		eventTypes[i] = dto.EventType(eventType)
+	}
+
+	return dto.Webhook{
+		Id:         webhook.ID,
+		Url:        webhook.URL,
+		EventTypes: eventTypes,
+		PvzId:      webhook.PointID,
+		City:       webhook.City,
+		CreatedAt:  webhook.CreatedAt,
+	}
+}
@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"github.com/google/uuid"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+type EventFilter struct {
+	PointID *uuid.UUID
+	City    *string
+}
+
+func (f EventFilter) Match(event entity.Event) bool {
+	if f.PointID != nil && event.PointID != *f.PointID {
+		return false
+	}
+
+	if f.City != nil && event.City != *f.City {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,9 @@
+package dto
+
+import "github.com/spanwalla/pvz/internal/entity"
+
+// OutboxDispatch is a claimed event together with its delivery state for one sink
+type OutboxDispatch struct {
+	Event    entity.Event
+	Delivery entity.OutboxDelivery
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var errMalformedCursor = errors.New("malformed cursor")
+
+// PointCursor is the keyset position of the last point on a page. Points are ordered by (created_at, id),
+// or by (city, created_at, id) when sorted by city, so the cursor is valid only for the sort it was made for.
+type PointCursor struct {
+	Sort      PointSort `json:"s,omitempty"`
+	City      string    `json:"c,omitempty"`
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// Encode returns opaque URL-safe representation of the cursor
+func (c PointCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodePointCursor(s string) (PointCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return PointCursor{}, errMalformedCursor
+	}
+
+	var cursor PointCursor
+	if err = json.Unmarshal(raw, &cursor); err != nil || cursor.ID == uuid.Nil || cursor.CreatedAt.IsZero() {
+		return PointCursor{}, errMalformedCursor
+	}
+
+	// Cursors issued before sorting was added carry no sort, they were made for the default one
+	if len(cursor.Sort) == 0 {
+		cursor.Sort = PointSortCreatedAtAsc
+	}
+
+	return cursor, nil
+}
+
+// Pagination selects a page either by number or by cursor, cursor takes precedence
+type Pagination struct {
+	Page   *int
+	Limit  *int
+	Cursor string
+}
+
+type PointPage struct {
+	Points []PointOutput
+	// NextCursor is empty on the last page
+	NextCursor string
+}
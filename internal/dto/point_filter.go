@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+// PointSort is the listing order, a leading minus means descending
+type PointSort string
+
+const (
+	PointSortCreatedAtAsc  PointSort = "createdAt"
+	PointSortCreatedAtDesc PointSort = "-createdAt"
+	PointSortCityAsc       PointSort = "city"
+	PointSortCityDesc      PointSort = "-city"
+)
+
+type PointFilter struct {
+	StartDate *time.Time
+	EndDate   *time.Time
+	Status    *entity.PointStatus
+	City      *string
+	PointIDs  []uuid.UUID
+	// ReceptionStatus and ProductType keep only points having a matching reception in the date range,
+	// both conditions apply to the same reception
+	ReceptionStatus *entity.ReceptionStatus
+	ProductType     *entity.ProductType
+	// Empty sort means PointSortCreatedAtAsc
+	Sort PointSort
+}
+
+// Descending reports whether the sort order is reversed
+func (s PointSort) Descending() bool {
+	return s == PointSortCreatedAtDesc || s == PointSortCityDesc
+}
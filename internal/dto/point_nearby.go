@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/spanwalla/pvz/internal/entity"
+
+type NearbyPoint struct {
+	Point    entity.Point
+	Distance float64 // meters
+}
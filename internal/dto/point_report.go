@@ -9,16 +9,24 @@ import (
 )
 
 type Point struct {
-	ID        uuid.UUID `json:"id"`
-	CreatedAt time.Time `json:"createdAt"`
-	City      string    `json:"city"`
+	ID        uuid.UUID          `json:"id"`
+	CreatedAt time.Time          `json:"createdAt"`
+	City      string             `json:"city"`
+	Status    entity.PointStatus `json:"status"`
+	Address   string             `json:"address"`
+	Latitude  *float64           `json:"latitude"`
+	Longitude *float64           `json:"longitude"`
+	TimeZone  string             `json:"timeZone"`
 }
 
 type Product struct {
-	ID          uuid.UUID          `json:"id"`
-	ReceptionID uuid.UUID          `json:"receptionId"`
-	CreatedAt   time.Time          `json:"createdAt"`
-	Type        entity.ProductType `json:"type"`
+	ID          uuid.UUID            `json:"id"`
+	ReceptionID uuid.UUID            `json:"receptionId"`
+	CreatedAt   time.Time            `json:"createdAt"`
+	Type        entity.ProductType   `json:"type"`
+	TypeNames   map[string]string    `json:"typeNames"`
+	ItemCode    string               `json:"itemCode"`
+	Status      entity.ProductStatus `json:"status"`
 }
 
 type Reception struct {
@@ -29,11 +37,21 @@ type Reception struct {
 }
 
 type PointOutput struct {
-	Point      Point             `json:"point"`
-	Receptions []ReceptionResult `json:"receptions"`
+	Point         Point                        `json:"point"`
+	Receptions    []ReceptionResult            `json:"receptions"`
+	ProductCounts map[entity.ProductStatus]int `json:"productCounts"`
+}
+
+type ReceptionReopening struct {
+	ID          uuid.UUID `json:"id"`
+	ReceptionID uuid.UUID `json:"receptionId"`
+	ReopenedBy  uuid.UUID `json:"reopenedBy"`
+	Reason      string    `json:"reason"`
+	ReopenedAt  time.Time `json:"reopenedAt"`
 }
 
 type ReceptionResult struct {
-	Reception Reception `json:"reception"`
-	Products  []Product `json:"products"`
+	Reception  Reception            `json:"reception"`
+	Products   []Product            `json:"products"`
+	Reopenings []ReceptionReopening `json:"reopenings"`
 }
@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/spanwalla/pvz/internal/entity"
+
+type ProductInput struct {
+	Type     entity.ProductType
+	ItemCode string
+}
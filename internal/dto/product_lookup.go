@@ -0,0 +1,9 @@
+package dto
+
+import "github.com/spanwalla/pvz/internal/entity"
+
+type ProductLookup struct {
+	Product   entity.Product
+	Reception entity.Reception
+	Point     entity.Point
+}
@@ -0,0 +1,8 @@
+package dto
+
+import "github.com/spanwalla/pvz/internal/entity"
+
+type ReceptionDetails struct {
+	Reception entity.Reception
+	Products  []entity.Product
+}
@@ -0,0 +1,10 @@
+package dto
+
+import "github.com/spanwalla/pvz/internal/entity"
+
+// WebhookDispatch is a due delivery together with the target it is sent to
+type WebhookDispatch struct {
+	Delivery entity.WebhookDelivery
+	URL      string
+	Secret   string
+}
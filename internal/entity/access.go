@@ -0,0 +1,19 @@
+package entity
+
+// Access lists roles allowed to perform an operation, empty list allows any authenticated user.
+// Both HTTP middleware and gRPC interceptors check roles through it.
+type Access []RoleType
+
+func (a Access) Allows(role RoleType) bool {
+	if len(a) == 0 {
+		return true
+	}
+
+	for _, allowed := range a {
+		if allowed == role {
+			return true
+		}
+	}
+
+	return false
+}
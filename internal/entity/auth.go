@@ -1,12 +1,37 @@
 package entity
 
 import (
+	"time"
+
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 )
 
+// TokenClaims of an access token, the token id (`jti`) is used to revoke it
 type TokenClaims struct {
 	jwt.RegisteredClaims
 	UserID uuid.UUID `json:"userId"`
 	Role   RoleType  `json:"role"`
 }
+
+// RefreshToken is a server-side session issued together with an access token. Every refresh rotates it:
+// the token is marked as used and a new one is issued in the same family.
+type RefreshToken struct {
+	ID              uuid.UUID
+	FamilyID        uuid.UUID
+	UserID          uuid.UUID
+	Role            RoleType
+	TokenHash       string
+	AccessTokenID   uuid.UUID
+	AccessExpiresAt time.Time
+	CreatedAt       time.Time
+	ExpiresAt       time.Time
+	UsedAt          *time.Time
+	RevokedAt       *time.Time
+}
+
+// RevokedToken is an access token rejected until it expires
+type RevokedToken struct {
+	ID        uuid.UUID
+	ExpiresAt time.Time
+}
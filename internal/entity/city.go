@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+type City struct {
+	ID        int       `db:"id"`
+	Name      string    `db:"name"`
+	IsActive  bool      `db:"is_active"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type EventType string
+
+const (
+	EventTypeReceptionCreated EventType = "reception_created"
+	EventTypeReceptionClosed  EventType = "reception_closed"
+	EventTypeProductAdded     EventType = "product_added"
+	EventTypeProductDeleted   EventType = "product_deleted"
+)
+
+// Event describes committed change of a reception, ProductID is set only for product events.
+// Events are delivered at least once, ID stays the same across repeated deliveries.
+type Event struct {
+	ID          uuid.UUID
+	Type        EventType
+	PointID     uuid.UUID
+	City        string
+	ReceptionID uuid.UUID
+	ProductID   *uuid.UUID
+	OccurredAt  time.Time
+}
+
+type OutboxDeliveryStatus string
+
+const (
+	OutboxDeliveryStatusPending   OutboxDeliveryStatus = "pending"
+	OutboxDeliveryStatusPublished OutboxDeliveryStatus = "published"
+	OutboxDeliveryStatusDead      OutboxDeliveryStatus = "dead"
+)
+
+// OutboxDelivery is one stored event relayed to one sink. Failed attempts are retried until the delivery is dead.
+type OutboxDelivery struct {
+	EventID       uuid.UUID
+	Sink          string
+	Status        OutboxDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     *string
+	PublishedAt   *time.Time
+}
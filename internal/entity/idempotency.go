@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyRecord remembers the first response to a request made with an Idempotency-Key.
+// Response is nil while the first request is still being processed.
+type IdempotencyRecord struct {
+	UserID      uuid.UUID           `db:"user_id"`
+	Key         string              `db:"key"`
+	RequestHash string              `db:"request_hash"`
+	Response    *IdempotentResponse `db:"-"`
+	ExpiresAt   time.Time           `db:"expires_at"`
+}
+
+type IdempotentResponse struct {
+	StatusCode  int    `db:"status_code"`
+	ContentType string `db:"content_type"`
+	Body        []byte `db:"response_body"`
+}
@@ -7,7 +7,33 @@ import (
 )
 
 type Point struct {
-	ID        uuid.UUID `db:"id"`
-	CreatedAt time.Time `db:"created_at"`
-	City      string    `db:"city"`
+	ID        uuid.UUID   `db:"id"`
+	CreatedAt time.Time   `db:"created_at"`
+	City      string      `db:"city"`
+	Status    PointStatus `db:"status"`
+	Address   string      `db:"address"`
+	Latitude  *float64    `db:"latitude"`
+	Longitude *float64    `db:"longitude"`
+	TimeZone  string      `db:"time_zone"`
+}
+
+type PointStatus string
+
+const (
+	PointStatusActive    PointStatus = "active"
+	PointStatusSuspended PointStatus = "suspended"
+	PointStatusClosed    PointStatus = "closed"
+)
+
+// CanTransitionTo reports whether point lifecycle allows moving from s to next.
+// Closed is terminal, suspended point can be resumed or closed.
+func (s PointStatus) CanTransitionTo(next PointStatus) bool {
+	switch s {
+	case PointStatusActive:
+		return next == PointStatusSuspended || next == PointStatusClosed
+	case PointStatusSuspended:
+		return next == PointStatusActive || next == PointStatusClosed
+	default:
+		return false
+	}
 }
@@ -6,17 +6,92 @@ import (
 	"github.com/google/uuid"
 )
 
+// DefaultLocale is used for product type display names when the requested locale is missing
+const DefaultLocale = "ru"
+
 type Product struct {
-	ID          uuid.UUID   `db:"id"`
-	ReceptionID uuid.UUID   `db:"reception_id"`
-	CreatedAt   time.Time   `db:"created_at"`
-	Type        ProductType `db:"type"`
+	ID          uuid.UUID         `db:"id"`
+	ReceptionID uuid.UUID         `db:"reception_id"`
+	CreatedAt   time.Time         `db:"created_at"`
+	Type        ProductType       `db:"type"`
+	TypeNames   map[string]string `db:"type_names"`
+	ItemCode    string            `db:"item_code"`
+	Status      ProductStatus     `db:"status"`
+}
+
+type ProductStatus string
+
+const (
+	ProductStatusReceived ProductStatus = "received"
+	ProductStatusStored   ProductStatus = "stored"
+	ProductStatusIssued   ProductStatus = "issued"
+	ProductStatusReturned ProductStatus = "returned"
+)
+
+// ProductStatuses lists all product statuses in lifecycle order
+var ProductStatuses = []ProductStatus{
+	ProductStatusReceived,
+	ProductStatusStored,
+	ProductStatusIssued,
+	ProductStatusReturned,
 }
 
+// CanTransitionTo reports whether product lifecycle allows moving from s to next.
+// Stored product goes back to received only when its reception is reopened.
+// Issued and returned are terminal.
+func (s ProductStatus) CanTransitionTo(next ProductStatus) bool {
+	switch s {
+	case ProductStatusReceived:
+		return next == ProductStatusStored
+	case ProductStatusStored:
+		return next == ProductStatusIssued || next == ProductStatusReturned || next == ProductStatusReceived
+	default:
+		return false
+	}
+}
+
+// ProductType is a stable machine code of the product type catalogue entry
 type ProductType string
 
 const (
-	ProductTypeElectronics ProductType = "электроника"
-	ProductTypeClothes     ProductType = "одежда"
-	ProductTypeShoes       ProductType = "обувь"
+	ProductTypeElectronics ProductType = "electronics"
+	ProductTypeClothes     ProductType = "clothes"
+	ProductTypeShoes       ProductType = "shoes"
 )
+
+// legacyProductTypes maps values of the enum that preceded the catalogue to their codes, older scanners still send them
+var legacyProductTypes = map[ProductType]ProductType{
+	"электроника": ProductTypeElectronics,
+	"одежда":      ProductTypeClothes,
+	"обувь":       ProductTypeShoes,
+}
+
+// CanonicalProductType returns the catalogue code of a legacy value and any other value as is
+func CanonicalProductType(productType ProductType) ProductType {
+	if code, ok := legacyProductTypes[productType]; ok {
+		return code
+	}
+
+	return productType
+}
+
+type ProductTypeEntry struct {
+	ID        int               `db:"id"`
+	Code      ProductType       `db:"code"`
+	Names     map[string]string `db:"names"`
+	IsActive  bool              `db:"is_active"`
+	CreatedAt time.Time         `db:"created_at"`
+}
+
+// DisplayName returns the product type name for locale, falling back to DefaultLocale and then to the code
+func DisplayName(code ProductType, names map[string]string, locale string) string {
+	if name, ok := names[locale]; ok {
+		return name
+	}
+
+	if name, ok := names[DefaultLocale]; ok {
+		return name
+	}
+
+	return string(code)
+}
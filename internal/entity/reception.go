@@ -19,3 +19,11 @@ const (
 	ReceptionStatusInProgress = "in_progress"
 	ReceptionStatusClosed     = "close"
 )
+
+type ReceptionReopening struct {
+	ID          uuid.UUID `db:"id"`
+	ReceptionID uuid.UUID `db:"reception_id"`
+	ReopenedBy  uuid.UUID `db:"reopened_by"`
+	Reason      string    `db:"reason"`
+	ReopenedAt  time.Time `db:"reopened_at"`
+}
@@ -0,0 +1,72 @@
+package entity
+
+import "time"
+
+const (
+	DefaultTimeZone = "Europe/Moscow"
+	TimeOfDayLayout = "15:04"
+	DateLayout      = "2006-01-02"
+)
+
+// WorkingHours is an opening interval for a weekday in point local time, closing time is exclusive
+type WorkingHours struct {
+	Weekday  time.Weekday `db:"weekday"`
+	OpensAt  string       `db:"opens_at"`
+	ClosesAt string       `db:"closes_at"`
+}
+
+// Holiday replaces weekly working hours for a single local date.
+// Point is closed for the whole day when opening and closing times are empty.
+type Holiday struct {
+	Date     string  `db:"date"`
+	OpensAt  *string `db:"opens_at"`
+	ClosesAt *string `db:"closes_at"`
+}
+
+type Schedule struct {
+	TimeZone      string
+	WorkingHours  []WorkingHours
+	Holidays      []Holiday
+	OverrideUntil *time.Time
+}
+
+// IsOpen reports whether point accepts receptions and products at the given moment.
+// Point without configured working hours is considered always open.
+func (s Schedule) IsOpen(at time.Time) (bool, error) {
+	if s.OverrideUntil != nil && at.Before(*s.OverrideUntil) {
+		return true, nil
+	}
+
+	loc, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return false, err
+	}
+
+	local := at.In(loc)
+	clock := local.Format(TimeOfDayLayout)
+	date := local.Format(DateLayout)
+
+	for _, holiday := range s.Holidays {
+		if holiday.Date != date {
+			continue
+		}
+
+		if holiday.OpensAt == nil || holiday.ClosesAt == nil {
+			return false, nil
+		}
+
+		return clock >= *holiday.OpensAt && clock < *holiday.ClosesAt, nil
+	}
+
+	if len(s.WorkingHours) == 0 {
+		return true, nil
+	}
+
+	for _, hours := range s.WorkingHours {
+		if hours.Weekday == local.Weekday() {
+			return clock >= hours.OpensAt && clock < hours.ClosesAt, nil
+		}
+	}
+
+	return false, nil
+}
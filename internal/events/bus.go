@@ -0,0 +1,81 @@
+package events
+
+import (
+	"context"
+	"sync"
+
+	"github.com/jonboulle/clockwork"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+const defaultBufferSize = 64
+
+type subscription struct {
+	match func(entity.Event) bool
+	ch    chan entity.Event
+}
+
+// Bus delivers events to in-process subscribers.
+// Publish never blocks, so events are dropped for subscribers that do not keep up.
+type Bus struct {
+	mu            sync.RWMutex
+	subscriptions map[*subscription]struct{}
+	clock         clockwork.Clock
+	bufferSize    int
+}
+
+func NewBus(clock clockwork.Clock) *Bus {
+	return &Bus{
+		subscriptions: make(map[*subscription]struct{}),
+		clock:         clock,
+		bufferSize:    defaultBufferSize,
+	}
+}
+
+func (b *Bus) Publish(_ context.Context, event entity.Event) error {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = b.clock.Now()
+	}
+
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subscriptions {
+		if !sub.match(event) {
+			continue
+		}
+
+		select {
+		case sub.ch <- event:
+		default:
+			log.Warnf("events.Bus.Publish - subscriber is too slow, %s event dropped", event.Type)
+		}
+	}
+
+	return nil
+}
+
+func (b *Bus) Subscribe(match func(entity.Event) bool) (<-chan entity.Event, func()) {
+	sub := &subscription{
+		match: match,
+		ch:    make(chan entity.Event, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	b.subscriptions[sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subscriptions, sub)
+			b.mu.Unlock()
+			close(sub.ch)
+		})
+	}
+
+	return sub.ch, cancel
+}
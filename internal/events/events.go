@@ -0,0 +1,19 @@
+package events
+
+import (
+	"context"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+//go:generate go tool mockgen -source=events.go -destination=mocks/mock_events.go -package=mocks
+
+type Publisher interface {
+	// Publish delivers committed event, an error means it must be published again
+	Publish(ctx context.Context, event entity.Event) error
+}
+
+type Subscriber interface {
+	// Subscribe returns channel with events accepted by match and function that closes it
+	Subscribe(match func(entity.Event) bool) (<-chan entity.Event, func())
+}
@@ -0,0 +1,51 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+// FilePublisher appends events to a file as JSON lines
+type FilePublisher struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+func NewFilePublisher(path string) (*FilePublisher, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("events - NewFilePublisher - os.OpenFile: %w", err)
+	}
+
+	return &FilePublisher{file: file}, nil
+}
+
+// Publish returns after the line is synced to disk, so a published event survives a crash
+func (p *FilePublisher) Publish(_ context.Context, event entity.Event) error {
+	line, err := json.Marshal(newMessage(event))
+	if err != nil {
+		return fmt.Errorf("FilePublisher.Publish - json.Marshal: %w", err)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, err = p.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("FilePublisher.Publish - file.Write: %w", err)
+	}
+
+	if err = p.file.Sync(); err != nil {
+		return fmt.Errorf("FilePublisher.Publish - file.Sync: %w", err)
+	}
+
+	return nil
+}
+
+func (p *FilePublisher) Close() error {
+	return p.file.Close()
+}
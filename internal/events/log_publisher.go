@@ -0,0 +1,33 @@
+package events
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+// LogPublisher writes events to the application log, it is meant for development
+type LogPublisher struct{}
+
+func NewLogPublisher() *LogPublisher {
+	return &LogPublisher{}
+}
+
+func (p *LogPublisher) Publish(_ context.Context, event entity.Event) error {
+	entry := log.WithFields(log.Fields{
+		"id":          event.ID,
+		"type":        event.Type,
+		"pvzId":       event.PointID,
+		"city":        event.City,
+		"receptionId": event.ReceptionID,
+		"occurredAt":  event.OccurredAt,
+	})
+	if event.ProductID != nil {
+		entry = entry.WithField("productId", *event.ProductID)
+	}
+
+	entry.Info("event published")
+	return nil
+}
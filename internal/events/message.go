@@ -0,0 +1,32 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/spanwalla/pvz/internal/entity"
+)
+
+// message is the JSON form of an event written by the file and HTTP publishers
+type message struct {
+	ID          uuid.UUID        `json:"id"`
+	Type        entity.EventType `json:"type"`
+	PointID     uuid.UUID        `json:"pvzId"`
+	City        string           `json:"city"`
+	ReceptionID uuid.UUID        `json:"receptionId"`
+	ProductID   *uuid.UUID       `json:"productId,omitempty"`
+	OccurredAt  time.Time        `json:"occurredAt"`
+}
+
+func newMessage(event entity.Event) message {
+	return message{
+		ID:          event.ID,
+		Type:        event.Type,
+		PointID:     event.PointID,
+		City:        event.City,
+		ReceptionID: event.ReceptionID,
+		ProductID:   event.ProductID,
+		OccurredAt:  event.OccurredAt,
+	}
+}
@@ -6,6 +6,7 @@ import "github.com/prometheus/client_golang/prometheus"
 
 type Counter interface {
 	Inc()
+	Add(delta float64)
 }
 
 type Counters struct {
@@ -33,6 +34,10 @@ func (p *PrometheusCounter) Inc() {
 	p.counter.Inc()
 }
 
+func (p *PrometheusCounter) Add(delta float64) {
+	p.counter.Add(delta)
+}
+
 func New() *Counters {
 	return &Counters{
 		PointsCreated:     NewPrometheusCounter("points_created_total", "Number of points created"),
@@ -39,6 +39,18 @@ func (m *MockCounter) EXPECT() *MockCounterMockRecorder {
 	return m.recorder
 }
 
+// Add mocks base method.
+func (m *MockCounter) Add(delta float64) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Add", delta)
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCounterMockRecorder) Add(delta any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCounter)(nil).Add), delta)
+}
+
 // Inc mocks base method.
 func (m *MockCounter) Inc() {
 	m.ctrl.T.Helper()
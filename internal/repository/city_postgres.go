@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/pkg/postgres"
+)
+
+type CityRepository struct {
+	*postgres.Postgres
+}
+
+func NewCityRepository(pg *postgres.Postgres) *CityRepository {
+	return &CityRepository{pg}
+}
+
+func (r *CityRepository) Create(ctx context.Context, name string) (entity.City, error) {
+	sql, args, _ := r.Builder.
+		Insert("cities").
+		Columns("name").
+		Values(name).
+		Suffix("RETURNING id, is_active, created_at").
+		ToSql()
+
+	city := entity.City{Name: name}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&city.ID,
+		&city.IsActive,
+		&city.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok {
+			if pgErr.Code == pgerrcode.UniqueViolation {
+				return entity.City{}, ErrAlreadyExists
+			}
+		}
+
+		return entity.City{}, fmt.Errorf("CityRepository.Create - QueryRow: %w", err)
+	}
+
+	return city, nil
+}
+
+func (r *CityRepository) GetAll(ctx context.Context, includeInactive bool) ([]entity.City, error) {
+	query := r.Builder.
+		Select("id, name, is_active, created_at").
+		From("cities").
+		OrderBy("name")
+
+	if !includeInactive {
+		query = query.Where("is_active")
+	}
+
+	sql, args, _ := query.ToSql()
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("CityRepository.GetAll - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var cities []entity.City
+	for rows.Next() {
+		var city entity.City
+		if err = rows.Scan(&city.ID, &city.Name, &city.IsActive, &city.CreatedAt); err != nil {
+			return nil, fmt.Errorf("CityRepository.GetAll - rows.Scan: %w", err)
+		}
+
+		cities = append(cities, city)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("CityRepository.GetAll - rows.Err: %w", err)
+	}
+
+	return cities, nil
+}
+
+func (r *CityRepository) Rename(ctx context.Context, cityID int, name string) (entity.City, error) {
+	sql, args, _ := r.Builder.
+		Update("cities").
+		Set("name", name).
+		Where("id = ?", cityID).
+		Suffix("RETURNING is_active, created_at").
+		ToSql()
+
+	city := entity.City{ID: cityID, Name: name}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&city.IsActive,
+		&city.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.City{}, ErrNotFound
+		}
+
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok {
+			if pgErr.Code == pgerrcode.UniqueViolation {
+				return entity.City{}, ErrAlreadyExists
+			}
+		}
+
+		return entity.City{}, fmt.Errorf("CityRepository.Rename - QueryRow: %w", err)
+	}
+
+	return city, nil
+}
+
+func (r *CityRepository) Deactivate(ctx context.Context, cityID int) (entity.City, error) {
+	sql, args, _ := r.Builder.
+		Update("cities").
+		Set("is_active", false).
+		Where("id = ?", cityID).
+		Suffix("RETURNING name, is_active, created_at").
+		ToSql()
+
+	city := entity.City{ID: cityID}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&city.Name,
+		&city.IsActive,
+		&city.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.City{}, ErrNotFound
+		}
+
+		return entity.City{}, fmt.Errorf("CityRepository.Deactivate - QueryRow: %w", err)
+	}
+
+	return city, nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/pkg/postgres"
+)
+
+type IdempotencyRepository struct {
+	*postgres.Postgres
+}
+
+func NewIdempotencyRepository(pg *postgres.Postgres) *IdempotencyRepository {
+	return &IdempotencyRepository{pg}
+}
+
+// Reserve stores the key without a response. A key that expired before now is taken over,
+// a live key is reported as ErrAlreadyExists.
+func (r *IdempotencyRepository) Reserve(ctx context.Context, record entity.IdempotencyRecord, now time.Time) error {
+	sql, args, _ := r.Builder.
+		Insert("idempotency_keys").
+		Columns("user_id, key, request_hash, expires_at").
+		Values(record.UserID, record.Key, record.RequestHash, record.ExpiresAt).
+		Suffix(`ON CONFLICT (user_id, key) DO UPDATE SET
+			request_hash = EXCLUDED.request_hash,
+			status_code = NULL,
+			content_type = NULL,
+			response_body = NULL,
+			created_at = NOW(),
+			expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= ?
+		RETURNING user_id`, now).
+		ToSql()
+
+	var userID uuid.UUID
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrAlreadyExists
+		}
+
+		return fmt.Errorf("IdempotencyRepository.Reserve - QueryRow: %w", err)
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) Get(ctx context.Context, userID uuid.UUID, key string) (entity.IdempotencyRecord, error) {
+	sql, args, _ := r.Builder.
+		Select("request_hash, status_code, content_type, response_body, expires_at").
+		From("idempotency_keys").
+		Where("user_id = ?", userID).
+		Where("key = ?", key).
+		ToSql()
+
+	var (
+		statusCode  *int
+		contentType *string
+		body        []byte
+	)
+
+	record := entity.IdempotencyRecord{UserID: userID, Key: key}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&record.RequestHash,
+		&statusCode,
+		&contentType,
+		&body,
+		&record.ExpiresAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.IdempotencyRecord{}, ErrNotFound
+		}
+
+		return entity.IdempotencyRecord{}, fmt.Errorf("IdempotencyRepository.Get - QueryRow: %w", err)
+	}
+
+	if statusCode != nil {
+		record.Response = &entity.IdempotentResponse{StatusCode: *statusCode, Body: body}
+		if contentType != nil {
+			record.Response.ContentType = *contentType
+		}
+	}
+
+	return record, nil
+}
+
+func (r *IdempotencyRepository) SaveResponse(ctx context.Context, userID uuid.UUID, key string, response entity.IdempotentResponse) error {
+	sql, args, _ := r.Builder.
+		Update("idempotency_keys").
+		Set("status_code", response.StatusCode).
+		Set("content_type", response.ContentType).
+		Set("response_body", response.Body).
+		Where("user_id = ?", userID).
+		Where("key = ?", key).
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("IdempotencyRepository.SaveResponse - Exec: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+func (r *IdempotencyRepository) Delete(ctx context.Context, userID uuid.UUID, key string) error {
+	sql, args, _ := r.Builder.
+		Delete("idempotency_keys").
+		Where("user_id = ?", userID).
+		Where("key = ?", key).
+		ToSql()
+
+	if _, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("IdempotencyRepository.Delete - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteExpired removes keys that expired before now and returns their number
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	sql, args, _ := r.Builder.
+		Delete("idempotency_keys").
+		Where("expires_at <= ?", now).
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("IdempotencyRepository.DeleteExpired - Exec: %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
@@ -20,6 +20,287 @@ import (
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MockCity is a mock of City interface.
+type MockCity struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityMockRecorder
+	isgomock struct{}
+}
+
+// MockCityMockRecorder is the mock recorder for MockCity.
+type MockCityMockRecorder struct {
+	mock *MockCity
+}
+
+// NewMockCity creates a new mock instance.
+func NewMockCity(ctrl *gomock.Controller) *MockCity {
+	mock := &MockCity{ctrl: ctrl}
+	mock.recorder = &MockCityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCity) EXPECT() *MockCityMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCity) Create(ctx context.Context, name string) (entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name)
+	ret0, _ := ret[0].(entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCityMockRecorder) Create(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCity)(nil).Create), ctx, name)
+}
+
+// Deactivate mocks base method.
+func (m *MockCity) Deactivate(ctx context.Context, cityID int) (entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, cityID)
+	ret0, _ := ret[0].(entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockCityMockRecorder) Deactivate(ctx, cityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockCity)(nil).Deactivate), ctx, cityID)
+}
+
+// GetAll mocks base method.
+func (m *MockCity) GetAll(ctx context.Context, includeInactive bool) ([]entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, includeInactive)
+	ret0, _ := ret[0].([]entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCityMockRecorder) GetAll(ctx, includeInactive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCity)(nil).GetAll), ctx, includeInactive)
+}
+
+// Rename mocks base method.
+func (m *MockCity) Rename(ctx context.Context, cityID int, name string) (entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, cityID, name)
+	ret0, _ := ret[0].(entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockCityMockRecorder) Rename(ctx, cityID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockCity)(nil).Rename), ctx, cityID, name)
+}
+
+// MockIdempotency is a mock of Idempotency interface.
+type MockIdempotency struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyMockRecorder is the mock recorder for MockIdempotency.
+type MockIdempotencyMockRecorder struct {
+	mock *MockIdempotency
+}
+
+// NewMockIdempotency creates a new mock instance.
+func NewMockIdempotency(ctrl *gomock.Controller) *MockIdempotency {
+	mock := &MockIdempotency{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotency) EXPECT() *MockIdempotencyMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockIdempotency) Delete(ctx context.Context, userID uuid.UUID, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, userID, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockIdempotencyMockRecorder) Delete(ctx, userID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockIdempotency)(nil).Delete), ctx, userID, key)
+}
+
+// DeleteExpired mocks base method.
+func (m *MockIdempotency) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockIdempotencyMockRecorder) DeleteExpired(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockIdempotency)(nil).DeleteExpired), ctx, now)
+}
+
+// Get mocks base method.
+func (m *MockIdempotency) Get(ctx context.Context, userID uuid.UUID, key string) (entity.IdempotencyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, userID, key)
+	ret0, _ := ret[0].(entity.IdempotencyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockIdempotencyMockRecorder) Get(ctx, userID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIdempotency)(nil).Get), ctx, userID, key)
+}
+
+// Reserve mocks base method.
+func (m *MockIdempotency) Reserve(ctx context.Context, record entity.IdempotencyRecord, now time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", ctx, record, now)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockIdempotencyMockRecorder) Reserve(ctx, record, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockIdempotency)(nil).Reserve), ctx, record, now)
+}
+
+// SaveResponse mocks base method.
+func (m *MockIdempotency) SaveResponse(ctx context.Context, userID uuid.UUID, key string, response entity.IdempotentResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveResponse", ctx, userID, key, response)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveResponse indicates an expected call of SaveResponse.
+func (mr *MockIdempotencyMockRecorder) SaveResponse(ctx, userID, key, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveResponse", reflect.TypeOf((*MockIdempotency)(nil).SaveResponse), ctx, userID, key, response)
+}
+
+// MockOutbox is a mock of Outbox interface.
+type MockOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxMockRecorder is the mock recorder for MockOutbox.
+type MockOutboxMockRecorder struct {
+	mock *MockOutbox
+}
+
+// NewMockOutbox creates a new mock instance.
+func NewMockOutbox(ctrl *gomock.Controller) *MockOutbox {
+	mock := &MockOutbox{ctrl: ctrl}
+	mock.recorder = &MockOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutbox) EXPECT() *MockOutboxMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockOutbox) Add(ctx context.Context, events ...entity.Event) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range events {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Add", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockOutboxMockRecorder) Add(ctx any, events ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, events...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockOutbox)(nil).Add), varargs...)
+}
+
+// ClaimDue mocks base method.
+func (m *MockOutbox) ClaimDue(ctx context.Context, sink string, now, leaseUntil time.Time, limit int) ([]dto.OutboxDispatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, sink, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]dto.OutboxDispatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockOutboxMockRecorder) ClaimDue(ctx, sink, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockOutbox)(nil).ClaimDue), ctx, sink, now, leaseUntil, limit)
+}
+
+// DeleteFinished mocks base method.
+func (m *MockOutbox) DeleteFinished(ctx context.Context, sinks []string, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFinished", ctx, sinks, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFinished indicates an expected call of DeleteFinished.
+func (mr *MockOutboxMockRecorder) DeleteFinished(ctx, sinks, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFinished", reflect.TypeOf((*MockOutbox)(nil).DeleteFinished), ctx, sinks, before)
+}
+
+// Listen mocks base method.
+func (m *MockOutbox) Listen(ctx context.Context, handle func(entity.Event)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Listen", ctx, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Listen indicates an expected call of Listen.
+func (mr *MockOutboxMockRecorder) Listen(ctx, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Listen", reflect.TypeOf((*MockOutbox)(nil).Listen), ctx, handle)
+}
+
+// SaveAttempt mocks base method.
+func (m *MockOutbox) SaveAttempt(ctx context.Context, delivery entity.OutboxDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttempt", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAttempt indicates an expected call of SaveAttempt.
+func (mr *MockOutboxMockRecorder) SaveAttempt(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttempt", reflect.TypeOf((*MockOutbox)(nil).SaveAttempt), ctx, delivery)
+}
+
 // MockPoint is a mock of Point interface.
 type MockPoint struct {
 	ctrl     *gomock.Controller
@@ -32,129 +313,546 @@ type MockPointMockRecorder struct {
 	mock *MockPoint
 }
 
-// NewMockPoint creates a new mock instance.
-func NewMockPoint(ctrl *gomock.Controller) *MockPoint {
-	mock := &MockPoint{ctrl: ctrl}
-	mock.recorder = &MockPointMockRecorder{mock}
-	return mock
+// NewMockPoint creates a new mock instance.
+func NewMockPoint(ctrl *gomock.Controller) *MockPoint {
+	mock := &MockPoint{ctrl: ctrl}
+	mock.recorder = &MockPointMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPoint) EXPECT() *MockPointMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPoint) Create(ctx context.Context, point entity.Point) (entity.Point, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, point)
+	ret0, _ := ret[0].(entity.Point)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPointMockRecorder) Create(ctx, point any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPoint)(nil).Create), ctx, point)
+}
+
+// GetAll mocks base method.
+func (m *MockPoint) GetAll(ctx context.Context, status *entity.PointStatus) ([]entity.Point, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, status)
+	ret0, _ := ret[0].([]entity.Point)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockPointMockRecorder) GetAll(ctx, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPoint)(nil).GetAll), ctx, status)
+}
+
+// GetByID mocks base method.
+func (m *MockPoint) GetByID(ctx context.Context, pointID uuid.UUID) (entity.Point, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, pointID)
+	ret0, _ := ret[0].(entity.Point)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPointMockRecorder) GetByID(ctx, pointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPoint)(nil).GetByID), ctx, pointID)
+}
+
+// GetExtended mocks base method.
+func (m *MockPoint) GetExtended(ctx context.Context, filter dto.PointFilter, after *dto.PointCursor, offset, limit int) ([]dto.PointOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExtended", ctx, filter, after, offset, limit)
+	ret0, _ := ret[0].([]dto.PointOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExtended indicates an expected call of GetExtended.
+func (mr *MockPointMockRecorder) GetExtended(ctx, filter, after, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtended", reflect.TypeOf((*MockPoint)(nil).GetExtended), ctx, filter, after, offset, limit)
+}
+
+// GetNearby mocks base method.
+func (m *MockPoint) GetNearby(ctx context.Context, latitude, longitude, radius float64, limit int) ([]dto.NearbyPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNearby", ctx, latitude, longitude, radius, limit)
+	ret0, _ := ret[0].([]dto.NearbyPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNearby indicates an expected call of GetNearby.
+func (mr *MockPointMockRecorder) GetNearby(ctx, latitude, longitude, radius, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearby", reflect.TypeOf((*MockPoint)(nil).GetNearby), ctx, latitude, longitude, radius, limit)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockPoint) UpdateStatus(ctx context.Context, pointID uuid.UUID, from, to entity.PointStatus) (entity.Point, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, pointID, from, to)
+	ret0, _ := ret[0].(entity.Point)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockPointMockRecorder) UpdateStatus(ctx, pointID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockPoint)(nil).UpdateStatus), ctx, pointID, from, to)
+}
+
+// MockProduct is a mock of Product interface.
+type MockProduct struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductMockRecorder
+	isgomock struct{}
+}
+
+// MockProductMockRecorder is the mock recorder for MockProduct.
+type MockProductMockRecorder struct {
+	mock *MockProduct
+}
+
+// NewMockProduct creates a new mock instance.
+func NewMockProduct(ctrl *gomock.Controller) *MockProduct {
+	mock := &MockProduct{ctrl: ctrl}
+	mock.recorder = &MockProductMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProduct) EXPECT() *MockProductMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockProduct) Create(ctx context.Context, receptionID uuid.UUID, productType entity.ProductType, itemCode string) (entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, receptionID, productType, itemCode)
+	ret0, _ := ret[0].(entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProductMockRecorder) Create(ctx, receptionID, productType, itemCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProduct)(nil).Create), ctx, receptionID, productType, itemCode)
+}
+
+// CreateBatch mocks base method.
+func (m *MockProduct) CreateBatch(ctx context.Context, receptionID uuid.UUID, inputs []dto.ProductInput) ([]entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, receptionID, inputs)
+	ret0, _ := ret[0].([]entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockProductMockRecorder) CreateBatch(ctx, receptionID, inputs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockProduct)(nil).CreateBatch), ctx, receptionID, inputs)
+}
+
+// DeleteByID mocks base method.
+func (m *MockProduct) DeleteByID(ctx context.Context, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByID", ctx, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByID indicates an expected call of DeleteByID.
+func (mr *MockProductMockRecorder) DeleteByID(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockProduct)(nil).DeleteByID), ctx, productID)
+}
+
+// DeleteFromReception mocks base method.
+func (m *MockProduct) DeleteFromReception(ctx context.Context, receptionID, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFromReception", ctx, receptionID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFromReception indicates an expected call of DeleteFromReception.
+func (mr *MockProductMockRecorder) DeleteFromReception(ctx, receptionID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFromReception", reflect.TypeOf((*MockProduct)(nil).DeleteFromReception), ctx, receptionID, productID)
+}
+
+// GetByID mocks base method.
+func (m *MockProduct) GetByID(ctx context.Context, productID uuid.UUID) (entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, productID)
+	ret0, _ := ret[0].(entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockProductMockRecorder) GetByID(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProduct)(nil).GetByID), ctx, productID)
+}
+
+// GetByItemCode mocks base method.
+func (m *MockProduct) GetByItemCode(ctx context.Context, itemCode string) (dto.ProductLookup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByItemCode", ctx, itemCode)
+	ret0, _ := ret[0].(dto.ProductLookup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByItemCode indicates an expected call of GetByItemCode.
+func (mr *MockProductMockRecorder) GetByItemCode(ctx, itemCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByItemCode", reflect.TypeOf((*MockProduct)(nil).GetByItemCode), ctx, itemCode)
+}
+
+// GetByReception mocks base method.
+func (m *MockProduct) GetByReception(ctx context.Context, receptionID uuid.UUID) ([]entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByReception", ctx, receptionID)
+	ret0, _ := ret[0].([]entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByReception indicates an expected call of GetByReception.
+func (mr *MockProductMockRecorder) GetByReception(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByReception", reflect.TypeOf((*MockProduct)(nil).GetByReception), ctx, receptionID)
+}
+
+// GetLatestID mocks base method.
+func (m *MockProduct) GetLatestID(ctx context.Context, receptionID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestID", ctx, receptionID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestID indicates an expected call of GetLatestID.
+func (mr *MockProductMockRecorder) GetLatestID(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestID", reflect.TypeOf((*MockProduct)(nil).GetLatestID), ctx, receptionID)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockProduct) UpdateStatus(ctx context.Context, productID uuid.UUID, from, to entity.ProductStatus) (entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, productID, from, to)
+	ret0, _ := ret[0].(entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockProductMockRecorder) UpdateStatus(ctx, productID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockProduct)(nil).UpdateStatus), ctx, productID, from, to)
+}
+
+// UpdateStatusByReception mocks base method.
+func (m *MockProduct) UpdateStatusByReception(ctx context.Context, receptionID uuid.UUID, from, to entity.ProductStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusByReception", ctx, receptionID, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatusByReception indicates an expected call of UpdateStatusByReception.
+func (mr *MockProductMockRecorder) UpdateStatusByReception(ctx, receptionID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusByReception", reflect.TypeOf((*MockProduct)(nil).UpdateStatusByReception), ctx, receptionID, from, to)
+}
+
+// MockProductType is a mock of ProductType interface.
+type MockProductType struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeMockRecorder
+	isgomock struct{}
+}
+
+// MockProductTypeMockRecorder is the mock recorder for MockProductType.
+type MockProductTypeMockRecorder struct {
+	mock *MockProductType
+}
+
+// NewMockProductType creates a new mock instance.
+func NewMockProductType(ctrl *gomock.Controller) *MockProductType {
+	mock := &MockProductType{ctrl: ctrl}
+	mock.recorder = &MockProductTypeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductType) EXPECT() *MockProductTypeMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockProductType) Create(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, code, names)
+	ret0, _ := ret[0].(entity.ProductTypeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProductTypeMockRecorder) Create(ctx, code, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProductType)(nil).Create), ctx, code, names)
+}
+
+// GetAll mocks base method.
+func (m *MockProductType) GetAll(ctx context.Context, includeInactive bool) ([]entity.ProductTypeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, includeInactive)
+	ret0, _ := ret[0].([]entity.ProductTypeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockProductTypeMockRecorder) GetAll(ctx, includeInactive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockProductType)(nil).GetAll), ctx, includeInactive)
+}
+
+// SetActive mocks base method.
+func (m *MockProductType) SetActive(ctx context.Context, code entity.ProductType, active bool) (entity.ProductTypeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActive", ctx, code, active)
+	ret0, _ := ret[0].(entity.ProductTypeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetActive indicates an expected call of SetActive.
+func (mr *MockProductTypeMockRecorder) SetActive(ctx, code, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActive", reflect.TypeOf((*MockProductType)(nil).SetActive), ctx, code, active)
+}
+
+// UpdateNames mocks base method.
+func (m *MockProductType) UpdateNames(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNames", ctx, code, names)
+	ret0, _ := ret[0].(entity.ProductTypeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNames indicates an expected call of UpdateNames.
+func (mr *MockProductTypeMockRecorder) UpdateNames(ctx, code, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNames", reflect.TypeOf((*MockProductType)(nil).UpdateNames), ctx, code, names)
+}
+
+// MockRefreshToken is a mock of RefreshToken interface.
+type MockRefreshToken struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshTokenMockRecorder is the mock recorder for MockRefreshToken.
+type MockRefreshTokenMockRecorder struct {
+	mock *MockRefreshToken
+}
+
+// NewMockRefreshToken creates a new mock instance.
+func NewMockRefreshToken(ctrl *gomock.Controller) *MockRefreshToken {
+	mock := &MockRefreshToken{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshToken) EXPECT() *MockRefreshTokenMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRefreshToken) Create(ctx context.Context, token entity.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRefreshTokenMockRecorder) Create(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRefreshToken)(nil).Create), ctx, token)
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockPoint) EXPECT() *MockPointMockRecorder {
-	return m.recorder
+// DeleteExpired mocks base method.
+func (m *MockRefreshToken) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Create mocks base method.
-func (m *MockPoint) Create(ctx context.Context, city string) (entity.Point, error) {
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockRefreshTokenMockRecorder) DeleteExpired(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockRefreshToken)(nil).DeleteExpired), ctx, now)
+}
+
+// GetByHashForUpdate mocks base method.
+func (m *MockRefreshToken) GetByHashForUpdate(ctx context.Context, tokenHash string) (entity.RefreshToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, city)
-	ret0, _ := ret[0].(entity.Point)
+	ret := m.ctrl.Call(m, "GetByHashForUpdate", ctx, tokenHash)
+	ret0, _ := ret[0].(entity.RefreshToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockPointMockRecorder) Create(ctx, city any) *gomock.Call {
+// GetByHashForUpdate indicates an expected call of GetByHashForUpdate.
+func (mr *MockRefreshTokenMockRecorder) GetByHashForUpdate(ctx, tokenHash any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPoint)(nil).Create), ctx, city)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByHashForUpdate", reflect.TypeOf((*MockRefreshToken)(nil).GetByHashForUpdate), ctx, tokenHash)
 }
 
-// GetAll mocks base method.
-func (m *MockPoint) GetAll(ctx context.Context) ([]entity.Point, error) {
+// MarkUsed mocks base method.
+func (m *MockRefreshToken) MarkUsed(ctx context.Context, tokenID uuid.UUID, usedAt time.Time) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]entity.Point)
+	ret := m.ctrl.Call(m, "MarkUsed", ctx, tokenID, usedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkUsed indicates an expected call of MarkUsed.
+func (mr *MockRefreshTokenMockRecorder) MarkUsed(ctx, tokenID, usedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUsed", reflect.TypeOf((*MockRefreshToken)(nil).MarkUsed), ctx, tokenID, usedAt)
+}
+
+// RevokeByUser mocks base method.
+func (m *MockRefreshToken) RevokeByUser(ctx context.Context, userID uuid.UUID, revokedAt time.Time) ([]entity.RevokedToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeByUser", ctx, userID, revokedAt)
+	ret0, _ := ret[0].([]entity.RevokedToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAll indicates an expected call of GetAll.
-func (mr *MockPointMockRecorder) GetAll(ctx any) *gomock.Call {
+// RevokeByUser indicates an expected call of RevokeByUser.
+func (mr *MockRefreshTokenMockRecorder) RevokeByUser(ctx, userID, revokedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPoint)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeByUser", reflect.TypeOf((*MockRefreshToken)(nil).RevokeByUser), ctx, userID, revokedAt)
 }
 
-// GetExtended mocks base method.
-func (m *MockPoint) GetExtended(ctx context.Context, start, end *time.Time, offset, limit int) ([]dto.PointOutput, error) {
+// RevokeFamily mocks base method.
+func (m *MockRefreshToken) RevokeFamily(ctx context.Context, familyID uuid.UUID, revokedAt time.Time) ([]entity.RevokedToken, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetExtended", ctx, start, end, offset, limit)
-	ret0, _ := ret[0].([]dto.PointOutput)
+	ret := m.ctrl.Call(m, "RevokeFamily", ctx, familyID, revokedAt)
+	ret0, _ := ret[0].([]entity.RevokedToken)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetExtended indicates an expected call of GetExtended.
-func (mr *MockPointMockRecorder) GetExtended(ctx, start, end, offset, limit any) *gomock.Call {
+// RevokeFamily indicates an expected call of RevokeFamily.
+func (mr *MockRefreshTokenMockRecorder) RevokeFamily(ctx, familyID, revokedAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtended", reflect.TypeOf((*MockPoint)(nil).GetExtended), ctx, start, end, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeFamily", reflect.TypeOf((*MockRefreshToken)(nil).RevokeFamily), ctx, familyID, revokedAt)
 }
 
-// MockProduct is a mock of Product interface.
-type MockProduct struct {
+// MockRevokedToken is a mock of RevokedToken interface.
+type MockRevokedToken struct {
 	ctrl     *gomock.Controller
-	recorder *MockProductMockRecorder
+	recorder *MockRevokedTokenMockRecorder
 	isgomock struct{}
 }
 
-// MockProductMockRecorder is the mock recorder for MockProduct.
-type MockProductMockRecorder struct {
-	mock *MockProduct
+// MockRevokedTokenMockRecorder is the mock recorder for MockRevokedToken.
+type MockRevokedTokenMockRecorder struct {
+	mock *MockRevokedToken
 }
 
-// NewMockProduct creates a new mock instance.
-func NewMockProduct(ctrl *gomock.Controller) *MockProduct {
-	mock := &MockProduct{ctrl: ctrl}
-	mock.recorder = &MockProductMockRecorder{mock}
+// NewMockRevokedToken creates a new mock instance.
+func NewMockRevokedToken(ctrl *gomock.Controller) *MockRevokedToken {
+	mock := &MockRevokedToken{ctrl: ctrl}
+	mock.recorder = &MockRevokedTokenMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockProduct) EXPECT() *MockProductMockRecorder {
+func (m *MockRevokedToken) EXPECT() *MockRevokedTokenMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockProduct) Create(ctx context.Context, receptionID uuid.UUID, productType entity.ProductType) (entity.Product, error) {
+// Add mocks base method.
+func (m *MockRevokedToken) Add(ctx context.Context, tokens ...entity.RevokedToken) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, receptionID, productType)
-	ret0, _ := ret[0].(entity.Product)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx}
+	for _, a := range tokens {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Add", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockProductMockRecorder) Create(ctx, receptionID, productType any) *gomock.Call {
+// Add indicates an expected call of Add.
+func (mr *MockRevokedTokenMockRecorder) Add(ctx any, tokens ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProduct)(nil).Create), ctx, receptionID, productType)
+	varargs := append([]any{ctx}, tokens...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockRevokedToken)(nil).Add), varargs...)
 }
 
-// DeleteByID mocks base method.
-func (m *MockProduct) DeleteByID(ctx context.Context, productID uuid.UUID) error {
+// DeleteExpired mocks base method.
+func (m *MockRevokedToken) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteByID", ctx, productID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "DeleteExpired", ctx, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteByID indicates an expected call of DeleteByID.
-func (mr *MockProductMockRecorder) DeleteByID(ctx, productID any) *gomock.Call {
+// DeleteExpired indicates an expected call of DeleteExpired.
+func (mr *MockRevokedTokenMockRecorder) DeleteExpired(ctx, now any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByID", reflect.TypeOf((*MockProduct)(nil).DeleteByID), ctx, productID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpired", reflect.TypeOf((*MockRevokedToken)(nil).DeleteExpired), ctx, now)
 }
 
-// GetLatestID mocks base method.
-func (m *MockProduct) GetLatestID(ctx context.Context, receptionID uuid.UUID) (uuid.UUID, error) {
+// Exists mocks base method.
+func (m *MockRevokedToken) Exists(ctx context.Context, tokenID uuid.UUID) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLatestID", ctx, receptionID)
-	ret0, _ := ret[0].(uuid.UUID)
+	ret := m.ctrl.Call(m, "Exists", ctx, tokenID)
+	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetLatestID indicates an expected call of GetLatestID.
-func (mr *MockProductMockRecorder) GetLatestID(ctx, receptionID any) *gomock.Call {
+// Exists indicates an expected call of Exists.
+func (mr *MockRevokedTokenMockRecorder) Exists(ctx, tokenID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestID", reflect.TypeOf((*MockProduct)(nil).GetLatestID), ctx, receptionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockRevokedToken)(nil).Exists), ctx, tokenID)
 }
 
 // MockReception is a mock of Reception interface.
@@ -211,19 +909,189 @@ func (mr *MockReceptionMockRecorder) Create(ctx, pointID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReception)(nil).Create), ctx, pointID)
 }
 
-// GetActiveID mocks base method.
-func (m *MockReception) GetActiveID(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error) {
+// CreateReopening mocks base method.
+func (m *MockReception) CreateReopening(ctx context.Context, receptionID, userID uuid.UUID, reason string) (entity.ReceptionReopening, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReopening", ctx, receptionID, userID, reason)
+	ret0, _ := ret[0].(entity.ReceptionReopening)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReopening indicates an expected call of CreateReopening.
+func (mr *MockReceptionMockRecorder) CreateReopening(ctx, receptionID, userID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReopening", reflect.TypeOf((*MockReception)(nil).CreateReopening), ctx, receptionID, userID, reason)
+}
+
+// GetActive mocks base method.
+func (m *MockReception) GetActive(ctx context.Context, pointID uuid.UUID) (entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx, pointID)
+	ret0, _ := ret[0].(entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockReceptionMockRecorder) GetActive(ctx, pointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockReception)(nil).GetActive), ctx, pointID)
+}
+
+// GetActiveIDForShare mocks base method.
+func (m *MockReception) GetActiveIDForShare(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveIDForShare", ctx, pointID)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveIDForShare indicates an expected call of GetActiveIDForShare.
+func (mr *MockReceptionMockRecorder) GetActiveIDForShare(ctx, pointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveIDForShare", reflect.TypeOf((*MockReception)(nil).GetActiveIDForShare), ctx, pointID)
+}
+
+// GetActiveIDForUpdate mocks base method.
+func (m *MockReception) GetActiveIDForUpdate(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActiveID", ctx, pointID)
+	ret := m.ctrl.Call(m, "GetActiveIDForUpdate", ctx, pointID)
 	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetActiveID indicates an expected call of GetActiveID.
-func (mr *MockReceptionMockRecorder) GetActiveID(ctx, pointID any) *gomock.Call {
+// GetActiveIDForUpdate indicates an expected call of GetActiveIDForUpdate.
+func (mr *MockReceptionMockRecorder) GetActiveIDForUpdate(ctx, pointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveIDForUpdate", reflect.TypeOf((*MockReception)(nil).GetActiveIDForUpdate), ctx, pointID)
+}
+
+// GetByID mocks base method.
+func (m *MockReception) GetByID(ctx context.Context, receptionID uuid.UUID) (entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, receptionID)
+	ret0, _ := ret[0].(entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReceptionMockRecorder) GetByID(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReception)(nil).GetByID), ctx, receptionID)
+}
+
+// Reopen mocks base method.
+func (m *MockReception) Reopen(ctx context.Context, receptionID uuid.UUID) (entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, receptionID)
+	ret0, _ := ret[0].(entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockReceptionMockRecorder) Reopen(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockReception)(nil).Reopen), ctx, receptionID)
+}
+
+// MockSchedule is a mock of Schedule interface.
+type MockSchedule struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleMockRecorder is the mock recorder for MockSchedule.
+type MockScheduleMockRecorder struct {
+	mock *MockSchedule
+}
+
+// NewMockSchedule creates a new mock instance.
+func NewMockSchedule(ctrl *gomock.Controller) *MockSchedule {
+	mock := &MockSchedule{ctrl: ctrl}
+	mock.recorder = &MockScheduleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchedule) EXPECT() *MockScheduleMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockSchedule) Get(ctx context.Context, pointID uuid.UUID) (entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, pointID)
+	ret0, _ := ret[0].(entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockScheduleMockRecorder) Get(ctx, pointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSchedule)(nil).Get), ctx, pointID)
+}
+
+// ReplaceHolidays mocks base method.
+func (m *MockSchedule) ReplaceHolidays(ctx context.Context, pointID uuid.UUID, holidays []entity.Holiday) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceHolidays", ctx, pointID, holidays)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceHolidays indicates an expected call of ReplaceHolidays.
+func (mr *MockScheduleMockRecorder) ReplaceHolidays(ctx, pointID, holidays any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceHolidays", reflect.TypeOf((*MockSchedule)(nil).ReplaceHolidays), ctx, pointID, holidays)
+}
+
+// ReplaceWorkingHours mocks base method.
+func (m *MockSchedule) ReplaceWorkingHours(ctx context.Context, pointID uuid.UUID, workingHours []entity.WorkingHours) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceWorkingHours", ctx, pointID, workingHours)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceWorkingHours indicates an expected call of ReplaceWorkingHours.
+func (mr *MockScheduleMockRecorder) ReplaceWorkingHours(ctx, pointID, workingHours any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceWorkingHours", reflect.TypeOf((*MockSchedule)(nil).ReplaceWorkingHours), ctx, pointID, workingHours)
+}
+
+// SetOverride mocks base method.
+func (m *MockSchedule) SetOverride(ctx context.Context, pointID uuid.UUID, until *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOverride", ctx, pointID, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetOverride indicates an expected call of SetOverride.
+func (mr *MockScheduleMockRecorder) SetOverride(ctx, pointID, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOverride", reflect.TypeOf((*MockSchedule)(nil).SetOverride), ctx, pointID, until)
+}
+
+// UpdateTimeZone mocks base method.
+func (m *MockSchedule) UpdateTimeZone(ctx context.Context, pointID uuid.UUID, timeZone string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTimeZone", ctx, pointID, timeZone)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTimeZone indicates an expected call of UpdateTimeZone.
+func (mr *MockScheduleMockRecorder) UpdateTimeZone(ctx, pointID, timeZone any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveID", reflect.TypeOf((*MockReception)(nil).GetActiveID), ctx, pointID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTimeZone", reflect.TypeOf((*MockSchedule)(nil).UpdateTimeZone), ctx, pointID, timeZone)
 }
 
 // MockUser is a mock of User interface.
@@ -265,6 +1133,21 @@ func (mr *MockUserMockRecorder) Create(ctx, email, password, role any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUser)(nil).Create), ctx, email, password, role)
 }
 
+// Exists mocks base method.
+func (m *MockUser) Exists(ctx context.Context, userID uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exists", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exists indicates an expected call of Exists.
+func (mr *MockUserMockRecorder) Exists(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exists", reflect.TypeOf((*MockUser)(nil).Exists), ctx, userID)
+}
+
 // GetByEmail mocks base method.
 func (m *MockUser) GetByEmail(ctx context.Context, email string) (entity.User, error) {
 	m.ctrl.T.Helper()
@@ -279,3 +1162,145 @@ func (mr *MockUserMockRecorder) GetByEmail(ctx, email any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByEmail", reflect.TypeOf((*MockUser)(nil).GetByEmail), ctx, email)
 }
+
+// MockWebhook is a mock of Webhook interface.
+type MockWebhook struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookMockRecorder is the mock recorder for MockWebhook.
+type MockWebhookMockRecorder struct {
+	mock *MockWebhook
+}
+
+// NewMockWebhook creates a new mock instance.
+func NewMockWebhook(ctrl *gomock.Controller) *MockWebhook {
+	mock := &MockWebhook{ctrl: ctrl}
+	mock.recorder = &MockWebhookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhook) EXPECT() *MockWebhookMockRecorder {
+	return m.recorder
+}
+
+// ClaimDue mocks base method.
+func (m *MockWebhook) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]dto.WebhookDispatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDue", ctx, now, leaseUntil, limit)
+	ret0, _ := ret[0].([]dto.WebhookDispatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDue indicates an expected call of ClaimDue.
+func (mr *MockWebhookMockRecorder) ClaimDue(ctx, now, leaseUntil, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDue", reflect.TypeOf((*MockWebhook)(nil).ClaimDue), ctx, now, leaseUntil, limit)
+}
+
+// Create mocks base method.
+func (m *MockWebhook) Create(ctx context.Context, webhook entity.Webhook) (entity.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, webhook)
+	ret0, _ := ret[0].(entity.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookMockRecorder) Create(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhook)(nil).Create), ctx, webhook)
+}
+
+// Delete mocks base method.
+func (m *MockWebhook) Delete(ctx context.Context, webhookID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookMockRecorder) Delete(ctx, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhook)(nil).Delete), ctx, webhookID)
+}
+
+// EnqueueDeliveries mocks base method.
+func (m *MockWebhook) EnqueueDeliveries(ctx context.Context, event entity.Event, payload []byte, now time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueDeliveries", ctx, event, payload, now)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnqueueDeliveries indicates an expected call of EnqueueDeliveries.
+func (mr *MockWebhookMockRecorder) EnqueueDeliveries(ctx, event, payload, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueDeliveries", reflect.TypeOf((*MockWebhook)(nil).EnqueueDeliveries), ctx, event, payload, now)
+}
+
+// GetAll mocks base method.
+func (m *MockWebhook) GetAll(ctx context.Context) ([]entity.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]entity.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockWebhookMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockWebhook)(nil).GetAll), ctx)
+}
+
+// GetByID mocks base method.
+func (m *MockWebhook) GetByID(ctx context.Context, webhookID uuid.UUID) (entity.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, webhookID)
+	ret0, _ := ret[0].(entity.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockWebhookMockRecorder) GetByID(ctx, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockWebhook)(nil).GetByID), ctx, webhookID)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhook) GetDeliveries(ctx context.Context, webhookID uuid.UUID, status *entity.WebhookDeliveryStatus, limit int) ([]entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, webhookID, status, limit)
+	ret0, _ := ret[0].([]entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookMockRecorder) GetDeliveries(ctx, webhookID, status, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhook)(nil).GetDeliveries), ctx, webhookID, status, limit)
+}
+
+// SaveAttempt mocks base method.
+func (m *MockWebhook) SaveAttempt(ctx context.Context, delivery entity.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttempt", ctx, delivery)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAttempt indicates an expected call of SaveAttempt.
+func (mr *MockWebhookMockRecorder) SaveAttempt(ctx, delivery any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttempt", reflect.TypeOf((*MockWebhook)(nil).SaveAttempt), ctx, delivery)
+}
@@ -0,0 +1,205 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/pkg/postgres"
+)
+
+// outboxChannel is notified by the outbox trigger about every stored event
+const outboxChannel = "outbox_events"
+
+type OutboxRepository struct {
+	*postgres.Postgres
+}
+
+func NewOutboxRepository(pg *postgres.Postgres) *OutboxRepository {
+	return &OutboxRepository{pg}
+}
+
+// Add stores events to be relayed, it must run in the transaction of the change they describe.
+// Missing city is taken from the point.
+func (r *OutboxRepository) Add(ctx context.Context, events ...entity.Event) error {
+	query := r.Builder.
+		Insert("outbox").
+		Columns("event_type, point_id, city, reception_id, product_id")
+
+	for _, event := range events {
+		query = query.Values(
+			event.Type,
+			event.PointID,
+			squirrel.Expr(`COALESCE(NULLIF(?, ''), (
+				SELECT c.name FROM points p INNER JOIN cities c ON c.id = p.city_id WHERE p.id = ?
+			))`, event.City, event.PointID),
+			event.ReceptionID,
+			event.ProductID,
+		)
+	}
+
+	sql, args, _ := query.ToSql()
+
+	if _, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("OutboxRepository.Add - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimDue leases up to limit events due for the sink, at most the earliest unfinished one of each point.
+// Later events of a point wait until the earlier one is published or dead for the sink, so every point keeps
+// its order however many relays run. Events are locked only while they are claimed, the lease keeps other relays
+// away while they are published.
+func (r *OutboxRepository) ClaimDue(ctx context.Context, sink string, now, leaseUntil time.Time, limit int) ([]dto.OutboxDispatch, error) {
+	sql := `WITH due AS (
+			SELECT o.id, o.event_type, o.point_id, o.city, o.reception_id, o.product_id, o.occurred_at, o.seq,
+				COALESCE(d.attempts, 0) AS attempts
+			FROM outbox o
+			LEFT JOIN outbox_deliveries d ON d.event_id = o.id AND d.sink = $1
+			WHERE (d.event_id IS NULL OR (d.status = $2 AND d.next_attempt_at <= $3))
+				AND NOT EXISTS (
+					SELECT 1
+					FROM outbox prev
+					LEFT JOIN outbox_deliveries pd ON pd.event_id = prev.id AND pd.sink = $1
+					WHERE prev.point_id = o.point_id AND prev.seq < o.seq AND (pd.event_id IS NULL OR pd.status = $2)
+				)
+			ORDER BY o.seq
+			LIMIT $5
+			FOR UPDATE OF o SKIP LOCKED
+		), claimed AS (
+			INSERT INTO outbox_deliveries (event_id, sink, next_attempt_at)
+			SELECT id, $1, $4 FROM due
+			ON CONFLICT (event_id, sink) DO UPDATE SET next_attempt_at = EXCLUDED.next_attempt_at
+		)
+		SELECT id, event_type, point_id, city, reception_id, product_id, occurred_at, attempts
+		FROM due
+		ORDER BY seq`
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, sink, entity.OutboxDeliveryStatusPending, now, leaseUntil, limit)
+	if err != nil {
+		return nil, fmt.Errorf("OutboxRepository.ClaimDue - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var dispatches []dto.OutboxDispatch
+	for rows.Next() {
+		var dispatch dto.OutboxDispatch
+		err = rows.Scan(
+			&dispatch.Event.ID,
+			&dispatch.Event.Type,
+			&dispatch.Event.PointID,
+			&dispatch.Event.City,
+			&dispatch.Event.ReceptionID,
+			&dispatch.Event.ProductID,
+			&dispatch.Event.OccurredAt,
+			&dispatch.Delivery.Attempts,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("OutboxRepository.ClaimDue - rows.Scan: %w", err)
+		}
+
+		dispatch.Delivery.EventID = dispatch.Event.ID
+		dispatch.Delivery.Sink = sink
+		dispatch.Delivery.Status = entity.OutboxDeliveryStatusPending
+		dispatch.Delivery.NextAttemptAt = leaseUntil
+		dispatches = append(dispatches, dispatch)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("OutboxRepository.ClaimDue - rows.Err: %w", err)
+	}
+
+	return dispatches, nil
+}
+
+// SaveAttempt stores the outcome of the attempt to publish the event to the sink
+func (r *OutboxRepository) SaveAttempt(ctx context.Context, delivery entity.OutboxDelivery) error {
+	sql, args, _ := r.Builder.
+		Update("outbox_deliveries").
+		Set("status", delivery.Status).
+		Set("attempts", delivery.Attempts).
+		Set("next_attempt_at", delivery.NextAttemptAt).
+		Set("last_error", delivery.LastError).
+		Set("published_at", delivery.PublishedAt).
+		Where("event_id = ? AND sink = ?", delivery.EventID, delivery.Sink).
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("OutboxRepository.SaveAttempt - Exec: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// DeleteFinished deletes events that occurred before the given time and are published or dead for every sink
+func (r *OutboxRepository) DeleteFinished(ctx context.Context, sinks []string, before time.Time) (int64, error) {
+	sql := `DELETE FROM outbox o
+		WHERE o.occurred_at < $1 AND (
+			SELECT COUNT(*)
+			FROM outbox_deliveries d
+			WHERE d.event_id = o.id AND d.sink = ANY($2) AND d.status <> $3
+		) = $4`
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, before, sinks, entity.OutboxDeliveryStatusPending, len(sinks))
+	if err != nil {
+		return 0, fmt.Errorf("OutboxRepository.DeleteFinished - Exec: %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
+
+// outboxNotification is the payload the outbox trigger sends for every stored event
+type outboxNotification struct {
+	ID          uuid.UUID        `json:"id"`
+	Type        entity.EventType `json:"event_type"`
+	PointID     uuid.UUID        `json:"point_id"`
+	City        string           `json:"city"`
+	ReceptionID uuid.UUID        `json:"reception_id"`
+	ProductID   *uuid.UUID       `json:"product_id"`
+	OccurredAt  time.Time        `json:"occurred_at"`
+}
+
+// Listen passes every event to handle once its transaction commits, until ctx is done or the connection fails.
+// The connection is taken out of the pool for the whole time. Events committed while nobody listens are not passed.
+func (r *OutboxRepository) Listen(ctx context.Context, handle func(entity.Event)) error {
+	poolConn, err := r.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("OutboxRepository.Listen - r.Pool.Acquire: %w", err)
+	}
+
+	conn := poolConn.Hijack()
+	defer func() { _ = conn.Close(context.WithoutCancel(ctx)) }()
+
+	if _, err = conn.Exec(ctx, "LISTEN "+outboxChannel); err != nil {
+		return fmt.Errorf("OutboxRepository.Listen - Exec: %w", err)
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("OutboxRepository.Listen - WaitForNotification: %w", err)
+		}
+
+		var payload outboxNotification
+		if err = json.Unmarshal([]byte(notification.Payload), &payload); err != nil {
+			return fmt.Errorf("OutboxRepository.Listen - json.Unmarshal: %w", err)
+		}
+
+		handle(entity.Event(payload))
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"time"
+	"math"
 
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	log "github.com/sirupsen/logrus"
 
@@ -15,6 +17,11 @@ import (
 	"github.com/spanwalla/pvz/pkg/postgres"
 )
 
+const (
+	earthRadiusMeters = 6371000
+	metersPerDegree   = earthRadiusMeters * math.Pi / 180
+)
+
 type PointRepository struct {
 	*postgres.Postgres
 }
@@ -23,23 +30,35 @@ func NewPointRepository(pg *postgres.Postgres) *PointRepository {
 	return &PointRepository{pg}
 }
 
-func (r *PointRepository) Create(ctx context.Context, city string) (entity.Point, error) {
+func (r *PointRepository) Create(ctx context.Context, input entity.Point) (entity.Point, error) {
 	subQuery := r.Builder.
 		Select("id").
+		Column("?::VARCHAR", input.Address).
+		Column("?::DOUBLE PRECISION", input.Latitude).
+		Column("?::DOUBLE PRECISION", input.Longitude).
+		Column("?::VARCHAR", input.TimeZone).
 		From("cities").
-		Where("name = ?", city)
+		Where("name = ?", input.City).
+		Where("is_active")
 
 	sql, args, _ := r.Builder.
 		Insert("points").
-		Columns("city_id").
+		Columns("city_id", "address", "latitude", "longitude", "time_zone").
 		Select(subQuery).
-		Suffix("RETURNING id, created_at").
+		Suffix("RETURNING id, created_at, status").
 		ToSql()
 
-	point := entity.Point{City: city}
+	point := entity.Point{
+		City:      input.City,
+		Address:   input.Address,
+		Latitude:  input.Latitude,
+		Longitude: input.Longitude,
+		TimeZone:  input.TimeZone,
+	}
 	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
 		&point.ID,
 		&point.CreatedAt,
+		&point.Status,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -52,12 +71,17 @@ func (r *PointRepository) Create(ctx context.Context, city string) (entity.Point
 	return point, nil
 }
 
-func (r *PointRepository) GetAll(ctx context.Context) ([]entity.Point, error) {
-	sql, args, _ := r.Builder.
-		Select("points.id, created_at, cities.name AS city").
+func (r *PointRepository) GetAll(ctx context.Context, status *entity.PointStatus) ([]entity.Point, error) {
+	query := r.Builder.
+		Select("points.id, created_at, cities.name AS city, status, address, latitude, longitude, time_zone").
 		From("points").
-		InnerJoin("cities ON cities.id = points.city_id").
-		ToSql()
+		InnerJoin("cities ON cities.id = points.city_id")
+
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	sql, args, _ := query.ToSql()
 
 	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
 	if err != nil {
@@ -68,7 +92,16 @@ func (r *PointRepository) GetAll(ctx context.Context) ([]entity.Point, error) {
 	var points []entity.Point
 	for rows.Next() {
 		var point entity.Point
-		if err = rows.Scan(&point.ID, &point.CreatedAt, &point.City); err != nil {
+		if err = rows.Scan(
+			&point.ID,
+			&point.CreatedAt,
+			&point.City,
+			&point.Status,
+			&point.Address,
+			&point.Latitude,
+			&point.Longitude,
+			&point.TimeZone,
+		); err != nil {
 			return nil, fmt.Errorf("PointRepository.GetAll - rows.Scan: %w", err)
 		}
 
@@ -82,7 +115,130 @@ func (r *PointRepository) GetAll(ctx context.Context) ([]entity.Point, error) {
 	return points, nil
 }
 
-func (r *PointRepository) GetExtended(ctx context.Context, start, end *time.Time, offset, limit int) ([]dto.PointOutput, error) {
+func (r *PointRepository) GetByID(ctx context.Context, pointID uuid.UUID) (entity.Point, error) {
+	sql, args, _ := r.Builder.
+		Select("points.id, created_at, cities.name AS city, status, address, latitude, longitude, time_zone").
+		From("points").
+		InnerJoin("cities ON cities.id = points.city_id").
+		Where("points.id = ?", pointID).
+		ToSql()
+
+	var point entity.Point
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&point.ID,
+		&point.CreatedAt,
+		&point.City,
+		&point.Status,
+		&point.Address,
+		&point.Latitude,
+		&point.Longitude,
+		&point.TimeZone,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Point{}, ErrNotFound
+		}
+
+		return entity.Point{}, fmt.Errorf("PointRepository.GetByID - QueryRow: %w", err)
+	}
+
+	return point, nil
+}
+
+// UpdateStatus changes point status only if it is still equal to from
+func (r *PointRepository) UpdateStatus(ctx context.Context, pointID uuid.UUID, from, to entity.PointStatus) (entity.Point, error) {
+	sql, args, _ := r.Builder.
+		Update("points").
+		Set("status", to).
+		Where("id = ?", pointID).
+		Where("status = ?", from).
+		Suffix("RETURNING created_at, (SELECT name FROM cities WHERE cities.id = points.city_id), address, latitude, longitude, time_zone").
+		ToSql()
+
+	point := entity.Point{ID: pointID, Status: to}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&point.CreatedAt,
+		&point.City,
+		&point.Address,
+		&point.Latitude,
+		&point.Longitude,
+		&point.TimeZone,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Point{}, ErrNotFound
+		}
+
+		return entity.Point{}, fmt.Errorf("PointRepository.UpdateStatus - QueryRow: %w", err)
+	}
+
+	return point, nil
+}
+
+// GetNearby returns active points within radius meters from the given coordinates, closest first.
+// Distance is calculated with the haversine formula, latitude range is used as a cheap prefilter.
+func (r *PointRepository) GetNearby(ctx context.Context, latitude, longitude, radius float64, limit int) ([]dto.NearbyPoint, error) {
+	delta := radius / metersPerDegree
+
+	inner := r.Builder.
+		Select("points.id, created_at, cities.name AS city, status, address, latitude, longitude, time_zone").
+		Column(
+			`? * 2 * ASIN(SQRT(LEAST(1,
+				POWER(SIN(RADIANS(latitude - ?) / 2), 2) +
+				COS(RADIANS(?)) * COS(RADIANS(latitude)) * POWER(SIN(RADIANS(longitude - ?) / 2), 2)
+			))) AS distance`,
+			earthRadiusMeters, latitude, latitude, longitude,
+		).
+		From("points").
+		InnerJoin("cities ON cities.id = points.city_id").
+		Where("status = ?", entity.PointStatusActive).
+		Where("latitude BETWEEN ? AND ?", latitude-delta, latitude+delta)
+
+	sql, args, _ := r.Builder.
+		Select("id, created_at, city, status, address, latitude, longitude, time_zone, distance").
+		FromSelect(inner, "nearby").
+		Where("distance <= ?", radius).
+		OrderBy("distance").
+		Limit(uint64(limit)).
+		ToSql()
+
+	log.Debugf("PointRepository.GetNearby - sql, args: %v %v", sql, args)
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("PointRepository.GetNearby - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var points []dto.NearbyPoint
+	for rows.Next() {
+		var point dto.NearbyPoint
+		if err = rows.Scan(
+			&point.Point.ID,
+			&point.Point.CreatedAt,
+			&point.Point.City,
+			&point.Point.Status,
+			&point.Point.Address,
+			&point.Point.Latitude,
+			&point.Point.Longitude,
+			&point.Point.TimeZone,
+			&point.Distance,
+		); err != nil {
+			return nil, fmt.Errorf("PointRepository.GetNearby - rows.Scan: %w", err)
+		}
+
+		points = append(points, point)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("PointRepository.GetNearby - rows.Err: %w", err)
+	}
+
+	return points, nil
+}
+
+// GetExtended returns points ordered by (created_at, id), starting after the cursor if it is set
+func (r *PointRepository) GetExtended(ctx context.Context, filter dto.PointFilter, after *dto.PointCursor, offset, limit int) ([]dto.PointOutput, error) {
 	cte := r.Builder.
 		Select(
 			"r.id AS reception_id",
@@ -95,32 +251,70 @@ func (r *PointRepository) GetExtended(ctx context.Context, start, end *time.Time
 						'id', p.id,
 						'receptionId', p.reception_id,
 						'createdAt', p.created_at,
-						'type', p.type
+						'type', pt.code,
+						'typeNames', pt.names,
+						'itemCode', p.item_code,
+						'status', p.status
 					)
 				) FILTER (WHERE p.id IS NOT NULL), '[]'
 			) AS products_json`,
+			`COALESCE(
+				(
+					SELECT json_agg(
+						json_build_object(
+							'id', rr.id,
+							'receptionId', rr.reception_id,
+							'reopenedBy', rr.reopened_by,
+							'reason', rr.reason,
+							'reopenedAt', rr.reopened_at
+						) ORDER BY rr.reopened_at
+					)
+					FROM reception_reopenings rr
+					WHERE rr.reception_id = r.id
+				), '[]'
+			) AS reopenings_json`,
 		).
 		From("receptions r").
 		LeftJoin("products p ON r.id = p.reception_id").
+		LeftJoin("product_types pt ON pt.id = p.type_id").
 		GroupBy("r.id", "r.point_id", "r.created_at", "r.status")
 
-	if start != nil {
-		cte = cte.Where("r.created_at >= ?", start)
+	if filter.StartDate != nil {
+		cte = cte.Where("r.created_at >= ?", filter.StartDate)
+	}
+
+	if filter.EndDate != nil {
+		cte = cte.Where("r.created_at <= ?", filter.EndDate)
+	}
+
+	if filter.ReceptionStatus != nil {
+		cte = cte.Where("r.status = ?", *filter.ReceptionStatus)
 	}
 
-	if end != nil {
-		cte = cte.Where("r.created_at <= ?", end)
+	if filter.ProductType != nil {
+		cte = cte.Where(`EXISTS (
+			SELECT 1
+			FROM products fp
+			INNER JOIN product_types fpt ON fpt.id = fp.type_id
+			WHERE fp.reception_id = r.id AND fpt.code = ?
+		)`, *filter.ProductType)
 	}
 
-	cteSql, cteArgs, _ := cte.ToSql()
+	// CTE is rendered with `?` placeholders, so the outer query numbers all arguments at once
+	cteSql, cteArgs, _ := cte.PlaceholderFormat(squirrel.Question).ToSql()
 
 	cteFinal := fmt.Sprintf("WITH reception_products AS (%s)", cteSql)
 
-	sql, args, _ := r.Builder.
+	query := r.Builder.
 		Select(
 			"pts.id",
 			"pts.created_at",
 			"c.name AS city",
+			"pts.status",
+			"pts.address",
+			"pts.latitude",
+			"pts.longitude",
+			"pts.time_zone",
 			`COALESCE(
 				json_agg(
 					json_build_object(
@@ -130,25 +324,56 @@ func (r *PointRepository) GetExtended(ctx context.Context, start, end *time.Time
 							'createdAt', rp.created_at,
 							'status', rp.status
 						),
-						'products', rp.products_json
+						'products', rp.products_json,
+						'reopenings', rp.reopenings_json
 					)
 				) FILTER (WHERE rp.reception_id IS NOT NULL), '[]'
 			) AS receptions`,
+			// Counts cover all products of the point regardless of the reception date filter
+			`(
+				SELECT COALESCE(json_object_agg(sc.status, sc.total), '{}')
+				FROM (
+					SELECT p.status, COUNT(*) AS total
+					FROM products p
+					INNER JOIN receptions r ON r.id = p.reception_id
+					WHERE r.point_id = pts.id
+					GROUP BY p.status
+				) sc
+			) AS product_counts`,
 		).
 		From("points pts").
 		InnerJoin("cities c ON c.id = pts.city_id").
-		LeftJoin("reception_products rp ON rp.point_id = pts.id").
-		GroupBy("pts.id", "pts.created_at", "c.name").
+		GroupBy("pts.id", "pts.created_at", "c.name", "pts.status", "pts.address", "pts.latitude", "pts.longitude", "pts.time_zone").
 		Offset(uint64(offset)).
 		Limit(uint64(limit)).
-		Prefix(cteFinal).
-		ToSql()
+		Prefix(cteFinal, cteArgs...)
 
-	cteArgs = append(cteArgs, args...)
+	// Reception filters select points, so points without a matching reception are dropped
+	if filter.ReceptionStatus != nil || filter.ProductType != nil {
+		query = query.InnerJoin("reception_products rp ON rp.point_id = pts.id")
+	} else {
+		query = query.LeftJoin("reception_products rp ON rp.point_id = pts.id")
+	}
 
-	log.Debugf("PointRepository.GetExtended - sql, args: %v %v", sql, cteArgs)
+	if filter.Status != nil {
+		query = query.Where("pts.status = ?", *filter.Status)
+	}
+
+	if filter.City != nil {
+		query = query.Where("c.name = ?", *filter.City)
+	}
 
-	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, cteArgs...)
+	if len(filter.PointIDs) > 0 {
+		query = query.Where(squirrel.Eq{"pts.id": filter.PointIDs})
+	}
+
+	query = orderPoints(query, filter.Sort, after)
+
+	sql, args, _ := query.ToSql()
+
+	log.Debugf("PointRepository.GetExtended - sql, args: %v %v", sql, args)
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
 	if err != nil {
 		return nil, fmt.Errorf("PointRepository.GetExtended - Query: %w", err)
 	}
@@ -158,11 +383,23 @@ func (r *PointRepository) GetExtended(ctx context.Context, start, end *time.Time
 
 	for rows.Next() {
 		var (
-			point   dto.Point
-			rawJSON []byte
+			point     dto.Point
+			rawJSON   []byte
+			rawCounts []byte
 		)
 
-		if err = rows.Scan(&point.ID, &point.CreatedAt, &point.City, &rawJSON); err != nil {
+		if err = rows.Scan(
+			&point.ID,
+			&point.CreatedAt,
+			&point.City,
+			&point.Status,
+			&point.Address,
+			&point.Latitude,
+			&point.Longitude,
+			&point.TimeZone,
+			&rawJSON,
+			&rawCounts,
+		); err != nil {
 			return nil, fmt.Errorf("PointRepository.GetExtended - rows.Scan: %w", err)
 		}
 
@@ -171,9 +408,19 @@ func (r *PointRepository) GetExtended(ctx context.Context, start, end *time.Time
 			return nil, fmt.Errorf("PointRepository.GetExtended - json.Unmarshal: %w", err)
 		}
 
+		productCounts := make(map[entity.ProductStatus]int, len(entity.ProductStatuses))
+		for _, status := range entity.ProductStatuses {
+			productCounts[status] = 0
+		}
+
+		if err = json.Unmarshal(rawCounts, &productCounts); err != nil {
+			return nil, fmt.Errorf("PointRepository.GetExtended - json.Unmarshal counts: %w", err)
+		}
+
 		results = append(results, dto.PointOutput{
-			Point:      point,
-			Receptions: receptions,
+			Point:         point,
+			Receptions:    receptions,
+			ProductCounts: productCounts,
 		})
 	}
 
@@ -183,3 +430,27 @@ func (r *PointRepository) GetExtended(ctx context.Context, start, end *time.Time
 
 	return results, nil
 }
+
+// orderPoints applies the sort order and continues after the keyset cursor if it is set.
+// created_at and id break ties, so the order is total and pages never overlap.
+func orderPoints(query squirrel.SelectBuilder, sort dto.PointSort, after *dto.PointCursor) squirrel.SelectBuilder {
+	direction, comparison := "", ">"
+	if sort.Descending() {
+		direction, comparison = " DESC", "<"
+	}
+
+	switch sort {
+	case dto.PointSortCityAsc, dto.PointSortCityDesc:
+		query = query.OrderBy("c.name"+direction, "pts.created_at"+direction, "pts.id"+direction)
+		if after != nil {
+			query = query.Where("(c.name, pts.created_at, pts.id) "+comparison+" (?, ?, ?)", after.City, after.CreatedAt, after.ID)
+		}
+	default:
+		query = query.OrderBy("pts.created_at"+direction, "pts.id"+direction)
+		if after != nil {
+			query = query.Where("(pts.created_at, pts.id) "+comparison+" (?, ?)", after.CreatedAt, after.ID)
+		}
+	}
+
+	return query
+}
@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
+	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 
+	"github.com/spanwalla/pvz/internal/dto"
 	"github.com/spanwalla/pvz/internal/entity"
 	"github.com/spanwalla/pvz/pkg/postgres"
 )
@@ -20,31 +25,312 @@ func NewProductRepository(pg *postgres.Postgres) *ProductRepository {
 	return &ProductRepository{pg}
 }
 
-func (r *ProductRepository) Create(ctx context.Context, receptionID uuid.UUID, productType entity.ProductType) (entity.Product, error) {
+func (r *ProductRepository) Create(ctx context.Context, receptionID uuid.UUID, productType entity.ProductType, itemCode string) (entity.Product, error) {
+	subQuery := r.Builder.
+		Select().
+		Column("?::uuid", receptionID).
+		Column("id").
+		Column("?::text", itemCode).
+		From("product_types").
+		Where("code = ?", productType).
+		Where("is_active")
+
 	sql, args, _ := r.Builder.
 		Insert("products").
-		Columns("reception_id, type").
-		Values(receptionID, productType).
-		Suffix("RETURNING id, created_at").
+		Columns("reception_id, type_id, item_code").
+		Select(subQuery).
+		Suffix("RETURNING id, created_at, (SELECT names FROM product_types WHERE product_types.id = products.type_id), status").
 		ToSql()
 
-	product := entity.Product{ReceptionID: receptionID, Type: productType}
+	product := entity.Product{ReceptionID: receptionID, Type: productType, ItemCode: itemCode}
 	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
 		&product.ID,
 		&product.CreatedAt,
+		&product.TypeNames,
+		&product.Status,
 	)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Product{}, ErrNotFound
+		}
+
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok {
+			if pgErr.Code == pgerrcode.UniqueViolation {
+				return entity.Product{}, ErrAlreadyExists
+			}
+		}
+
 		return entity.Product{}, fmt.Errorf("ProductRepository.Create - QueryRow: %w", err)
 	}
 
 	return product, nil
 }
 
+// CreateBatch inserts all products with one statement and returns them in input order.
+// If any product type is missing or inactive nothing is inserted and ErrNotFound is returned,
+// if any item code is already in an open reception ErrAlreadyExists is returned.
+func (r *ProductRepository) CreateBatch(ctx context.Context, receptionID uuid.UUID, inputs []dto.ProductInput) ([]entity.Product, error) {
+	types := make([]string, len(inputs))
+	itemCodes := make([]string, len(inputs))
+	for i, input := range inputs {
+		types[i] = string(input.Type)
+		itemCodes[i] = input.ItemCode
+	}
+
+	// Rows of one transaction share NOW(), so the ordinal keeps scan order for "delete last product"
+	sql, args, _ := r.Builder.
+		Insert("products").
+		Columns("reception_id, type_id, item_code, created_at").
+		Select(r.Builder.
+			Select().
+			Column("?::uuid", receptionID).
+			Column("pt.id").
+			Column("i.item_code").
+			Column("NOW() + i.ord * INTERVAL '1 microsecond'").
+			From("product_types pt").
+			InnerJoin("unnest(?::text[], ?::text[]) WITH ORDINALITY AS i(code, item_code, ord) ON i.code = pt.code", types, itemCodes).
+			Where("pt.is_active").
+			OrderBy("i.ord")).
+		Suffix(`RETURNING id, created_at,
+			(SELECT code FROM product_types WHERE product_types.id = products.type_id),
+			(SELECT names FROM product_types WHERE product_types.id = products.type_id),
+			item_code, status`).
+		ToSql()
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ProductRepository.CreateBatch - Query: %w", err)
+	}
+	defer rows.Close()
+
+	products := make([]entity.Product, 0, len(inputs))
+	for rows.Next() {
+		product := entity.Product{ReceptionID: receptionID}
+		if err = rows.Scan(
+			&product.ID,
+			&product.CreatedAt,
+			&product.Type,
+			&product.TypeNames,
+			&product.ItemCode,
+			&product.Status,
+		); err != nil {
+			return nil, fmt.Errorf("ProductRepository.CreateBatch - rows.Scan: %w", err)
+		}
+
+		products = append(products, product)
+	}
+
+	if err = rows.Err(); err != nil {
+		// The unique index on item codes of open receptions fails the whole statement
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok {
+			if pgErr.Code == pgerrcode.UniqueViolation {
+				return nil, ErrAlreadyExists
+			}
+		}
+
+		return nil, fmt.Errorf("ProductRepository.CreateBatch - rows.Err: %w", err)
+	}
+
+	// Rows without an active type were filtered out by the join, the caller rolls back the transaction
+	if len(products) != len(inputs) {
+		return nil, ErrNotFound
+	}
+
+	slices.SortFunc(products, func(a, b entity.Product) int {
+		return a.CreatedAt.Compare(b.CreatedAt)
+	})
+
+	return products, nil
+}
+
+func (r *ProductRepository) GetByItemCode(ctx context.Context, itemCode string) (dto.ProductLookup, error) {
+	sql, args, _ := r.Builder.
+		Select(
+			"p.id",
+			"p.created_at",
+			"pt.code",
+			"pt.names",
+			"p.status",
+			"r.id",
+			"r.created_at",
+			"r.status",
+			"pts.id",
+			"pts.created_at",
+			"c.name",
+		).
+		From("products p").
+		InnerJoin("product_types pt ON pt.id = p.type_id").
+		InnerJoin("receptions r ON r.id = p.reception_id").
+		InnerJoin("points pts ON pts.id = r.point_id").
+		InnerJoin("cities c ON c.id = pts.city_id").
+		Where("p.item_code = ?", itemCode).
+		OrderBy("p.created_at DESC").
+		Limit(1).
+		ToSql()
+
+	var lookup dto.ProductLookup
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&lookup.Product.ID,
+		&lookup.Product.CreatedAt,
+		&lookup.Product.Type,
+		&lookup.Product.TypeNames,
+		&lookup.Product.Status,
+		&lookup.Reception.ID,
+		&lookup.Reception.CreatedAt,
+		&lookup.Reception.Status,
+		&lookup.Point.ID,
+		&lookup.Point.CreatedAt,
+		&lookup.Point.City,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return dto.ProductLookup{}, ErrNotFound
+		}
+
+		return dto.ProductLookup{}, fmt.Errorf("ProductRepository.GetByItemCode - QueryRow: %w", err)
+	}
+
+	lookup.Product.ItemCode = itemCode
+	lookup.Product.ReceptionID = lookup.Reception.ID
+	lookup.Reception.PointID = lookup.Point.ID
+
+	return lookup, nil
+}
+
+func (r *ProductRepository) GetByID(ctx context.Context, productID uuid.UUID) (entity.Product, error) {
+	sql, args, _ := r.Builder.
+		Select("p.reception_id, p.created_at, pt.code, pt.names, p.item_code, p.status").
+		From("products p").
+		InnerJoin("product_types pt ON pt.id = p.type_id").
+		Where("p.id = ?", productID).
+		ToSql()
+
+	product := entity.Product{ID: productID}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&product.ReceptionID,
+		&product.CreatedAt,
+		&product.Type,
+		&product.TypeNames,
+		&product.ItemCode,
+		&product.Status,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Product{}, ErrNotFound
+		}
+
+		return entity.Product{}, fmt.Errorf("ProductRepository.GetByID - QueryRow: %w", err)
+	}
+
+	return product, nil
+}
+
+// GetByReception returns products of the reception in scan order
+func (r *ProductRepository) GetByReception(ctx context.Context, receptionID uuid.UUID) ([]entity.Product, error) {
+	sql, args, _ := r.Builder.
+		Select("p.id, p.created_at, pt.code, pt.names, p.item_code, p.status").
+		From("products p").
+		InnerJoin("product_types pt ON pt.id = p.type_id").
+		Where("p.reception_id = ?", receptionID).
+		OrderBy("p.created_at").
+		ToSql()
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ProductRepository.GetByReception - Query: %w", err)
+	}
+	defer rows.Close()
+
+	products := make([]entity.Product, 0)
+	for rows.Next() {
+		product := entity.Product{ReceptionID: receptionID}
+		if err = rows.Scan(
+			&product.ID,
+			&product.CreatedAt,
+			&product.Type,
+			&product.TypeNames,
+			&product.ItemCode,
+			&product.Status,
+		); err != nil {
+			return nil, fmt.Errorf("ProductRepository.GetByReception - rows.Scan: %w", err)
+		}
+
+		products = append(products, product)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ProductRepository.GetByReception - rows.Err: %w", err)
+	}
+
+	return products, nil
+}
+
+// UpdateStatus changes product status only if it is still equal to from
+func (r *ProductRepository) UpdateStatus(ctx context.Context, productID uuid.UUID, from, to entity.ProductStatus) (entity.Product, error) {
+	sql, args, _ := r.Builder.
+		Update("products").
+		Set("status", to).
+		Set("status_changed_at", squirrel.Expr("NOW()")).
+		Where("id = ?", productID).
+		Where("status = ?", from).
+		Suffix(`RETURNING reception_id, created_at,
+			(SELECT code FROM product_types WHERE product_types.id = products.type_id),
+			(SELECT names FROM product_types WHERE product_types.id = products.type_id),
+			item_code`).
+		ToSql()
+
+	product := entity.Product{ID: productID, Status: to}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&product.ReceptionID,
+		&product.CreatedAt,
+		&product.Type,
+		&product.TypeNames,
+		&product.ItemCode,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Product{}, ErrNotFound
+		}
+
+		return entity.Product{}, fmt.Errorf("ProductRepository.UpdateStatus - QueryRow: %w", err)
+	}
+
+	return product, nil
+}
+
+// UpdateStatusByReception moves all products of the reception with status from to status to.
+// ErrAlreadyExists is returned when a product becomes received while its item code is in another open reception.
+func (r *ProductRepository) UpdateStatusByReception(ctx context.Context, receptionID uuid.UUID, from, to entity.ProductStatus) error {
+	sql, args, _ := r.Builder.
+		Update("products").
+		Set("status", to).
+		Set("status_changed_at", squirrel.Expr("NOW()")).
+		Where("reception_id = ?", receptionID).
+		Where("status = ?", from).
+		ToSql()
+
+	if _, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...); err != nil {
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok {
+			if pgErr.Code == pgerrcode.UniqueViolation {
+				return ErrAlreadyExists
+			}
+		}
+
+		return fmt.Errorf("ProductRepository.UpdateStatusByReception - Exec: %w", err)
+	}
+
+	return nil
+}
+
 func (r *ProductRepository) GetLatestID(ctx context.Context, receptionID uuid.UUID) (uuid.UUID, error) {
 	sql, args, _ := r.Builder.
 		Select("id").
 		From("products").
 		Where("reception_id = ?", receptionID).
+		Where("status = ?", entity.ProductStatusReceived).
 		OrderBy("created_at DESC").
 		Limit(1).
 		ToSql()
@@ -80,3 +366,23 @@ func (r *ProductRepository) DeleteByID(ctx context.Context, productID uuid.UUID)
 
 	return nil
 }
+
+func (r *ProductRepository) DeleteFromReception(ctx context.Context, receptionID, productID uuid.UUID) error {
+	sql, args, _ := r.Builder.
+		Delete("products").
+		Where("id = ?", productID).
+		Where("reception_id = ?", receptionID).
+		Where("status = ?", entity.ProductStatusReceived).
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("ProductRepository.DeleteFromReception - Exec: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsDeleted
+	}
+
+	return nil
+}
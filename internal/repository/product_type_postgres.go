@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/pkg/postgres"
+)
+
+type ProductTypeRepository struct {
+	*postgres.Postgres
+}
+
+func NewProductTypeRepository(pg *postgres.Postgres) *ProductTypeRepository {
+	return &ProductTypeRepository{pg}
+}
+
+func (r *ProductTypeRepository) Create(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error) {
+	sql, args, _ := r.Builder.
+		Insert("product_types").
+		Columns("code, names").
+		Values(code, names).
+		Suffix("RETURNING id, is_active, created_at").
+		ToSql()
+
+	productType := entity.ProductTypeEntry{Code: code, Names: names}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&productType.ID,
+		&productType.IsActive,
+		&productType.CreatedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok {
+			if pgErr.Code == pgerrcode.UniqueViolation {
+				return entity.ProductTypeEntry{}, ErrAlreadyExists
+			}
+		}
+
+		return entity.ProductTypeEntry{}, fmt.Errorf("ProductTypeRepository.Create - QueryRow: %w", err)
+	}
+
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) GetAll(ctx context.Context, includeInactive bool) ([]entity.ProductTypeEntry, error) {
+	query := r.Builder.
+		Select("id, code, names, is_active, created_at").
+		From("product_types").
+		OrderBy("code")
+
+	if !includeInactive {
+		query = query.Where("is_active")
+	}
+
+	sql, args, _ := query.ToSql()
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ProductTypeRepository.GetAll - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var productTypes []entity.ProductTypeEntry
+	for rows.Next() {
+		var productType entity.ProductTypeEntry
+		if err = rows.Scan(
+			&productType.ID,
+			&productType.Code,
+			&productType.Names,
+			&productType.IsActive,
+			&productType.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("ProductTypeRepository.GetAll - rows.Scan: %w", err)
+		}
+
+		productTypes = append(productTypes, productType)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ProductTypeRepository.GetAll - rows.Err: %w", err)
+	}
+
+	return productTypes, nil
+}
+
+func (r *ProductTypeRepository) UpdateNames(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error) {
+	sql, args, _ := r.Builder.
+		Update("product_types").
+		Set("names", names).
+		Where("code = ?", code).
+		Suffix("RETURNING id, is_active, created_at").
+		ToSql()
+
+	productType := entity.ProductTypeEntry{Code: code, Names: names}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&productType.ID,
+		&productType.IsActive,
+		&productType.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ProductTypeEntry{}, ErrNotFound
+		}
+
+		return entity.ProductTypeEntry{}, fmt.Errorf("ProductTypeRepository.UpdateNames - QueryRow: %w", err)
+	}
+
+	return productType, nil
+}
+
+func (r *ProductTypeRepository) SetActive(ctx context.Context, code entity.ProductType, active bool) (entity.ProductTypeEntry, error) {
+	sql, args, _ := r.Builder.
+		Update("product_types").
+		Set("is_active", active).
+		Where("code = ?", code).
+		Suffix("RETURNING id, names, is_active, created_at").
+		ToSql()
+
+	productType := entity.ProductTypeEntry{Code: code}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&productType.ID,
+		&productType.Names,
+		&productType.IsActive,
+		&productType.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.ProductTypeEntry{}, ErrNotFound
+		}
+
+		return entity.ProductTypeEntry{}, fmt.Errorf("ProductTypeRepository.SetActive - QueryRow: %w", err)
+	}
+
+	return productType, nil
+}
@@ -50,13 +50,64 @@ func (r *ReceptionRepository) Create(ctx context.Context, pointID uuid.UUID) (en
 	return reception, nil
 }
 
-func (r *ReceptionRepository) GetActiveID(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error) {
+func (r *ReceptionRepository) GetByID(ctx context.Context, receptionID uuid.UUID) (entity.Reception, error) {
+	sql, args, _ := r.Builder.
+		Select("point_id, created_at, status").
+		From("receptions").
+		Where("id = ?", receptionID).
+		ToSql()
+
+	reception := entity.Reception{ID: receptionID}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&reception.PointID,
+		&reception.CreatedAt,
+		&reception.Status,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Reception{}, ErrNotFound
+		}
+
+		return entity.Reception{}, fmt.Errorf("ReceptionRepository.GetByID - QueryRow: %w", err)
+	}
+
+	return reception, nil
+}
+
+// GetActiveIDForShare locks the active reception against closing until the transaction ends.
+// Must be called inside a transaction, a reception closed while waiting for the lock is reported as ErrNotFound.
+func (r *ReceptionRepository) GetActiveIDForShare(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error) {
 	sql, args, _ := r.Builder.
 		Select("id").
 		From("receptions").
 		Where("status = ?", entity.ReceptionStatusInProgress).
 		Where("point_id = ?", pointID).
-		Limit(1).
+		Suffix("FOR SHARE").
+		ToSql()
+
+	var receptionID uuid.UUID
+
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(&receptionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return uuid.Nil, ErrNotFound
+		}
+
+		return uuid.Nil, fmt.Errorf("ReceptionRepository.GetActiveIDForShare - QueryRow: %w", err)
+	}
+
+	return receptionID, nil
+}
+
+// GetActiveIDForUpdate locks the active reception exclusively, so closing it and deleting its products are serialized.
+// Must be called inside a transaction, a reception closed while waiting for the lock is reported as ErrNotFound.
+func (r *ReceptionRepository) GetActiveIDForUpdate(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error) {
+	sql, args, _ := r.Builder.
+		Select("id").
+		From("receptions").
+		Where("status = ?", entity.ReceptionStatusInProgress).
+		Where("point_id = ?", pointID).
+		Suffix("FOR UPDATE").
 		ToSql()
 
 	var receptionID uuid.UUID
@@ -67,12 +118,37 @@ func (r *ReceptionRepository) GetActiveID(ctx context.Context, pointID uuid.UUID
 			return uuid.Nil, ErrNotFound
 		}
 
-		return uuid.Nil, fmt.Errorf("ReceptionRepository.GetActiveID - QueryRow: %w", err)
+		return uuid.Nil, fmt.Errorf("ReceptionRepository.GetActiveIDForUpdate - QueryRow: %w", err)
 	}
 
 	return receptionID, nil
 }
 
+func (r *ReceptionRepository) GetActive(ctx context.Context, pointID uuid.UUID) (entity.Reception, error) {
+	sql, args, _ := r.Builder.
+		Select("id, created_at").
+		From("receptions").
+		Where("status = ?", entity.ReceptionStatusInProgress).
+		Where("point_id = ?", pointID).
+		Limit(1).
+		ToSql()
+
+	reception := entity.Reception{PointID: pointID, Status: entity.ReceptionStatusInProgress}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&reception.ID,
+		&reception.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Reception{}, ErrNotFound
+		}
+
+		return entity.Reception{}, fmt.Errorf("ReceptionRepository.GetActive - QueryRow: %w", err)
+	}
+
+	return reception, nil
+}
+
 func (r *ReceptionRepository) Close(ctx context.Context, receptionID uuid.UUID) (entity.Reception, error) {
 	sql, args, _ := r.Builder.
 		Update("receptions").
@@ -96,3 +172,55 @@ func (r *ReceptionRepository) Close(ctx context.Context, receptionID uuid.UUID)
 
 	return reception, nil
 }
+
+func (r *ReceptionRepository) Reopen(ctx context.Context, receptionID uuid.UUID) (entity.Reception, error) {
+	sql, args, _ := r.Builder.
+		Update("receptions").
+		Set("status", entity.ReceptionStatusInProgress).
+		Where("id = ?", receptionID).
+		Where("status = ?", entity.ReceptionStatusClosed).
+		Suffix("RETURNING point_id, created_at").
+		ToSql()
+
+	reception := entity.Reception{ID: receptionID, Status: entity.ReceptionStatusInProgress}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&reception.PointID,
+		&reception.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Reception{}, ErrNotFound
+		}
+
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok {
+			if pgErr.Code == pgerrcode.UniqueViolation {
+				return entity.Reception{}, ErrAlreadyExists
+			}
+		}
+
+		return entity.Reception{}, fmt.Errorf("ReceptionRepository.Reopen - QueryRow: %w", err)
+	}
+
+	return reception, nil
+}
+
+func (r *ReceptionRepository) CreateReopening(ctx context.Context, receptionID, userID uuid.UUID, reason string) (entity.ReceptionReopening, error) {
+	sql, args, _ := r.Builder.
+		Insert("reception_reopenings").
+		Columns("reception_id, reopened_by, reason").
+		Values(receptionID, userID, reason).
+		Suffix("RETURNING id, reopened_at").
+		ToSql()
+
+	reopening := entity.ReceptionReopening{ReceptionID: receptionID, ReopenedBy: userID, Reason: reason}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&reopening.ID,
+		&reopening.ReopenedAt,
+	)
+	if err != nil {
+		return entity.ReceptionReopening{}, fmt.Errorf("ReceptionRepository.CreateReopening - QueryRow: %w", err)
+	}
+
+	return reopening, nil
+}
@@ -0,0 +1,145 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/pkg/postgres"
+)
+
+type RefreshTokenRepository struct {
+	*postgres.Postgres
+}
+
+func NewRefreshTokenRepository(pg *postgres.Postgres) *RefreshTokenRepository {
+	return &RefreshTokenRepository{pg}
+}
+
+func (r *RefreshTokenRepository) Create(ctx context.Context, token entity.RefreshToken) error {
+	sql, args, _ := r.Builder.
+		Insert("refresh_tokens").
+		Columns("family_id, user_id, role, token_hash, access_token_id, access_expires_at, expires_at").
+		Values(token.FamilyID, token.UserID, token.Role, token.TokenHash, token.AccessTokenID, token.AccessExpiresAt, token.ExpiresAt).
+		ToSql()
+
+	_, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("RefreshTokenRepository.Create - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetByHashForUpdate locks the token, so it is rotated only once. Must be called inside a transaction.
+func (r *RefreshTokenRepository) GetByHashForUpdate(ctx context.Context, tokenHash string) (entity.RefreshToken, error) {
+	sql, args, _ := r.Builder.
+		Select("id, family_id, user_id, role, access_token_id, access_expires_at, created_at, expires_at, used_at, revoked_at").
+		From("refresh_tokens").
+		Where("token_hash = ?", tokenHash).
+		Suffix("FOR UPDATE").
+		ToSql()
+
+	token := entity.RefreshToken{TokenHash: tokenHash}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&token.ID,
+		&token.FamilyID,
+		&token.UserID,
+		&token.Role,
+		&token.AccessTokenID,
+		&token.AccessExpiresAt,
+		&token.CreatedAt,
+		&token.ExpiresAt,
+		&token.UsedAt,
+		&token.RevokedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.RefreshToken{}, ErrNotFound
+		}
+
+		return entity.RefreshToken{}, fmt.Errorf("RefreshTokenRepository.GetByHashForUpdate - QueryRow: %w", err)
+	}
+
+	return token, nil
+}
+
+func (r *RefreshTokenRepository) MarkUsed(ctx context.Context, tokenID uuid.UUID, usedAt time.Time) error {
+	sql, args, _ := r.Builder.
+		Update("refresh_tokens").
+		Set("used_at", usedAt).
+		Where("id = ?", tokenID).
+		ToSql()
+
+	_, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("RefreshTokenRepository.MarkUsed - Exec: %w", err)
+	}
+
+	return nil
+}
+
+// RevokeFamily revokes every token of the session and returns access tokens issued with them that are not expired yet
+func (r *RefreshTokenRepository) RevokeFamily(ctx context.Context, familyID uuid.UUID, revokedAt time.Time) ([]entity.RevokedToken, error) {
+	return r.revoke(ctx, squirrel.Eq{"family_id": familyID}, revokedAt)
+}
+
+// RevokeByUser revokes every session of the user and returns access tokens issued with them that are not expired yet
+func (r *RefreshTokenRepository) RevokeByUser(ctx context.Context, userID uuid.UUID, revokedAt time.Time) ([]entity.RevokedToken, error) {
+	return r.revoke(ctx, squirrel.Eq{"user_id": userID}, revokedAt)
+}
+
+func (r *RefreshTokenRepository) revoke(ctx context.Context, where squirrel.Eq, revokedAt time.Time) ([]entity.RevokedToken, error) {
+	sql, args, _ := r.Builder.
+		Update("refresh_tokens").
+		Set("revoked_at", revokedAt).
+		Where(where).
+		Where("revoked_at IS NULL").
+		Suffix("RETURNING access_token_id, access_expires_at").
+		ToSql()
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("RefreshTokenRepository.revoke - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var tokens []entity.RevokedToken
+	for rows.Next() {
+		var token entity.RevokedToken
+		if err = rows.Scan(&token.ID, &token.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("RefreshTokenRepository.revoke - rows.Scan: %w", err)
+		}
+
+		if token.ExpiresAt.After(revokedAt) {
+			tokens = append(tokens, token)
+		}
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("RefreshTokenRepository.revoke - rows.Err: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// DeleteExpired removes tokens that expired before now and returns their number
+func (r *RefreshTokenRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	sql, args, _ := r.Builder.
+		Delete("refresh_tokens").
+		Where("expires_at <= ?", now).
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("RefreshTokenRepository.DeleteExpired - Exec: %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
@@ -13,41 +13,137 @@ import (
 
 //go:generate go tool mockgen -source=repository.go -destination=mocks/mock_repository.go -package=mocks
 
+type City interface {
+	Create(ctx context.Context, name string) (entity.City, error)
+	GetAll(ctx context.Context, includeInactive bool) ([]entity.City, error)
+	Rename(ctx context.Context, cityID int, name string) (entity.City, error)
+	Deactivate(ctx context.Context, cityID int) (entity.City, error)
+}
+
+type Idempotency interface {
+	Reserve(ctx context.Context, record entity.IdempotencyRecord, now time.Time) error
+	Get(ctx context.Context, userID uuid.UUID, key string) (entity.IdempotencyRecord, error)
+	SaveResponse(ctx context.Context, userID uuid.UUID, key string, response entity.IdempotentResponse) error
+	Delete(ctx context.Context, userID uuid.UUID, key string) error
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type Outbox interface {
+	Add(ctx context.Context, events ...entity.Event) error
+	ClaimDue(ctx context.Context, sink string, now, leaseUntil time.Time, limit int) ([]dto.OutboxDispatch, error)
+	SaveAttempt(ctx context.Context, delivery entity.OutboxDelivery) error
+	DeleteFinished(ctx context.Context, sinks []string, before time.Time) (int64, error)
+	Listen(ctx context.Context, handle func(entity.Event)) error
+}
+
 type Point interface {
-	Create(ctx context.Context, city string) (entity.Point, error)
-	GetAll(ctx context.Context) ([]entity.Point, error)
-	GetExtended(ctx context.Context, start, end *time.Time, offset, limit int) ([]dto.PointOutput, error)
+	Create(ctx context.Context, point entity.Point) (entity.Point, error)
+	GetAll(ctx context.Context, status *entity.PointStatus) ([]entity.Point, error)
+	GetNearby(ctx context.Context, latitude, longitude, radius float64, limit int) ([]dto.NearbyPoint, error)
+	GetByID(ctx context.Context, pointID uuid.UUID) (entity.Point, error)
+	UpdateStatus(ctx context.Context, pointID uuid.UUID, from, to entity.PointStatus) (entity.Point, error)
+	GetExtended(ctx context.Context, filter dto.PointFilter, after *dto.PointCursor, offset, limit int) ([]dto.PointOutput, error)
 }
 
 type Product interface {
-	Create(ctx context.Context, receptionID uuid.UUID, productType entity.ProductType) (entity.Product, error)
+	Create(ctx context.Context, receptionID uuid.UUID, productType entity.ProductType, itemCode string) (entity.Product, error)
+	CreateBatch(ctx context.Context, receptionID uuid.UUID, inputs []dto.ProductInput) ([]entity.Product, error)
+	GetByItemCode(ctx context.Context, itemCode string) (dto.ProductLookup, error)
+	GetByID(ctx context.Context, productID uuid.UUID) (entity.Product, error)
+	GetByReception(ctx context.Context, receptionID uuid.UUID) ([]entity.Product, error)
+	UpdateStatus(ctx context.Context, productID uuid.UUID, from, to entity.ProductStatus) (entity.Product, error)
+	UpdateStatusByReception(ctx context.Context, receptionID uuid.UUID, from, to entity.ProductStatus) error
 	GetLatestID(ctx context.Context, receptionID uuid.UUID) (uuid.UUID, error)
 	DeleteByID(ctx context.Context, productID uuid.UUID) error
+	DeleteFromReception(ctx context.Context, receptionID, productID uuid.UUID) error
+}
+
+type ProductType interface {
+	Create(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error)
+	GetAll(ctx context.Context, includeInactive bool) ([]entity.ProductTypeEntry, error)
+	UpdateNames(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error)
+	SetActive(ctx context.Context, code entity.ProductType, active bool) (entity.ProductTypeEntry, error)
+}
+
+type RefreshToken interface {
+	Create(ctx context.Context, token entity.RefreshToken) error
+	GetByHashForUpdate(ctx context.Context, tokenHash string) (entity.RefreshToken, error)
+	MarkUsed(ctx context.Context, tokenID uuid.UUID, usedAt time.Time) error
+	RevokeFamily(ctx context.Context, familyID uuid.UUID, revokedAt time.Time) ([]entity.RevokedToken, error)
+	RevokeByUser(ctx context.Context, userID uuid.UUID, revokedAt time.Time) ([]entity.RevokedToken, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type RevokedToken interface {
+	Add(ctx context.Context, tokens ...entity.RevokedToken) error
+	Exists(ctx context.Context, tokenID uuid.UUID) (bool, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
 }
 
 type Reception interface {
 	Create(ctx context.Context, pointID uuid.UUID) (entity.Reception, error)
-	GetActiveID(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error)
+	GetByID(ctx context.Context, receptionID uuid.UUID) (entity.Reception, error)
+	GetActiveIDForShare(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error)
+	GetActiveIDForUpdate(ctx context.Context, pointID uuid.UUID) (uuid.UUID, error)
+	GetActive(ctx context.Context, pointID uuid.UUID) (entity.Reception, error)
 	Close(ctx context.Context, receptionID uuid.UUID) (entity.Reception, error)
+	Reopen(ctx context.Context, receptionID uuid.UUID) (entity.Reception, error)
+	CreateReopening(ctx context.Context, receptionID, userID uuid.UUID, reason string) (entity.ReceptionReopening, error)
+}
+
+type Schedule interface {
+	Get(ctx context.Context, pointID uuid.UUID) (entity.Schedule, error)
+	UpdateTimeZone(ctx context.Context, pointID uuid.UUID, timeZone string) error
+	ReplaceWorkingHours(ctx context.Context, pointID uuid.UUID, workingHours []entity.WorkingHours) error
+	ReplaceHolidays(ctx context.Context, pointID uuid.UUID, holidays []entity.Holiday) error
+	SetOverride(ctx context.Context, pointID uuid.UUID, until *time.Time) error
 }
 
 type User interface {
 	Create(ctx context.Context, email, password string, role entity.RoleType) (entity.User, error)
 	GetByEmail(ctx context.Context, email string) (entity.User, error)
+	Exists(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
+type Webhook interface {
+	Create(ctx context.Context, webhook entity.Webhook) (entity.Webhook, error)
+	GetAll(ctx context.Context) ([]entity.Webhook, error)
+	GetByID(ctx context.Context, webhookID uuid.UUID) (entity.Webhook, error)
+	Delete(ctx context.Context, webhookID uuid.UUID) error
+	GetDeliveries(ctx context.Context, webhookID uuid.UUID, status *entity.WebhookDeliveryStatus, limit int) ([]entity.WebhookDelivery, error)
+	EnqueueDeliveries(ctx context.Context, event entity.Event, payload []byte, now time.Time) (int64, error)
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]dto.WebhookDispatch, error)
+	SaveAttempt(ctx context.Context, delivery entity.WebhookDelivery) error
 }
 
 type Repositories struct {
+	City
+	Idempotency
+	Outbox
 	Point
 	Product
+	ProductType
 	Reception
+	RefreshToken
+	RevokedToken
+	Schedule
 	User
+	Webhook
 }
 
 func New(pg *postgres.Postgres) *Repositories {
 	return &Repositories{
-		Point:     NewPointRepository(pg),
-		Product:   NewProductRepository(pg),
-		Reception: NewReceptionRepository(pg),
-		User:      NewUserRepository(pg),
+		City:         NewCityRepository(pg),
+		Idempotency:  NewIdempotencyRepository(pg),
+		Outbox:       NewOutboxRepository(pg),
+		Point:        NewPointRepository(pg),
+		Product:      NewProductRepository(pg),
+		ProductType:  NewProductTypeRepository(pg),
+		Reception:    NewReceptionRepository(pg),
+		RefreshToken: NewRefreshTokenRepository(pg),
+		RevokedToken: NewRevokedTokenRepository(pg),
+		Schedule:     NewScheduleRepository(pg),
+		User:         NewUserRepository(pg),
+		Webhook:      NewWebhookRepository(pg),
 	}
 }
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/pkg/postgres"
+)
+
+type RevokedTokenRepository struct {
+	*postgres.Postgres
+}
+
+func NewRevokedTokenRepository(pg *postgres.Postgres) *RevokedTokenRepository {
+	return &RevokedTokenRepository{pg}
+}
+
+// Add puts access tokens on the revocation list, tokens revoked earlier are skipped
+func (r *RevokedTokenRepository) Add(ctx context.Context, tokens ...entity.RevokedToken) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	builder := r.Builder.
+		Insert("revoked_tokens").
+		Columns("id, expires_at").
+		Suffix("ON CONFLICT (id) DO NOTHING")
+
+	for _, token := range tokens {
+		builder = builder.Values(token.ID, token.ExpiresAt)
+	}
+
+	sql, args, _ := builder.ToSql()
+
+	_, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("RevokedTokenRepository.Add - Exec: %w", err)
+	}
+
+	return nil
+}
+
+func (r *RevokedTokenRepository) Exists(ctx context.Context, tokenID uuid.UUID) (bool, error) {
+	subQuery := r.Builder.
+		Select("1").
+		From("revoked_tokens").
+		Where("id = ?", tokenID)
+
+	sql, args, _ := r.Builder.
+		Select().
+		Column(squirrel.Expr("EXISTS (?)", subQuery)).
+		ToSql()
+
+	var exists bool
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("RevokedTokenRepository.Exists - QueryRow: %w", err)
+	}
+
+	return exists, nil
+}
+
+// DeleteExpired removes tokens that expired before now and returns their number, expired tokens are rejected anyway
+func (r *RevokedTokenRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	sql, args, _ := r.Builder.
+		Delete("revoked_tokens").
+		Where("expires_at <= ?", now).
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("RevokedTokenRepository.DeleteExpired - Exec: %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
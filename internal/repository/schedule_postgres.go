@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/pkg/postgres"
+)
+
+type ScheduleRepository struct {
+	*postgres.Postgres
+}
+
+func NewScheduleRepository(pg *postgres.Postgres) *ScheduleRepository {
+	return &ScheduleRepository{pg}
+}
+
+func (r *ScheduleRepository) Get(ctx context.Context, pointID uuid.UUID) (entity.Schedule, error) {
+	sql, args, _ := r.Builder.
+		Select("time_zone, hours_override_until").
+		From("points").
+		Where("id = ?", pointID).
+		ToSql()
+
+	var schedule entity.Schedule
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&schedule.TimeZone,
+		&schedule.OverrideUntil,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Schedule{}, ErrNotFound
+		}
+
+		return entity.Schedule{}, fmt.Errorf("ScheduleRepository.Get - QueryRow: %w", err)
+	}
+
+	schedule.WorkingHours, err = r.getWorkingHours(ctx, pointID)
+	if err != nil {
+		return entity.Schedule{}, err
+	}
+
+	schedule.Holidays, err = r.getHolidays(ctx, pointID)
+	if err != nil {
+		return entity.Schedule{}, err
+	}
+
+	return schedule, nil
+}
+
+func (r *ScheduleRepository) getWorkingHours(ctx context.Context, pointID uuid.UUID) ([]entity.WorkingHours, error) {
+	sql, args, _ := r.Builder.
+		Select("weekday", "to_char(opens_at, 'HH24:MI')", "to_char(closes_at, 'HH24:MI')").
+		From("point_working_hours").
+		Where("point_id = ?", pointID).
+		OrderBy("weekday").
+		ToSql()
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ScheduleRepository.getWorkingHours - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var workingHours []entity.WorkingHours
+	for rows.Next() {
+		var hours entity.WorkingHours
+		if err = rows.Scan(&hours.Weekday, &hours.OpensAt, &hours.ClosesAt); err != nil {
+			return nil, fmt.Errorf("ScheduleRepository.getWorkingHours - rows.Scan: %w", err)
+		}
+
+		workingHours = append(workingHours, hours)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ScheduleRepository.getWorkingHours - rows.Err: %w", err)
+	}
+
+	return workingHours, nil
+}
+
+func (r *ScheduleRepository) getHolidays(ctx context.Context, pointID uuid.UUID) ([]entity.Holiday, error) {
+	sql, args, _ := r.Builder.
+		Select("to_char(date, 'YYYY-MM-DD')", "to_char(opens_at, 'HH24:MI')", "to_char(closes_at, 'HH24:MI')").
+		From("point_holidays").
+		Where("point_id = ?", pointID).
+		OrderBy("date").
+		ToSql()
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ScheduleRepository.getHolidays - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var holidays []entity.Holiday
+	for rows.Next() {
+		var holiday entity.Holiday
+		if err = rows.Scan(&holiday.Date, &holiday.OpensAt, &holiday.ClosesAt); err != nil {
+			return nil, fmt.Errorf("ScheduleRepository.getHolidays - rows.Scan: %w", err)
+		}
+
+		holidays = append(holidays, holiday)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("ScheduleRepository.getHolidays - rows.Err: %w", err)
+	}
+
+	return holidays, nil
+}
+
+func (r *ScheduleRepository) UpdateTimeZone(ctx context.Context, pointID uuid.UUID, timeZone string) error {
+	sql, args, _ := r.Builder.
+		Update("points").
+		Set("time_zone", timeZone).
+		Where("id = ?", pointID).
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("ScheduleRepository.UpdateTimeZone - Exec: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// ReplaceWorkingHours removes previous weekly schedule of the point and stores the given one
+func (r *ScheduleRepository) ReplaceWorkingHours(ctx context.Context, pointID uuid.UUID, workingHours []entity.WorkingHours) error {
+	sql, args, _ := r.Builder.
+		Delete("point_working_hours").
+		Where("point_id = ?", pointID).
+		ToSql()
+
+	if _, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("ScheduleRepository.ReplaceWorkingHours - Exec delete: %w", err)
+	}
+
+	if len(workingHours) == 0 {
+		return nil
+	}
+
+	query := r.Builder.
+		Insert("point_working_hours").
+		Columns("point_id", "weekday", "opens_at", "closes_at")
+
+	for _, hours := range workingHours {
+		query = query.Values(pointID, int(hours.Weekday), hours.OpensAt, hours.ClosesAt)
+	}
+
+	sql, args, _ = query.ToSql()
+
+	if _, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("ScheduleRepository.ReplaceWorkingHours - Exec insert: %w", err)
+	}
+
+	return nil
+}
+
+// ReplaceHolidays removes previous holiday exceptions of the point and stores the given ones
+func (r *ScheduleRepository) ReplaceHolidays(ctx context.Context, pointID uuid.UUID, holidays []entity.Holiday) error {
+	sql, args, _ := r.Builder.
+		Delete("point_holidays").
+		Where("point_id = ?", pointID).
+		ToSql()
+
+	if _, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("ScheduleRepository.ReplaceHolidays - Exec delete: %w", err)
+	}
+
+	if len(holidays) == 0 {
+		return nil
+	}
+
+	query := r.Builder.
+		Insert("point_holidays").
+		Columns("point_id", "date", "opens_at", "closes_at")
+
+	for _, holiday := range holidays {
+		query = query.Values(pointID, holiday.Date, holiday.OpensAt, holiday.ClosesAt)
+	}
+
+	sql, args, _ = query.ToSql()
+
+	if _, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("ScheduleRepository.ReplaceHolidays - Exec insert: %w", err)
+	}
+
+	return nil
+}
+
+// SetOverride allows operations outside working hours until the given moment, nil removes the override
+func (r *ScheduleRepository) SetOverride(ctx context.Context, pointID uuid.UUID, until *time.Time) error {
+	sql, args, _ := r.Builder.
+		Update("points").
+		Set("hours_override_until", until).
+		Where("id = ?", pointID).
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("ScheduleRepository.SetOverride - Exec: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -69,3 +71,23 @@ func (r *UserRepository) GetByEmail(ctx context.Context, email string) (entity.U
 
 	return user, nil
 }
+
+func (r *UserRepository) Exists(ctx context.Context, userID uuid.UUID) (bool, error) {
+	subQuery := r.Builder.
+		Select("1").
+		From("users").
+		Where("id = ?", userID)
+
+	sql, args, _ := r.Builder.
+		Select().
+		Column(squirrel.Expr("EXISTS (?)", subQuery)).
+		ToSql()
+
+	var exists bool
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("UserRepository.Exists - QueryRow: %w", err)
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/jackc/pgerrcode"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/pkg/postgres"
+)
+
+type WebhookRepository struct {
+	*postgres.Postgres
+}
+
+func NewWebhookRepository(pg *postgres.Postgres) *WebhookRepository {
+	return &WebhookRepository{pg}
+}
+
+// Create stores the subscription, unknown point is reported as ErrNotFound
+func (r *WebhookRepository) Create(ctx context.Context, webhook entity.Webhook) (entity.Webhook, error) {
+	sql, args, _ := r.Builder.
+		Insert("webhooks").
+		Columns("url, event_types, point_id, city, secret").
+		Values(webhook.URL, eventTypesToStrings(webhook.EventTypes), webhook.PointID, webhook.City, webhook.Secret).
+		Suffix("RETURNING id, created_at").
+		ToSql()
+
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(&webhook.ID, &webhook.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if ok := errors.As(err, &pgErr); ok {
+			if pgErr.Code == pgerrcode.ForeignKeyViolation {
+				return entity.Webhook{}, ErrNotFound
+			}
+		}
+
+		return entity.Webhook{}, fmt.Errorf("WebhookRepository.Create - QueryRow: %w", err)
+	}
+
+	return webhook, nil
+}
+
+func (r *WebhookRepository) GetAll(ctx context.Context) ([]entity.Webhook, error) {
+	sql, args, _ := r.Builder.
+		Select("id, url, event_types, point_id, city, secret, created_at").
+		From("webhooks").
+		OrderBy("created_at").
+		ToSql()
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepository.GetAll - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var webhooks []entity.Webhook
+	for rows.Next() {
+		var (
+			webhook    entity.Webhook
+			eventTypes []string
+		)
+
+		err = rows.Scan(
+			&webhook.ID,
+			&webhook.URL,
+			&eventTypes,
+			&webhook.PointID,
+			&webhook.City,
+			&webhook.Secret,
+			&webhook.CreatedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("WebhookRepository.GetAll - rows.Scan: %w", err)
+		}
+
+		webhook.EventTypes = stringsToEventTypes(eventTypes)
+		webhooks = append(webhooks, webhook)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("WebhookRepository.GetAll - rows.Err: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepository) GetByID(ctx context.Context, webhookID uuid.UUID) (entity.Webhook, error) {
+	sql, args, _ := r.Builder.
+		Select("url, event_types, point_id, city, secret, created_at").
+		From("webhooks").
+		Where("id = ?", webhookID).
+		ToSql()
+
+	var eventTypes []string
+	webhook := entity.Webhook{ID: webhookID}
+	err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).QueryRow(ctx, sql, args...).Scan(
+		&webhook.URL,
+		&eventTypes,
+		&webhook.PointID,
+		&webhook.City,
+		&webhook.Secret,
+		&webhook.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entity.Webhook{}, ErrNotFound
+		}
+
+		return entity.Webhook{}, fmt.Errorf("WebhookRepository.GetByID - QueryRow: %w", err)
+	}
+
+	webhook.EventTypes = stringsToEventTypes(eventTypes)
+	return webhook, nil
+}
+
+// Delete removes the subscription together with its delivery log
+func (r *WebhookRepository) Delete(ctx context.Context, webhookID uuid.UUID) error {
+	sql, args, _ := r.Builder.
+		Delete("webhooks").
+		Where("id = ?", webhookID).
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("WebhookRepository.Delete - Exec: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// GetDeliveries returns the latest deliveries of the webhook first
+func (r *WebhookRepository) GetDeliveries(ctx context.Context, webhookID uuid.UUID, status *entity.WebhookDeliveryStatus, limit int) ([]entity.WebhookDelivery, error) {
+	query := r.Builder.
+		Select(deliveryColumns...).
+		From("webhook_deliveries").
+		Where("webhook_id = ?", webhookID).
+		OrderBy("created_at DESC", "id").
+		Limit(uint64(limit))
+
+	if status != nil {
+		query = query.Where("status = ?", *status)
+	}
+
+	sql, args, _ := query.ToSql()
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepository.GetDeliveries - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []entity.WebhookDelivery
+	for rows.Next() {
+		var delivery entity.WebhookDelivery
+		if err = rows.Scan(deliveryFields(&delivery)...); err != nil {
+			return nil, fmt.Errorf("WebhookRepository.GetDeliveries - rows.Scan: %w", err)
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("WebhookRepository.GetDeliveries - rows.Err: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// EnqueueDeliveries creates a pending delivery of the event for every matching webhook and returns their number.
+// A webhook matches when it is subscribed to the event type and its point and city filters are empty or equal.
+// Repeated event is not queued again for the same webhook.
+func (r *WebhookRepository) EnqueueDeliveries(ctx context.Context, event entity.Event, payload []byte, now time.Time) (int64, error) {
+	// Select is rendered with `?` placeholders, so the insert numbers all arguments at once
+	subscribers := r.Builder.
+		Select("id").
+		Column("?::uuid", event.ID).
+		Column("?::varchar", string(event.Type)).
+		Column("?::jsonb", payload).
+		Column("?::timestamptz", now).
+		From("webhooks").
+		Where("? = ANY(event_types)", string(event.Type)).
+		Where("(point_id IS NULL OR point_id = ?)", event.PointID).
+		Where("(city IS NULL OR city = ?)", event.City).
+		PlaceholderFormat(squirrel.Question)
+
+	sql, args, _ := r.Builder.
+		Insert("webhook_deliveries").
+		Columns("webhook_id, event_id, event_type, payload, next_attempt_at").
+		Select(subscribers).
+		Suffix("ON CONFLICT (webhook_id, event_id) DO NOTHING").
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("WebhookRepository.EnqueueDeliveries - Exec: %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
+
+// ClaimDue leases up to limit pending deliveries due at now by moving their next attempt to leaseUntil,
+// so concurrent workers skip them and a crashed worker's deliveries are retried once the lease expires.
+func (r *WebhookRepository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]dto.WebhookDispatch, error) {
+	sql := `WITH claimed AS (
+			UPDATE webhook_deliveries
+			SET next_attempt_at = $1
+			WHERE id IN (
+				SELECT id
+				FROM webhook_deliveries
+				WHERE status = $2 AND next_attempt_at <= $3
+				ORDER BY next_attempt_at
+				LIMIT $4
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING ` + strings.Join(deliveryColumns, ", ") + `
+		)
+		SELECT claimed.*, w.url, w.secret
+		FROM claimed
+		INNER JOIN webhooks w ON w.id = claimed.webhook_id
+		ORDER BY claimed.created_at`
+
+	rows, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Query(ctx, sql, leaseUntil, entity.WebhookDeliveryStatusPending, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepository.ClaimDue - Query: %w", err)
+	}
+	defer rows.Close()
+
+	var dispatches []dto.WebhookDispatch
+	for rows.Next() {
+		var dispatch dto.WebhookDispatch
+		if err = rows.Scan(append(deliveryFields(&dispatch.Delivery), &dispatch.URL, &dispatch.Secret)...); err != nil {
+			return nil, fmt.Errorf("WebhookRepository.ClaimDue - rows.Scan: %w", err)
+		}
+
+		dispatches = append(dispatches, dispatch)
+	}
+
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("WebhookRepository.ClaimDue - rows.Err: %w", err)
+	}
+
+	return dispatches, nil
+}
+
+// SaveAttempt stores the outcome of the delivery attempt
+func (r *WebhookRepository) SaveAttempt(ctx context.Context, delivery entity.WebhookDelivery) error {
+	sql, args, _ := r.Builder.
+		Update("webhook_deliveries").
+		Set("status", delivery.Status).
+		Set("attempts", delivery.Attempts).
+		Set("next_attempt_at", delivery.NextAttemptAt).
+		Set("response_status", delivery.ResponseStatus).
+		Set("last_error", delivery.LastError).
+		Set("delivered_at", delivery.DeliveredAt).
+		Where("id = ?", delivery.ID).
+		ToSql()
+
+	cmdTag, err := r.CtxGetter.DefaultTrOrDB(ctx, r.Pool).Exec(ctx, sql, args...)
+	if err != nil {
+		return fmt.Errorf("WebhookRepository.SaveAttempt - Exec: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+var deliveryColumns = []string{
+	"id", "webhook_id", "event_id", "event_type", "payload", "status", "attempts", "next_attempt_at",
+	"response_status", "last_error", "created_at", "delivered_at",
+}
+
+// deliveryFields returns scan destinations in the order of deliveryColumns
+func deliveryFields(delivery *entity.WebhookDelivery) []any {
+	return []any{
+		&delivery.ID,
+		&delivery.WebhookID,
+		&delivery.EventID,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.Status,
+		&delivery.Attempts,
+		&delivery.NextAttemptAt,
+		&delivery.ResponseStatus,
+		&delivery.LastError,
+		&delivery.CreatedAt,
+		&delivery.DeliveredAt,
+	}
+}
+
+func eventTypesToStrings(eventTypes []entity.EventType) []string {
+	result := make([]string, len(eventTypes))
+	for i, eventType := range eventTypes {
+		result[i] = string(eventType)
+	}
+
+	return result
+}
+
+func stringsToEventTypes(values []string) []entity.EventType {
+	result := make([]entity.EventType, len(values))
+	for i, value := range values {
+		result[i] = entity.EventType(value)
+	}
+
+	return result
+}
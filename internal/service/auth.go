@@ -2,10 +2,16 @@ package service
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/google/uuid"
 	"github.com/jonboulle/clockwork"
@@ -14,40 +20,69 @@ import (
 	"github.com/spanwalla/pvz/internal/entity"
 	"github.com/spanwalla/pvz/internal/repository"
 	"github.com/spanwalla/pvz/pkg/hasher"
+	"github.com/spanwalla/pvz/pkg/jwtkeys"
 )
 
+// refreshTokenSize is the number of random bytes in a refresh token
+const refreshTokenSize = 32
+
 var (
-	ErrCannotGenerateToken = errors.New("cannot generate token")
-	ErrCannotAcceptToken   = errors.New("cannot accept this token")
-	ErrTokenExpired        = errors.New("token is expired")
-	ErrUserNotFound        = errors.New("user not found")
-	ErrCannotGetUser       = errors.New("cannot get user")
-	ErrWrongPassword       = errors.New("wrong password")
-	ErrUserAlreadyExists   = errors.New("user already exists")
-	ErrCannotRegisterUser  = errors.New("cannot register user")
+	ErrCannotGenerateToken  = NewError(CodeInternal, "cannot generate token")
+	ErrCannotAcceptToken    = NewError(CodeInvalidToken, "cannot accept this token")
+	ErrCannotCheckToken     = NewError(CodeInternal, "cannot check token")
+	ErrTokenExpired         = NewError(CodeTokenExpired, "token is expired")
+	ErrTokenRevoked         = NewError(CodeTokenRevoked, "token is revoked")
+	ErrInvalidRefreshToken  = NewError(CodeInvalidRefreshToken, "refresh token is invalid, expired or revoked")
+	ErrRefreshTokenReused   = NewError(CodeRefreshTokenReused, "refresh token was already used, the session is revoked")
+	ErrCannotRefreshToken   = NewError(CodeInternal, "cannot refresh token")
+	ErrCannotLogout         = NewError(CodeInternal, "cannot logout")
+	ErrCannotRevokeSessions = NewError(CodeInternal, "cannot revoke sessions")
+	ErrCannotDeleteTokens   = NewError(CodeInternal, "cannot delete expired tokens")
+	ErrUserNotFound         = NewError(CodeUserNotFound, "user not found")
+	ErrCannotGetUser        = NewError(CodeInternal, "cannot get user")
+	ErrWrongPassword        = NewError(CodeWrongPassword, "wrong password")
+	ErrUserAlreadyExists    = NewError(CodeUserAlreadyExists, "user already exists")
+	ErrCannotRegisterUser   = NewError(CodeInternal, "cannot register user")
 )
 
+// AuthSettings of access tokens. Tokens are signed with the signing key of Keys when it is set,
+// otherwise with SecretKey. Tokens signed with SecretKey are accepted only while it is set.
+type AuthSettings struct {
+	SecretKey       string
+	Keys            *jwtkeys.Set
+	Issuer          string
+	Audience        string
+	TokenTTL        time.Duration
+	RefreshTokenTTL time.Duration
+	CleanupInterval time.Duration
+}
+
 type AuthService struct {
-	userRepo       repository.User
-	passwordHasher hasher.PasswordHasher
-	clock          clockwork.Clock
-	secretKey      string
-	tokenTTL       time.Duration
+	userRepo         repository.User
+	refreshTokenRepo repository.RefreshToken
+	revokedTokenRepo repository.RevokedToken
+	trManager        trm.Manager
+	passwordHasher   hasher.PasswordHasher
+	clock            clockwork.Clock
+	settings         AuthSettings
 }
 
-func NewAuthService(userRepo repository.User, passwordHasher hasher.PasswordHasher,
-	clock clockwork.Clock, secretKey string, ttl time.Duration) *AuthService {
+func NewAuthService(userRepo repository.User, refreshTokenRepo repository.RefreshToken, revokedTokenRepo repository.RevokedToken,
+	trManager trm.Manager, passwordHasher hasher.PasswordHasher, clock clockwork.Clock, settings AuthSettings) *AuthService {
 	return &AuthService{
-		userRepo:       userRepo,
-		passwordHasher: passwordHasher,
-		clock:          clock,
-		secretKey:      secretKey,
-		tokenTTL:       ttl,
+		userRepo:         userRepo,
+		refreshTokenRepo: refreshTokenRepo,
+		revokedTokenRepo: revokedTokenRepo,
+		trManager:        trManager,
+		passwordHasher:   passwordHasher,
+		clock:            clock,
+		settings:         settings,
 	}
 }
 
+// DummyLogin issues an access token only, dummy users have no sessions to refresh
 func (s *AuthService) DummyLogin(_ context.Context, role entity.RoleType) (string, error) {
-	token, err := s.generateToken(uuid.New(), role)
+	token, err := s.generateToken(uuid.New(), uuid.New(), role, s.clock.Now())
 	if err != nil {
 		log.Errorf("AuthService.DummyLogin - s.generateToken: %v", err)
 		return "", ErrCannotGenerateToken
@@ -56,28 +91,227 @@ func (s *AuthService) DummyLogin(_ context.Context, role entity.RoleType) (strin
 	return token, nil
 }
 
-func (s *AuthService) Login(ctx context.Context, email, password string) (string, error) {
+func (s *AuthService) Login(ctx context.Context, email, password string) (TokenPair, error) {
 	user, err := s.userRepo.GetByEmail(ctx, email)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return "", ErrUserNotFound
+			return TokenPair{}, ErrUserNotFound
 		}
 
 		log.Errorf("AuthService.Login - s.usersRepo.GetByEmail: %v", err)
-		return "", ErrCannotGetUser
+		return TokenPair{}, ErrCannotGetUser
 	}
 
 	if !s.passwordHasher.Match(password, user.Password) {
-		return "", ErrWrongPassword
+		return TokenPair{}, ErrWrongPassword
 	}
 
-	token, err := s.generateToken(user.ID, user.Role)
+	pair, err := s.issueTokens(ctx, uuid.New(), user.ID, user.Role, s.clock.Now())
 	if err != nil {
-		log.Errorf("AuthService.Login - s.generateToken: %v", err)
-		return "", ErrCannotGenerateToken
+		log.Errorf("AuthService.Login - s.issueTokens: %v", err)
+		return TokenPair{}, ErrCannotGenerateToken
 	}
 
-	return token, nil
+	return pair, nil
+}
+
+// Refresh rotates the refresh token. A token presented for the second time means it was stolen,
+// so the whole session is revoked together with its access tokens.
+func (s *AuthService) Refresh(ctx context.Context, refreshToken string) (TokenPair, error) {
+	var (
+		pair   TokenPair
+		reused bool
+		now    = s.clock.Now()
+	)
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		token, err := s.refreshTokenRepo.GetByHashForUpdate(ctx, hashRefreshToken(refreshToken))
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrInvalidRefreshToken
+			}
+
+			log.Errorf("AuthService.Refresh - s.refreshTokenRepo.GetByHashForUpdate: %v", err)
+			return ErrCannotRefreshToken
+		}
+
+		if token.RevokedAt != nil || !now.Before(token.ExpiresAt) {
+			return ErrInvalidRefreshToken
+		}
+
+		// Revocation must be committed, so reuse is reported after the transaction
+		if token.UsedAt != nil {
+			reused = true
+
+			if err = s.revokeFamily(ctx, token.FamilyID, now); err != nil {
+				log.Errorf("AuthService.Refresh - s.revokeFamily: %v", err)
+				return ErrCannotRefreshToken
+			}
+
+			return nil
+		}
+
+		if err = s.refreshTokenRepo.MarkUsed(ctx, token.ID, now); err != nil {
+			log.Errorf("AuthService.Refresh - s.refreshTokenRepo.MarkUsed: %v", err)
+			return ErrCannotRefreshToken
+		}
+
+		pair, err = s.issueTokens(ctx, token.FamilyID, token.UserID, token.Role, now)
+		if err != nil {
+			log.Errorf("AuthService.Refresh - s.issueTokens: %v", err)
+			return ErrCannotRefreshToken
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidRefreshToken):
+			return TokenPair{}, ErrInvalidRefreshToken
+		case !errors.Is(err, ErrCannotRefreshToken):
+			log.Errorf("AuthService.Refresh - s.trManager.Do: %v", err)
+		}
+
+		return TokenPair{}, ErrCannotRefreshToken
+	}
+
+	if reused {
+		return TokenPair{}, ErrRefreshTokenReused
+	}
+
+	return pair, nil
+}
+
+// Logout revokes the access token the request is made with and the session of refreshToken, if it is given
+func (s *AuthService) Logout(ctx context.Context, claims *entity.TokenClaims, refreshToken string) error {
+	tokenID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		return ErrCannotAcceptToken
+	}
+
+	now := s.clock.Now()
+
+	err = s.trManager.Do(ctx, func(ctx context.Context) error {
+		err := s.revokedTokenRepo.Add(ctx, entity.RevokedToken{ID: tokenID, ExpiresAt: claims.ExpiresAt.Time})
+		if err != nil {
+			log.Errorf("AuthService.Logout - s.revokedTokenRepo.Add: %v", err)
+			return ErrCannotLogout
+		}
+
+		if len(refreshToken) == 0 {
+			return nil
+		}
+
+		token, err := s.refreshTokenRepo.GetByHashForUpdate(ctx, hashRefreshToken(refreshToken))
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrInvalidRefreshToken
+			}
+
+			log.Errorf("AuthService.Logout - s.refreshTokenRepo.GetByHashForUpdate: %v", err)
+			return ErrCannotLogout
+		}
+
+		if token.UserID != claims.UserID {
+			return ErrInvalidRefreshToken
+		}
+
+		if err = s.revokeFamily(ctx, token.FamilyID, now); err != nil {
+			log.Errorf("AuthService.Logout - s.revokeFamily: %v", err)
+			return ErrCannotLogout
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidRefreshToken):
+			return ErrInvalidRefreshToken
+		case !errors.Is(err, ErrCannotLogout):
+			log.Errorf("AuthService.Logout - s.trManager.Do: %v", err)
+		}
+
+		return ErrCannotLogout
+	}
+
+	return nil
+}
+
+// Run deletes expired refresh tokens and revocation list entries every cleanup interval until ctx is done
+func (s *AuthService) Run(ctx context.Context) {
+	ticker := s.clock.NewTicker(s.settings.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+			if err := s.DeleteExpiredTokens(ctx); err != nil {
+				log.Errorf("AuthService.Run - s.DeleteExpiredTokens: %v", err)
+			}
+		}
+	}
+}
+
+// DeleteExpiredTokens removes tokens that cannot be accepted anymore, so the tables do not grow with every login
+func (s *AuthService) DeleteExpiredTokens(ctx context.Context) error {
+	now := s.clock.Now()
+
+	refreshDeleted, err := s.refreshTokenRepo.DeleteExpired(ctx, now)
+	if err != nil {
+		log.Errorf("AuthService.DeleteExpiredTokens - s.refreshTokenRepo.DeleteExpired: %v", err)
+		return ErrCannotDeleteTokens
+	}
+
+	revokedDeleted, err := s.revokedTokenRepo.DeleteExpired(ctx, now)
+	if err != nil {
+		log.Errorf("AuthService.DeleteExpiredTokens - s.revokedTokenRepo.DeleteExpired: %v", err)
+		return ErrCannotDeleteTokens
+	}
+
+	log.Debugf("AuthService.DeleteExpiredTokens - deleted refresh: %d, revoked: %d", refreshDeleted, revokedDeleted)
+
+	return nil
+}
+
+// RevokeUserSessions revokes all refresh tokens of the user and access tokens issued with them
+func (s *AuthService) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	now := s.clock.Now()
+
+	exists, err := s.userRepo.Exists(ctx, userID)
+	if err != nil {
+		log.Errorf("AuthService.RevokeUserSessions - s.userRepo.Exists: %v", err)
+		return ErrCannotRevokeSessions
+	}
+
+	if !exists {
+		return ErrUserNotFound
+	}
+
+	err = s.trManager.Do(ctx, func(ctx context.Context) error {
+		accessTokens, err := s.refreshTokenRepo.RevokeByUser(ctx, userID, now)
+		if err != nil {
+			log.Errorf("AuthService.RevokeUserSessions - s.refreshTokenRepo.RevokeByUser: %v", err)
+			return ErrCannotRevokeSessions
+		}
+
+		if err = s.revokedTokenRepo.Add(ctx, accessTokens...); err != nil {
+			log.Errorf("AuthService.RevokeUserSessions - s.revokedTokenRepo.Add: %v", err)
+			return ErrCannotRevokeSessions
+		}
+
+		return nil
+	})
+	if err != nil {
+		if !errors.Is(err, ErrCannotRevokeSessions) {
+			log.Errorf("AuthService.RevokeUserSessions - s.trManager.Do: %v", err)
+		}
+
+		return ErrCannotRevokeSessions
+	}
+
+	return nil
 }
 
 func (s *AuthService) Register(ctx context.Context, email, password string, role entity.RoleType) (RegisterOutput, error) {
@@ -104,14 +338,16 @@ func (s *AuthService) Register(ctx context.Context, email, password string, role
 	}, nil
 }
 
-func (s *AuthService) ParseToken(token string) (*entity.TokenClaims, error) {
-	jwtToken, err := jwt.ParseWithClaims(token, &entity.TokenClaims{}, func(t *jwt.Token) (any, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
-		}
+// JWKS returns public keys tokens are accepted from, keys kept after a rotation are listed too
+func (s *AuthService) JWKS(_ context.Context) []jwtkeys.JWK {
+	return s.settings.Keys.JWKS()
+}
 
-		return []byte(s.secretKey), nil
-	}, jwt.WithExpirationRequired(), jwt.WithLeeway(time.Second*3))
+// ParseToken checks the signature, issuer, audience and expiration of the access token,
+// then its id (`jti`) against the revocation list
+func (s *AuthService) ParseToken(ctx context.Context, token string) (*entity.TokenClaims, error) {
+	jwtToken, err := jwt.ParseWithClaims(token, &entity.TokenClaims{}, s.verificationKey,
+		jwt.WithExpirationRequired(), jwt.WithLeeway(time.Second*3))
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			return nil, ErrTokenExpired
@@ -127,18 +363,161 @@ func (s *AuthService) ParseToken(token string) (*entity.TokenClaims, error) {
 		return nil, ErrCannotAcceptToken
 	}
 
+	if err = s.checkRecipient(jwtToken, claims); err != nil {
+		log.Errorf("AuthService.ParseToken - s.checkRecipient: %v", err)
+		return nil, ErrCannotAcceptToken
+	}
+
+	tokenID, err := uuid.Parse(claims.ID)
+	if err != nil {
+		log.Errorf("AuthService.ParseToken - uuid.Parse: %v", err)
+		return nil, ErrCannotAcceptToken
+	}
+
+	revoked, err := s.revokedTokenRepo.Exists(ctx, tokenID)
+	if err != nil {
+		log.Errorf("AuthService.ParseToken - s.revokedTokenRepo.Exists: %v", err)
+		return nil, ErrCannotCheckToken
+	}
+
+	if revoked {
+		return nil, ErrTokenRevoked
+	}
+
 	return claims, nil
 }
 
-func (s *AuthService) generateToken(userID uuid.UUID, role entity.RoleType) (string, error) {
-	now := s.clock.Now()
+// issueTokens creates an access token and a refresh token of the session familyID
+func (s *AuthService) issueTokens(ctx context.Context, familyID, userID uuid.UUID, role entity.RoleType, now time.Time) (TokenPair, error) {
+	accessTokenID := uuid.New()
+
+	accessToken, err := s.generateToken(accessTokenID, userID, role, now)
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("s.generateToken: %w", err)
+	}
 
-	return jwt.NewWithClaims(jwt.SigningMethodHS256, &entity.TokenClaims{
+	refreshToken, err := generateRefreshToken()
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("generateRefreshToken: %w", err)
+	}
+
+	err = s.refreshTokenRepo.Create(ctx, entity.RefreshToken{
+		FamilyID:        familyID,
+		UserID:          userID,
+		Role:            role,
+		TokenHash:       hashRefreshToken(refreshToken),
+		AccessTokenID:   accessTokenID,
+		AccessExpiresAt: now.Add(s.settings.TokenTTL),
+		ExpiresAt:       now.Add(s.settings.RefreshTokenTTL),
+	})
+	if err != nil {
+		return TokenPair{}, fmt.Errorf("s.refreshTokenRepo.Create: %w", err)
+	}
+
+	return TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// revokeFamily revokes refresh tokens of the session and puts their access tokens on the revocation list
+func (s *AuthService) revokeFamily(ctx context.Context, familyID uuid.UUID, now time.Time) error {
+	accessTokens, err := s.refreshTokenRepo.RevokeFamily(ctx, familyID, now)
+	if err != nil {
+		return fmt.Errorf("s.refreshTokenRepo.RevokeFamily: %w", err)
+	}
+
+	if err = s.revokedTokenRepo.Add(ctx, accessTokens...); err != nil {
+		return fmt.Errorf("s.revokedTokenRepo.Add: %w", err)
+	}
+
+	return nil
+}
+
+// verificationKey picks the key by the `kid` header, a token without it must be signed with the secret key
+func (s *AuthService) verificationKey(t *jwt.Token) (any, error) {
+	if kid, ok := t.Header["kid"].(string); ok {
+		key, found := s.settings.Keys.Get(kid)
+		if !found {
+			return nil, fmt.Errorf("unknown key id: %s", kid)
+		}
+
+		if t.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+		}
+
+		return key.Public, nil
+	}
+
+	if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok || len(s.settings.SecretKey) == 0 {
+		return nil, fmt.Errorf("unexpected signing method: %v", t.Header["alg"])
+	}
+
+	return []byte(s.settings.SecretKey), nil
+}
+
+// checkRecipient accepts tokens of the configured issuer and audience.
+// Tokens issued before both claims were added are HS256 without either of them, they are accepted as long as they
+// live no longer than TokenTTL, so they stop working at most one TokenTTL after the upgrade.
+func (s *AuthService) checkRecipient(token *jwt.Token, claims *entity.TokenClaims) error {
+	if claims.Issuer == "" && len(claims.Audience) == 0 {
+		if token.Method.Alg() != jwt.SigningMethodHS256.Alg() {
+			return fmt.Errorf("token without issuer and audience is signed with %s", token.Method.Alg())
+		}
+
+		if claims.IssuedAt == nil || claims.ExpiresAt.Sub(claims.IssuedAt.Time) > s.settings.TokenTTL {
+			return errors.New("token without issuer and audience lives longer than token ttl")
+		}
+
+		return nil
+	}
+
+	if claims.Issuer != s.settings.Issuer {
+		return fmt.Errorf("unexpected issuer: %s", claims.Issuer)
+	}
+
+	if !slices.Contains(claims.Audience, s.settings.Audience) {
+		return fmt.Errorf("unexpected audience: %v", claims.Audience)
+	}
+
+	return nil
+}
+
+func (s *AuthService) generateToken(tokenID, userID uuid.UUID, role entity.RoleType, now time.Time) (string, error) {
+	claims := &entity.TokenClaims{
 		UserID: userID,
 		Role:   role,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(now.Add(s.tokenTTL)),
+			ID:        tokenID.String(),
+			Issuer:    s.settings.Issuer,
+			Audience:  jwt.ClaimStrings{s.settings.Audience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.settings.TokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(now),
 		},
-	}).SignedString([]byte(s.secretKey))
+	}
+
+	key, ok := s.settings.Keys.Signing()
+	if !ok {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(s.settings.SecretKey))
+	}
+
+	token := jwt.NewWithClaims(key.Method, claims)
+	token.Header["kid"] = key.ID
+
+	return token.SignedString(key.Private)
+}
+
+// generateRefreshToken returns an opaque random token, only its hash is stored
+func generateRefreshToken() (string, error) {
+	b := make([]byte, refreshTokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
@@ -1,10 +1,16 @@
 package service_test
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
+	"crypto/ed25519"
 	"crypto/elliptic"
 	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"io"
 	"testing"
@@ -23,16 +29,38 @@ import (
 	repomocks "github.com/spanwalla/pvz/internal/repository/mocks"
 	"github.com/spanwalla/pvz/internal/service"
 	"github.com/spanwalla/pvz/pkg/hasher"
+	"github.com/spanwalla/pvz/pkg/jwtkeys"
 )
 
+var authSettings = service.AuthSettings{
+	SecretKey:       "secret",
+	Issuer:          "pvz",
+	Audience:        "pvz",
+	TokenTTL:        time.Minute,
+	RefreshTokenTTL: time.Hour,
+}
+
+// Keys after a rotation: tokens are signed with the Ed25519 key, the RSA key only verifies tokens issued before
+var (
+	rsaKey     = lo.Must(rsa.GenerateKey(rand.Reader, 2048))
+	ed25519Key = ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize))
+	keySet     = lo.Must(jwtkeys.New("ed-2",
+		lo.Must(jwtkeys.NewKey("rsa-1", &rsaKey.PublicKey)),
+		lo.Must(jwtkeys.NewKey("ed-2", ed25519Key)),
+	))
+)
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
 func TestAuthService_DummyLogin(t *testing.T) {
 	log.SetOutput(io.Discard)
 	var (
 		startTime = lo.Must(time.Parse(time.RFC3339, "2025-04-13T10:00:00Z"))
 		ctx       = context.Background()
-		secretKey = "secret"
 		role      = entity.RoleTypeEmployee
-		tokenTTL  = time.Minute
 	)
 
 	t.Run("success", func(t *testing.T) {
@@ -40,10 +68,12 @@ func TestAuthService_DummyLogin(t *testing.T) {
 		ctrl := gomock.NewController(t)
 
 		mockUserRepo := repomocks.NewMockUser(ctrl)
+		mockRefreshTokenRepo := repomocks.NewMockRefreshToken(ctrl)
+		mockRevokedTokenRepo := repomocks.NewMockRevokedToken(ctrl)
 		mockPasswordHasher := hasher.NewMockPasswordHasher(ctrl)
 		mockClock := clockwork.NewFakeClockAt(startTime)
 
-		s := service.NewAuthService(mockUserRepo, mockPasswordHasher, mockClock, secretKey, tokenTTL)
+		s := service.NewAuthService(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo, trManagerStub{}, mockPasswordHasher, mockClock, authSettings)
 
 		got, err := s.DummyLogin(ctx, role)
 
@@ -52,6 +82,45 @@ func TestAuthService_DummyLogin(t *testing.T) {
 	})
 }
 
+func TestAuthService_DummyLogin_SignsWithKey(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		ctx  = context.Background()
+		role = entity.RoleTypeEmployee
+	)
+
+	ctrl := gomock.NewController(t)
+
+	mockUserRepo := repomocks.NewMockUser(ctrl)
+	mockRefreshTokenRepo := repomocks.NewMockRefreshToken(ctrl)
+	mockRevokedTokenRepo := repomocks.NewMockRevokedToken(ctrl)
+	mockPasswordHasher := hasher.NewMockPasswordHasher(ctrl)
+
+	settings := authSettings
+	settings.Keys = keySet
+
+	s := service.NewAuthService(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo, trManagerStub{}, mockPasswordHasher,
+		clockwork.NewRealClock(), settings)
+
+	got, err := s.DummyLogin(ctx, role)
+	assert.NoError(t, err)
+
+	// Other services verify the token with the published key only
+	claims := &entity.TokenClaims{}
+	token, err := jwt.ParseWithClaims(got, claims, func(*jwt.Token) (any, error) {
+		return ed25519Key.Public(), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodEdDSA.Alg()}), jwt.WithIssuer("pvz"), jwt.WithAudience("pvz"))
+	assert.NoError(t, err)
+	assert.Equal(t, "ed-2", token.Header["kid"])
+	assert.Equal(t, role, claims.Role)
+
+	mockRevokedTokenRepo.EXPECT().Exists(ctx, lo.Must(uuid.Parse(claims.ID))).Return(false, nil)
+
+	parsed, err := s.ParseToken(ctx, got)
+	assert.NoError(t, err)
+	assert.Equal(t, claims, parsed)
+}
+
 func TestAuthService_Login(t *testing.T) {
 	log.SetOutput(io.Discard)
 	var (
@@ -60,8 +129,6 @@ func TestAuthService_Login(t *testing.T) {
 		ctx          = context.Background()
 		email        = "test@mail.ru"
 		password     = "12TestMark"
-		secretKey    = "secret"
-		tokenTTL     = time.Minute
 	)
 
 	user := entity.User{
@@ -71,70 +138,513 @@ func TestAuthService_Login(t *testing.T) {
 		Role:     entity.RoleTypeEmployee,
 	}
 
-	token := lo.Must(jwt.NewWithClaims(jwt.SigningMethodHS256, &entity.TokenClaims{
-		UserID: user.ID,
-		Role:   user.Role,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(startTime.Add(tokenTTL)),
-			IssuedAt:  jwt.NewNumericDate(startTime),
-		},
-	}).SignedString([]byte(secretKey)))
-
-	type MockBehavior func(u *repomocks.MockUser, h *hasher.MockPasswordHasher)
+	type MockBehavior func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, h *hasher.MockPasswordHasher)
 
 	for _, tc := range []struct {
 		name         string
 		mockBehavior MockBehavior
-		want         string
 		wantErr      error
 	}{
 		{
 			name: "success",
-			mockBehavior: func(u *repomocks.MockUser, h *hasher.MockPasswordHasher) {
+			mockBehavior: func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, h *hasher.MockPasswordHasher) {
 				u.EXPECT().GetByEmail(ctx, email).Return(user, nil)
 				h.EXPECT().Match(password, user.Password).Return(true)
+				rt.EXPECT().Create(ctx, gomock.Any()).Return(nil)
 			},
-			want: token,
 		},
 		{
 			name: "user not found",
-			mockBehavior: func(u *repomocks.MockUser, h *hasher.MockPasswordHasher) {
+			mockBehavior: func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, h *hasher.MockPasswordHasher) {
 				u.EXPECT().GetByEmail(ctx, email).Return(entity.User{}, repository.ErrNotFound)
 			},
 			wantErr: service.ErrUserNotFound,
 		},
 		{
 			name: "cannot get user",
-			mockBehavior: func(u *repomocks.MockUser, h *hasher.MockPasswordHasher) {
+			mockBehavior: func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, h *hasher.MockPasswordHasher) {
 				u.EXPECT().GetByEmail(ctx, email).Return(entity.User{}, arbitraryErr)
 			},
 			wantErr: service.ErrCannotGetUser,
 		},
 		{
 			name: "wrong password",
-			mockBehavior: func(u *repomocks.MockUser, h *hasher.MockPasswordHasher) {
+			mockBehavior: func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, h *hasher.MockPasswordHasher) {
 				u.EXPECT().GetByEmail(ctx, email).Return(user, nil)
 				h.EXPECT().Match(password, user.Password).Return(false)
 			},
 			wantErr: service.ErrWrongPassword,
 		},
+		{
+			name: "cannot store refresh token",
+			mockBehavior: func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, h *hasher.MockPasswordHasher) {
+				u.EXPECT().GetByEmail(ctx, email).Return(user, nil)
+				h.EXPECT().Match(password, user.Password).Return(true)
+				rt.EXPECT().Create(ctx, gomock.Any()).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotGenerateToken,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			ctrl := gomock.NewController(t)
 
 			mockUserRepo := repomocks.NewMockUser(ctrl)
+			mockRefreshTokenRepo := repomocks.NewMockRefreshToken(ctrl)
+			mockRevokedTokenRepo := repomocks.NewMockRevokedToken(ctrl)
 			mockPasswordHasher := hasher.NewMockPasswordHasher(ctrl)
 			mockClock := clockwork.NewFakeClockAt(startTime)
 
-			tc.mockBehavior(mockUserRepo, mockPasswordHasher)
+			tc.mockBehavior(mockUserRepo, mockRefreshTokenRepo, mockPasswordHasher)
 
-			s := service.NewAuthService(mockUserRepo, mockPasswordHasher, mockClock, secretKey, tokenTTL)
+			s := service.NewAuthService(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo, trManagerStub{}, mockPasswordHasher, mockClock, authSettings)
 
 			got, err := s.Login(ctx, email, password)
 
 			assert.ErrorIs(t, err, tc.wantErr)
-			assert.Equal(t, tc.want, got)
+			if tc.wantErr != nil {
+				assert.Equal(t, service.TokenPair{}, got)
+				return
+			}
+
+			assert.NotEmpty(t, got.AccessToken)
+			assert.NotEmpty(t, got.RefreshToken)
+		})
+	}
+}
+
+func TestAuthService_Login_StoresSession(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		startTime = lo.Must(time.Parse(time.RFC3339, "2025-04-13T10:00:00Z"))
+		ctx       = context.Background()
+		password  = "12TestMark"
+	)
+
+	user := entity.User{ID: uuid.New(), Email: "test@mail.ru", Password: password, Role: entity.RoleTypeModerator}
+
+	ctrl := gomock.NewController(t)
+
+	mockUserRepo := repomocks.NewMockUser(ctrl)
+	mockRefreshTokenRepo := repomocks.NewMockRefreshToken(ctrl)
+	mockRevokedTokenRepo := repomocks.NewMockRevokedToken(ctrl)
+	mockPasswordHasher := hasher.NewMockPasswordHasher(ctrl)
+
+	var stored entity.RefreshToken
+	mockUserRepo.EXPECT().GetByEmail(ctx, user.Email).Return(user, nil)
+	mockPasswordHasher.EXPECT().Match(password, user.Password).Return(true)
+	mockRefreshTokenRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, token entity.RefreshToken) error {
+		stored = token
+		return nil
+	})
+
+	s := service.NewAuthService(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo, trManagerStub{}, mockPasswordHasher,
+		clockwork.NewFakeClockAt(startTime), authSettings)
+
+	got, err := s.Login(ctx, user.Email, password)
+	assert.NoError(t, err)
+
+	// Only the hash of the refresh token is stored, the session is bound to the issued access token
+	claims := &entity.TokenClaims{}
+	_, _, err = jwt.NewParser().ParseUnverified(got.AccessToken, claims)
+	assert.NoError(t, err)
+
+	assert.Equal(t, hashToken(got.RefreshToken), stored.TokenHash)
+	assert.NotEqual(t, uuid.Nil, stored.FamilyID)
+	assert.Equal(t, user.ID, stored.UserID)
+	assert.Equal(t, user.Role, stored.Role)
+	assert.Equal(t, claims.ID, stored.AccessTokenID.String())
+	assert.Equal(t, startTime.Add(authSettings.TokenTTL), stored.AccessExpiresAt)
+	assert.Equal(t, startTime.Add(authSettings.RefreshTokenTTL), stored.ExpiresAt)
+}
+
+func TestAuthService_Refresh(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		startTime    = lo.Must(time.Parse(time.RFC3339, "2025-04-13T10:00:00Z"))
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		refreshToken = "refresh-token"
+		tokenHash    = hashToken(refreshToken)
+	)
+
+	token := entity.RefreshToken{
+		ID:              uuid.New(),
+		FamilyID:        uuid.New(),
+		UserID:          uuid.New(),
+		Role:            entity.RoleTypeEmployee,
+		TokenHash:       tokenHash,
+		AccessTokenID:   uuid.New(),
+		AccessExpiresAt: startTime.Add(-time.Minute),
+		CreatedAt:       startTime.Add(-2 * time.Minute),
+		ExpiresAt:       startTime.Add(time.Hour),
+	}
+
+	used := token
+	used.UsedAt = lo.ToPtr(startTime.Add(-time.Minute))
+
+	revoked := token
+	revoked.RevokedAt = lo.ToPtr(startTime.Add(-time.Minute))
+
+	expired := token
+	expired.ExpiresAt = startTime
+
+	accessTokens := []entity.RevokedToken{{ID: uuid.New(), ExpiresAt: startTime.Add(time.Minute)}}
+
+	type MockBehavior func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(token, nil)
+				rt.EXPECT().MarkUsed(ctx, token.ID, startTime).Return(nil)
+				rt.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, issued entity.RefreshToken) error {
+					if issued.FamilyID != token.FamilyID || issued.UserID != token.UserID || issued.TokenHash == tokenHash {
+						return arbitraryErr
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name: "token not found",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(entity.RefreshToken{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrInvalidRefreshToken,
+		},
+		{
+			name: "cannot get token",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(entity.RefreshToken{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotRefreshToken,
+		},
+		{
+			name: "token revoked",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(revoked, nil)
+			},
+			wantErr: service.ErrInvalidRefreshToken,
+		},
+		{
+			name: "token expired",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(expired, nil)
+			},
+			wantErr: service.ErrInvalidRefreshToken,
+		},
+		{
+			name: "token reused",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(used, nil)
+				rt.EXPECT().RevokeFamily(ctx, token.FamilyID, startTime).Return(accessTokens, nil)
+				rv.EXPECT().Add(ctx, accessTokens).Return(nil)
+			},
+			wantErr: service.ErrRefreshTokenReused,
+		},
+		{
+			name: "cannot revoke reused session",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(used, nil)
+				rt.EXPECT().RevokeFamily(ctx, token.FamilyID, startTime).Return(nil, arbitraryErr)
+			},
+			wantErr: service.ErrCannotRefreshToken,
+		},
+		{
+			name: "cannot mark token used",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(token, nil)
+				rt.EXPECT().MarkUsed(ctx, token.ID, startTime).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotRefreshToken,
+		},
+		{
+			name: "cannot issue tokens",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(token, nil)
+				rt.EXPECT().MarkUsed(ctx, token.ID, startTime).Return(nil)
+				rt.EXPECT().Create(ctx, gomock.Any()).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotRefreshToken,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockUserRepo := repomocks.NewMockUser(ctrl)
+			mockRefreshTokenRepo := repomocks.NewMockRefreshToken(ctrl)
+			mockRevokedTokenRepo := repomocks.NewMockRevokedToken(ctrl)
+			mockPasswordHasher := hasher.NewMockPasswordHasher(ctrl)
+			mockClock := clockwork.NewFakeClockAt(startTime)
+
+			tc.mockBehavior(mockRefreshTokenRepo, mockRevokedTokenRepo)
+
+			s := service.NewAuthService(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo, trManagerStub{}, mockPasswordHasher, mockClock, authSettings)
+
+			got, err := s.Refresh(ctx, refreshToken)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr != nil {
+				assert.Equal(t, service.TokenPair{}, got)
+				return
+			}
+
+			assert.NotEmpty(t, got.AccessToken)
+			assert.NotEmpty(t, got.RefreshToken)
+			assert.NotEqual(t, refreshToken, got.RefreshToken)
+		})
+	}
+}
+
+func TestAuthService_Logout(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		startTime    = lo.Must(time.Parse(time.RFC3339, "2025-04-13T10:00:00Z"))
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		userID       = uuid.New()
+		tokenID      = uuid.New()
+		refreshToken = "refresh-token"
+		tokenHash    = hashToken(refreshToken)
+	)
+
+	claims := &entity.TokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID.String(),
+			ExpiresAt: jwt.NewNumericDate(startTime.Add(time.Minute)),
+		},
+		UserID: userID,
+		Role:   entity.RoleTypeEmployee,
+	}
+
+	current := entity.RevokedToken{ID: tokenID, ExpiresAt: claims.ExpiresAt.Time}
+	session := entity.RefreshToken{ID: uuid.New(), FamilyID: uuid.New(), UserID: userID, TokenHash: tokenHash}
+
+	type MockBehavior func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken)
+
+	for _, tc := range []struct {
+		name         string
+		refreshToken string
+		mockBehavior MockBehavior
+		wantErr      error
+	}{
+		{
+			name:         "success",
+			refreshToken: refreshToken,
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Add(ctx, current).Return(nil)
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(session, nil)
+				rt.EXPECT().RevokeFamily(ctx, session.FamilyID, startTime).Return([]entity.RevokedToken{current}, nil)
+				rv.EXPECT().Add(ctx, current).Return(nil)
+			},
+		},
+		{
+			name: "without refresh token",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Add(ctx, current).Return(nil)
+			},
+		},
+		{
+			name: "cannot revoke access token",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Add(ctx, current).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotLogout,
+		},
+		{
+			name:         "refresh token not found",
+			refreshToken: refreshToken,
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Add(ctx, current).Return(nil)
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(entity.RefreshToken{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrInvalidRefreshToken,
+		},
+		{
+			name:         "refresh token of another user",
+			refreshToken: refreshToken,
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Add(ctx, current).Return(nil)
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(entity.RefreshToken{FamilyID: uuid.New(), UserID: uuid.New()}, nil)
+			},
+			wantErr: service.ErrInvalidRefreshToken,
+		},
+		{
+			name:         "cannot revoke session",
+			refreshToken: refreshToken,
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Add(ctx, current).Return(nil)
+				rt.EXPECT().GetByHashForUpdate(ctx, tokenHash).Return(session, nil)
+				rt.EXPECT().RevokeFamily(ctx, session.FamilyID, startTime).Return(nil, arbitraryErr)
+			},
+			wantErr: service.ErrCannotLogout,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockUserRepo := repomocks.NewMockUser(ctrl)
+			mockRefreshTokenRepo := repomocks.NewMockRefreshToken(ctrl)
+			mockRevokedTokenRepo := repomocks.NewMockRevokedToken(ctrl)
+			mockPasswordHasher := hasher.NewMockPasswordHasher(ctrl)
+			mockClock := clockwork.NewFakeClockAt(startTime)
+
+			tc.mockBehavior(mockRefreshTokenRepo, mockRevokedTokenRepo)
+
+			s := service.NewAuthService(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo, trManagerStub{}, mockPasswordHasher, mockClock, authSettings)
+
+			err := s.Logout(ctx, claims, tc.refreshToken)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestAuthService_RevokeUserSessions(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		startTime    = lo.Must(time.Parse(time.RFC3339, "2025-04-13T10:00:00Z"))
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		userID       = uuid.New()
+	)
+
+	accessTokens := []entity.RevokedToken{
+		{ID: uuid.New(), ExpiresAt: startTime.Add(time.Minute)},
+		{ID: uuid.New(), ExpiresAt: startTime.Add(2 * time.Minute)},
+	}
+
+	type MockBehavior func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				u.EXPECT().Exists(ctx, userID).Return(true, nil)
+				rt.EXPECT().RevokeByUser(ctx, userID, startTime).Return(accessTokens, nil)
+				rv.EXPECT().Add(ctx, accessTokens).Return(nil)
+			},
+		},
+		{
+			name: "cannot revoke refresh tokens",
+			mockBehavior: func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				u.EXPECT().Exists(ctx, userID).Return(true, nil)
+				rt.EXPECT().RevokeByUser(ctx, userID, startTime).Return(nil, arbitraryErr)
+			},
+			wantErr: service.ErrCannotRevokeSessions,
+		},
+		{
+			name: "cannot revoke access tokens",
+			mockBehavior: func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				u.EXPECT().Exists(ctx, userID).Return(true, nil)
+				rt.EXPECT().RevokeByUser(ctx, userID, startTime).Return(accessTokens, nil)
+				rv.EXPECT().Add(ctx, accessTokens).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotRevokeSessions,
+		},
+		{
+			name: "user not found",
+			mockBehavior: func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				u.EXPECT().Exists(ctx, userID).Return(false, nil)
+			},
+			wantErr: service.ErrUserNotFound,
+		},
+		{
+			name: "cannot check user",
+			mockBehavior: func(u *repomocks.MockUser, rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				u.EXPECT().Exists(ctx, userID).Return(false, arbitraryErr)
+			},
+			wantErr: service.ErrCannotRevokeSessions,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockUserRepo := repomocks.NewMockUser(ctrl)
+			mockRefreshTokenRepo := repomocks.NewMockRefreshToken(ctrl)
+			mockRevokedTokenRepo := repomocks.NewMockRevokedToken(ctrl)
+			mockPasswordHasher := hasher.NewMockPasswordHasher(ctrl)
+			mockClock := clockwork.NewFakeClockAt(startTime)
+
+			tc.mockBehavior(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo)
+
+			s := service.NewAuthService(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo, trManagerStub{}, mockPasswordHasher, mockClock, authSettings)
+
+			err := s.RevokeUserSessions(ctx, userID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestAuthService_DeleteExpiredTokens(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		startTime    = lo.Must(time.Parse(time.RFC3339, "2025-04-13T10:00:00Z"))
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+	)
+
+	type MockBehavior func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().DeleteExpired(ctx, startTime).Return(int64(2), nil)
+				rv.EXPECT().DeleteExpired(ctx, startTime).Return(int64(1), nil)
+			},
+		},
+		{
+			name: "cannot delete refresh tokens",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().DeleteExpired(ctx, startTime).Return(int64(0), arbitraryErr)
+			},
+			wantErr: service.ErrCannotDeleteTokens,
+		},
+		{
+			name: "cannot delete revoked tokens",
+			mockBehavior: func(rt *repomocks.MockRefreshToken, rv *repomocks.MockRevokedToken) {
+				rt.EXPECT().DeleteExpired(ctx, startTime).Return(int64(2), nil)
+				rv.EXPECT().DeleteExpired(ctx, startTime).Return(int64(0), arbitraryErr)
+			},
+			wantErr: service.ErrCannotDeleteTokens,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockUserRepo := repomocks.NewMockUser(ctrl)
+			mockRefreshTokenRepo := repomocks.NewMockRefreshToken(ctrl)
+			mockRevokedTokenRepo := repomocks.NewMockRevokedToken(ctrl)
+			mockPasswordHasher := hasher.NewMockPasswordHasher(ctrl)
+			mockClock := clockwork.NewFakeClockAt(startTime)
+
+			tc.mockBehavior(mockRefreshTokenRepo, mockRevokedTokenRepo)
+
+			s := service.NewAuthService(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo, trManagerStub{}, mockPasswordHasher, mockClock, authSettings)
+
+			err := s.DeleteExpiredTokens(ctx)
+
+			assert.ErrorIs(t, err, tc.wantErr)
 		})
 	}
 }
@@ -148,8 +658,6 @@ func TestAuthService_Register(t *testing.T) {
 		email        = "test@mail.ru"
 		password     = "12TestMark"
 		role         = entity.RoleTypeModerator
-		secretKey    = "secret"
-		tokenTTL     = time.Minute
 	)
 
 	user := entity.User{
@@ -208,12 +716,14 @@ func TestAuthService_Register(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
 			mockUserRepo := repomocks.NewMockUser(ctrl)
+			mockRefreshTokenRepo := repomocks.NewMockRefreshToken(ctrl)
+			mockRevokedTokenRepo := repomocks.NewMockRevokedToken(ctrl)
 			mockPasswordHasher := hasher.NewMockPasswordHasher(ctrl)
 			mockClock := clockwork.NewFakeClockAt(startTime)
 
 			tc.mockBehavior(mockUserRepo, mockPasswordHasher)
 
-			s := service.NewAuthService(mockUserRepo, mockPasswordHasher, mockClock, secretKey, tokenTTL)
+			s := service.NewAuthService(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo, trManagerStub{}, mockPasswordHasher, mockClock, authSettings)
 
 			got, err := s.Register(ctx, email, password, role)
 
@@ -226,16 +736,22 @@ func TestAuthService_Register(t *testing.T) {
 func TestAuthService_ParseToken(t *testing.T) {
 	log.SetOutput(io.Discard)
 	var (
+		arbitraryErr      = errors.New("arbitrary error")
+		ctx               = context.Background()
 		issuedTimeValid   = time.Now()
 		issuedTimeExpired = issuedTimeValid.Add(-time.Hour)
 		userID            = lo.Must(uuid.Parse("2864e043-95b7-42e1-8201-9b0fc2f7e0c1"))
+		tokenID           = lo.Must(uuid.Parse("0b6f4b5e-6a8e-4a55-9f55-0b2a8a3c1f10"))
 		role              = entity.RoleTypeModerator
-		secretKey         = "secret"
+		secretKey         = authSettings.SecretKey
 		tokenTTL          = time.Minute * 10
 	)
 
 	validClaims := entity.TokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID.String(),
+			Issuer:    authSettings.Issuer,
+			Audience:  jwt.ClaimStrings{authSettings.Audience},
 			ExpiresAt: jwt.NewNumericDate(issuedTimeValid.Add(tokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(issuedTimeValid),
 		},
@@ -245,6 +761,9 @@ func TestAuthService_ParseToken(t *testing.T) {
 
 	expiredClaims := entity.TokenClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        tokenID.String(),
+			Issuer:    authSettings.Issuer,
+			Audience:  jwt.ClaimStrings{authSettings.Audience},
 			ExpiresAt: jwt.NewNumericDate(issuedTimeExpired.Add(tokenTTL)),
 			IssuedAt:  jwt.NewNumericDate(issuedTimeExpired.Add(tokenTTL)),
 		},
@@ -252,6 +771,31 @@ func TestAuthService_ParseToken(t *testing.T) {
 		Role:   role,
 	}
 
+	withoutIDClaims := validClaims
+	withoutIDClaims.ID = ""
+
+	diffIssuerClaims := validClaims
+	diffIssuerClaims.Issuer = "other"
+
+	diffAudienceClaims := validClaims
+	diffAudienceClaims.Audience = jwt.ClaimStrings{"other"}
+
+	// Tokens issued before issuer and audience were added
+	legacyClaims := validClaims
+	legacyClaims.Issuer = ""
+	legacyClaims.Audience = nil
+	legacyClaims.ExpiresAt = jwt.NewNumericDate(issuedTimeValid.Add(authSettings.TokenTTL))
+
+	legacyLongClaims := legacyClaims
+	legacyLongClaims.ExpiresAt = jwt.NewNumericDate(issuedTimeValid.Add(tokenTTL))
+
+	// signWithKey signs claims as the service with the given key would, the key id is put in the header
+	signWithKey := func(method jwt.SigningMethod, kid string, key any, claims *entity.TokenClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		token.Header["kid"] = kid
+		return lo.Must(token.SignedString(key))
+	}
+
 	validToken := lo.Must(jwt.NewWithClaims(jwt.SigningMethodHS256, &validClaims).SignedString([]byte(secretKey)))
 	diffSecretKeyToken := lo.Must(jwt.NewWithClaims(jwt.SigningMethodHS256, &validClaims).
 		SignedString([]byte(secretKey + "a")))
@@ -259,17 +803,42 @@ func TestAuthService_ParseToken(t *testing.T) {
 		SignedString([]byte(secretKey)))
 	diffSigningMethodToken := lo.Must(jwt.NewWithClaims(jwt.SigningMethodES256, &validClaims).
 		SignedString(lo.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader))))
+	withoutIDToken := lo.Must(jwt.NewWithClaims(jwt.SigningMethodHS256, &withoutIDClaims).SignedString([]byte(secretKey)))
+	diffIssuerToken := lo.Must(jwt.NewWithClaims(jwt.SigningMethodHS256, &diffIssuerClaims).SignedString([]byte(secretKey)))
+	diffAudienceToken := lo.Must(jwt.NewWithClaims(jwt.SigningMethodHS256, &diffAudienceClaims).SignedString([]byte(secretKey)))
+	legacyToken := lo.Must(jwt.NewWithClaims(jwt.SigningMethodHS256, &legacyClaims).SignedString([]byte(secretKey)))
+	legacyLongToken := lo.Must(jwt.NewWithClaims(jwt.SigningMethodHS256, &legacyLongClaims).SignedString([]byte(secretKey)))
+	ed25519Token := signWithKey(jwt.SigningMethodEdDSA, "ed-2", ed25519Key, &validClaims)
+	legacyKeyToken := signWithKey(jwt.SigningMethodEdDSA, "ed-2", ed25519Key, &legacyClaims)
+	rotatedKeyToken := signWithKey(jwt.SigningMethodRS256, "rsa-1", rsaKey, &validClaims)
+	unknownKeyToken := signWithKey(jwt.SigningMethodEdDSA, "ed-3", ed25519Key, &validClaims)
+	diffKeyToken := signWithKey(jwt.SigningMethodEdDSA, "ed-2",
+		ed25519.NewKeyFromSeed(bytes.Repeat([]byte{2}, ed25519.SeedSize)), &validClaims)
+	keyMethodMismatchToken := signWithKey(jwt.SigningMethodHS256, "rsa-1", []byte(secretKey), &validClaims)
+
+	keySettings := authSettings
+	keySettings.Keys = keySet
+
+	withoutSecretKeySettings := keySettings
+	withoutSecretKeySettings.SecretKey = ""
+
+	type MockBehavior func(rv *repomocks.MockRevokedToken)
 
 	for _, tc := range []struct {
-		name    string
-		token   string
-		want    *entity.TokenClaims
-		wantErr error
+		name         string
+		token        string
+		settings     *service.AuthSettings
+		mockBehavior MockBehavior
+		want         *entity.TokenClaims
+		wantErr      error
 	}{
 		{
 			name:  "success",
 			token: validToken,
-			want:  &validClaims,
+			mockBehavior: func(rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Exists(ctx, tokenID).Return(false, nil)
+			},
+			want: &validClaims,
 		},
 		{
 			name:    "diff secret key",
@@ -286,21 +855,174 @@ func TestAuthService_ParseToken(t *testing.T) {
 			token:   diffSigningMethodToken,
 			wantErr: service.ErrCannotAcceptToken,
 		},
+		{
+			name:    "without token id",
+			token:   withoutIDToken,
+			wantErr: service.ErrCannotAcceptToken,
+		},
+		{
+			name:  "revoked token",
+			token: validToken,
+			mockBehavior: func(rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Exists(ctx, tokenID).Return(true, nil)
+			},
+			wantErr: service.ErrTokenRevoked,
+		},
+		{
+			name:  "cannot check revocation",
+			token: validToken,
+			mockBehavior: func(rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Exists(ctx, tokenID).Return(false, arbitraryErr)
+			},
+			wantErr: service.ErrCannotCheckToken,
+		},
+		{
+			name:    "diff issuer",
+			token:   diffIssuerToken,
+			wantErr: service.ErrCannotAcceptToken,
+		},
+		{
+			name:    "diff audience",
+			token:   diffAudienceToken,
+			wantErr: service.ErrCannotAcceptToken,
+		},
+		{
+			name:  "legacy token without issuer and audience",
+			token: legacyToken,
+			mockBehavior: func(rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Exists(ctx, tokenID).Return(false, nil)
+			},
+			want: &legacyClaims,
+		},
+		{
+			name:    "legacy token living longer than token ttl",
+			token:   legacyLongToken,
+			wantErr: service.ErrCannotAcceptToken,
+		},
+		{
+			name:     "legacy token signed with a key",
+			token:    legacyKeyToken,
+			settings: &keySettings,
+			wantErr:  service.ErrCannotAcceptToken,
+		},
+		{
+			name:     "signing key",
+			token:    ed25519Token,
+			settings: &keySettings,
+			mockBehavior: func(rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Exists(ctx, tokenID).Return(false, nil)
+			},
+			want: &validClaims,
+		},
+		{
+			name:     "rotated key",
+			token:    rotatedKeyToken,
+			settings: &keySettings,
+			mockBehavior: func(rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Exists(ctx, tokenID).Return(false, nil)
+			},
+			want: &validClaims,
+		},
+		{
+			name:     "secret key during migration to keys",
+			token:    validToken,
+			settings: &keySettings,
+			mockBehavior: func(rv *repomocks.MockRevokedToken) {
+				rv.EXPECT().Exists(ctx, tokenID).Return(false, nil)
+			},
+			want: &validClaims,
+		},
+		{
+			name:     "secret key is not set",
+			token:    validToken,
+			settings: &withoutSecretKeySettings,
+			wantErr:  service.ErrCannotAcceptToken,
+		},
+		{
+			name:     "unknown key id",
+			token:    unknownKeyToken,
+			settings: &keySettings,
+			wantErr:  service.ErrCannotAcceptToken,
+		},
+		{
+			name:     "diff key with known id",
+			token:    diffKeyToken,
+			settings: &keySettings,
+			wantErr:  service.ErrCannotAcceptToken,
+		},
+		{
+			name:     "signing method of key id mismatch",
+			token:    keyMethodMismatchToken,
+			settings: &keySettings,
+			wantErr:  service.ErrCannotAcceptToken,
+		},
+		{
+			name:    "key id without keys",
+			token:   ed25519Token,
+			wantErr: service.ErrCannotAcceptToken,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
 			ctrl := gomock.NewController(t)
 
 			mockUserRepo := repomocks.NewMockUser(ctrl)
+			mockRefreshTokenRepo := repomocks.NewMockRefreshToken(ctrl)
+			mockRevokedTokenRepo := repomocks.NewMockRevokedToken(ctrl)
 			mockPasswordHasher := hasher.NewMockPasswordHasher(ctrl)
 			mockClock := clockwork.NewFakeClock()
 
-			s := service.NewAuthService(mockUserRepo, mockPasswordHasher, mockClock, secretKey, tokenTTL)
+			if tc.mockBehavior != nil {
+				tc.mockBehavior(mockRevokedTokenRepo)
+			}
+
+			settings := authSettings
+			if tc.settings != nil {
+				settings = *tc.settings
+			}
 
-			got, err := s.ParseToken(tc.token)
+			s := service.NewAuthService(mockUserRepo, mockRefreshTokenRepo, mockRevokedTokenRepo, trManagerStub{}, mockPasswordHasher, mockClock, settings)
+
+			got, err := s.ParseToken(ctx, tc.token)
 
 			assert.ErrorIs(t, err, tc.wantErr)
 			assert.Equal(t, tc.want, got)
 		})
 	}
 }
+
+func TestAuthService_JWKS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	settings := authSettings
+	settings.Keys = keySet
+
+	s := service.NewAuthService(repomocks.NewMockUser(ctrl), repomocks.NewMockRefreshToken(ctrl), repomocks.NewMockRevokedToken(ctrl),
+		trManagerStub{}, hasher.NewMockPasswordHasher(ctrl), clockwork.NewFakeClock(), settings)
+
+	// The rotated key stays published until tokens signed with it expire
+	assert.Equal(t, []jwtkeys.JWK{
+		{
+			KeyType:   "OKP",
+			KeyID:     "ed-2",
+			Use:       "sig",
+			Algorithm: "EdDSA",
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(ed25519Key.Public().(ed25519.PublicKey)),
+		},
+		{
+			KeyType:   "RSA",
+			KeyID:     "rsa-1",
+			Use:       "sig",
+			Algorithm: "RS256",
+			N:         base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+			E:         "AQAB",
+		},
+	}, s.JWKS(context.Background()))
+
+	// Without keys tokens are signed with the secret key, which is never published
+	s = service.NewAuthService(repomocks.NewMockUser(ctrl), repomocks.NewMockRefreshToken(ctrl), repomocks.NewMockRevokedToken(ctrl),
+		trManagerStub{}, hasher.NewMockPasswordHasher(ctrl), clockwork.NewFakeClock(), authSettings)
+
+	assert.Empty(t, s.JWKS(context.Background()))
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+)
+
+var (
+	ErrCityAlreadyExists = NewError(CodeCityAlreadyExists, "city already exists")
+	ErrCannotCreateCity  = NewError(CodeInternal, "cannot create city")
+	ErrCannotGetCities   = NewError(CodeInternal, "cannot get cities")
+	ErrCannotUpdateCity  = NewError(CodeInternal, "cannot update city")
+)
+
+type CityService struct {
+	cityRepo repository.City
+}
+
+func NewCityService(cityRepo repository.City) *CityService {
+	return &CityService{
+		cityRepo: cityRepo,
+	}
+}
+
+func (s *CityService) Create(ctx context.Context, name string) (entity.City, error) {
+	city, err := s.cityRepo.Create(ctx, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return entity.City{}, ErrCityAlreadyExists
+		}
+
+		log.Errorf("CityService.Create - s.cityRepo.Create: %v", err)
+		return entity.City{}, ErrCannotCreateCity
+	}
+
+	return city, nil
+}
+
+func (s *CityService) GetAll(ctx context.Context, includeInactive bool) ([]entity.City, error) {
+	cities, err := s.cityRepo.GetAll(ctx, includeInactive)
+	if err != nil {
+		log.Errorf("CityService.GetAll - s.cityRepo.GetAll: %v", err)
+		return []entity.City{}, ErrCannotGetCities
+	}
+
+	return cities, nil
+}
+
+func (s *CityService) Rename(ctx context.Context, cityID int, name string) (entity.City, error) {
+	city, err := s.cityRepo.Rename(ctx, cityID, name)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound):
+			return entity.City{}, ErrCityNotFound
+		case errors.Is(err, repository.ErrAlreadyExists):
+			return entity.City{}, ErrCityAlreadyExists
+		}
+
+		log.Errorf("CityService.Rename - s.cityRepo.Rename: %v", err)
+		return entity.City{}, ErrCannotUpdateCity
+	}
+
+	return city, nil
+}
+
+func (s *CityService) Deactivate(ctx context.Context, cityID int) (entity.City, error) {
+	city, err := s.cityRepo.Deactivate(ctx, cityID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.City{}, ErrCityNotFound
+		}
+
+		log.Errorf("CityService.Deactivate - s.cityRepo.Deactivate: %v", err)
+		return entity.City{}, ErrCannotUpdateCity
+	}
+
+	return city, nil
+}
@@ -0,0 +1,280 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+	repomocks "github.com/spanwalla/pvz/internal/repository/mocks"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+func TestCityService_Create(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		name         = "Екатеринбург"
+	)
+
+	city := entity.City{
+		ID:        4,
+		Name:      name,
+		IsActive:  true,
+		CreatedAt: time.Now(),
+	}
+
+	type MockBehavior func(c *repomocks.MockCity)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         entity.City
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().Create(ctx, name).Return(city, nil)
+			},
+			want: city,
+		},
+		{
+			name: "city already exists",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().Create(ctx, name).Return(entity.City{}, repository.ErrAlreadyExists)
+			},
+			wantErr: service.ErrCityAlreadyExists,
+		},
+		{
+			name: "cannot create city",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().Create(ctx, name).Return(entity.City{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotCreateCity,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockCityRepo := repomocks.NewMockCity(ctrl)
+
+			tc.mockBehavior(mockCityRepo)
+
+			s := service.NewCityService(mockCityRepo)
+
+			got, err := s.Create(ctx, name)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCityService_GetAll(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+	)
+
+	cities := []entity.City{
+		{ID: 3, Name: "Казань", IsActive: true, CreatedAt: time.Now()},
+		{ID: 1, Name: "Москва", IsActive: true, CreatedAt: time.Now()},
+		{ID: 5, Name: "Тверь", IsActive: false, CreatedAt: time.Now()},
+	}
+
+	type MockBehavior func(c *repomocks.MockCity)
+
+	for _, tc := range []struct {
+		name            string
+		includeInactive bool
+		mockBehavior    MockBehavior
+		want            []entity.City
+		wantErr         error
+	}{
+		{
+			name:            "success",
+			includeInactive: true,
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().GetAll(ctx, true).Return(cities, nil)
+			},
+			want: cities,
+		},
+		{
+			name:            "only active",
+			includeInactive: false,
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().GetAll(ctx, false).Return(cities[:2], nil)
+			},
+			want: cities[:2],
+		},
+		{
+			name: "cannot get cities",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().GetAll(ctx, false).Return(nil, arbitraryErr)
+			},
+			want:    []entity.City{},
+			wantErr: service.ErrCannotGetCities,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockCityRepo := repomocks.NewMockCity(ctrl)
+
+			tc.mockBehavior(mockCityRepo)
+
+			s := service.NewCityService(mockCityRepo)
+
+			got, err := s.GetAll(ctx, tc.includeInactive)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCityService_Rename(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		cityID       = 2
+		name         = "Петербург"
+	)
+
+	city := entity.City{
+		ID:        cityID,
+		Name:      name,
+		IsActive:  true,
+		CreatedAt: time.Now(),
+	}
+
+	type MockBehavior func(c *repomocks.MockCity)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         entity.City
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().Rename(ctx, cityID, name).Return(city, nil)
+			},
+			want: city,
+		},
+		{
+			name: "city not found",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().Rename(ctx, cityID, name).Return(entity.City{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrCityNotFound,
+		},
+		{
+			name: "name already taken",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().Rename(ctx, cityID, name).Return(entity.City{}, repository.ErrAlreadyExists)
+			},
+			wantErr: service.ErrCityAlreadyExists,
+		},
+		{
+			name: "cannot rename city",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().Rename(ctx, cityID, name).Return(entity.City{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdateCity,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockCityRepo := repomocks.NewMockCity(ctrl)
+
+			tc.mockBehavior(mockCityRepo)
+
+			s := service.NewCityService(mockCityRepo)
+
+			got, err := s.Rename(ctx, cityID, name)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestCityService_Deactivate(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		cityID       = 3
+	)
+
+	city := entity.City{
+		ID:        cityID,
+		Name:      "Казань",
+		IsActive:  false,
+		CreatedAt: time.Now(),
+	}
+
+	type MockBehavior func(c *repomocks.MockCity)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         entity.City
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().Deactivate(ctx, cityID).Return(city, nil)
+			},
+			want: city,
+		},
+		{
+			name: "city not found",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().Deactivate(ctx, cityID).Return(entity.City{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrCityNotFound,
+		},
+		{
+			name: "cannot deactivate city",
+			mockBehavior: func(c *repomocks.MockCity) {
+				c.EXPECT().Deactivate(ctx, cityID).Return(entity.City{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdateCity,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockCityRepo := repomocks.NewMockCity(ctrl)
+
+			tc.mockBehavior(mockCityRepo)
+
+			s := service.NewCityService(mockCityRepo)
+
+			got, err := s.Deactivate(ctx, cityID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package service
+
+import "errors"
+
+// Code is a stable machine-readable error identifier. Messages may change, clients should match errors by code.
+type Code string
+
+// Generic codes, also used by controllers for errors that do not come from services
+const (
+	CodeInternal         Code = "INTERNAL_ERROR"
+	CodeInvalidRequest   Code = "INVALID_REQUEST"
+	CodeValidationFailed Code = "VALIDATION_FAILED"
+	CodeUnauthorized     Code = "UNAUTHORIZED"
+	CodeForbidden        Code = "FORBIDDEN"
+	CodeNotFound         Code = "NOT_FOUND"
+	CodeMethodNotAllowed Code = "METHOD_NOT_ALLOWED"
+)
+
+const (
+	CodeInvalidToken        Code = "INVALID_TOKEN"
+	CodeTokenExpired        Code = "TOKEN_EXPIRED"
+	CodeTokenRevoked        Code = "TOKEN_REVOKED"
+	CodeInvalidRefreshToken Code = "INVALID_REFRESH_TOKEN"
+	CodeRefreshTokenReused  Code = "REFRESH_TOKEN_REUSED"
+	CodeUserNotFound        Code = "USER_NOT_FOUND"
+	CodeWrongPassword       Code = "WRONG_PASSWORD"
+	CodeUserAlreadyExists   Code = "USER_ALREADY_EXISTS"
+
+	CodeCityNotFound      Code = "CITY_NOT_FOUND"
+	CodeCityAlreadyExists Code = "CITY_ALREADY_EXISTS"
+
+	CodePointNotFound            Code = "PVZ_NOT_FOUND"
+	CodePointSuspended           Code = "PVZ_SUSPENDED"
+	CodePointClosed              Code = "PVZ_CLOSED"
+	CodePointOutsideWorkingHours Code = "PVZ_OUTSIDE_WORKING_HOURS"
+	CodeInvalidPointTransition   Code = "INVALID_PVZ_TRANSITION"
+	CodeInvalidCursor            Code = "INVALID_CURSOR"
+
+	CodeReceptionNotFound       Code = "RECEPTION_NOT_FOUND"
+	CodeReceptionNotActive      Code = "RECEPTION_NOT_ACTIVE"
+	CodeReceptionAlreadyOpened  Code = "RECEPTION_ALREADY_OPENED"
+	CodeClosedReceptionNotFound Code = "CLOSED_RECEPTION_NOT_FOUND"
+
+	CodeProductNotFound          Code = "PRODUCT_NOT_FOUND"
+	CodeProductAlreadyDeleted    Code = "PRODUCT_ALREADY_DELETED"
+	CodeProductAlreadyScanned    Code = "PRODUCT_ALREADY_SCANNED"
+	CodeDuplicateItemCode        Code = "DUPLICATE_ITEM_CODE"
+	CodeInvalidProductTransition Code = "INVALID_PRODUCT_TRANSITION"
+
+	CodeProductTypeNotFound      Code = "PRODUCT_TYPE_NOT_FOUND"
+	CodeProductTypeAlreadyExists Code = "PRODUCT_TYPE_ALREADY_EXISTS"
+	CodeProductTypeNameRequired  Code = "PRODUCT_TYPE_NAME_REQUIRED"
+
+	CodeInvalidTimeZone Code = "INVALID_TIME_ZONE"
+	CodeInvalidSchedule Code = "INVALID_SCHEDULE"
+	CodeInvalidOverride Code = "INVALID_OVERRIDE"
+
+	CodeIdempotencyKeyReused        Code = "IDEMPOTENCY_KEY_REUSED"
+	CodeIdempotentRequestInProgress Code = "IDEMPOTENT_REQUEST_IN_PROGRESS"
+
+	CodeWebhookNotFound   Code = "WEBHOOK_NOT_FOUND"
+	CodeInvalidWebhookURL Code = "INVALID_WEBHOOK_URL"
+)
+
+// Error is a service error with a stable code. Errors are declared once as sentinels and compared with errors.Is.
+type Error struct {
+	code    Code
+	message string
+}
+
+func NewError(code Code, message string) *Error {
+	return &Error{
+		code:    code,
+		message: message,
+	}
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+func (e *Error) Code() Code {
+	return e.code
+}
+
+// CodeOf returns the code of the first Error in the chain, errors without a code are internal
+func CodeOf(err error) Code {
+	var serviceErr *Error
+	if errors.As(err, &serviceErr) {
+		return serviceErr.code
+	}
+
+	return CodeInternal
+}
@@ -0,0 +1,45 @@
+package service_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+func TestCodeOf(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		err  error
+		want service.Code
+	}{
+		{
+			name: "service error",
+			err:  service.ErrActiveReceptionNotFound,
+			want: service.CodeReceptionNotActive,
+		},
+		{
+			name: "wrapped service error",
+			err:  fmt.Errorf("close reception: %w", service.ErrPointClosed),
+			want: service.CodePointClosed,
+		},
+		{
+			name: "unknown error",
+			err:  errors.New("arbitrary error"),
+			want: service.CodeInternal,
+		},
+		{
+			name: "nil error",
+			want: service.CodeInternal,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, service.CodeOf(tc.err))
+		})
+	}
+}
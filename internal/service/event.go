@@ -0,0 +1,31 @@
+package service
+
+import (
+	"context"
+
+	"github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/events"
+)
+
+type EventService struct {
+	subscriber events.Subscriber
+}
+
+func NewEventService(subscriber events.Subscriber) *EventService {
+	return &EventService{
+		subscriber: subscriber,
+	}
+}
+
+// Subscribe returns events matching the filter, the channel is closed when ctx is done
+func (s *EventService) Subscribe(ctx context.Context, filter dto.EventFilter) <-chan entity.Event {
+	ch, cancel := s.subscriber.Subscribe(filter.Match)
+
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+
+	return ch
+}
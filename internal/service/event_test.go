@@ -0,0 +1,76 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/events"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+func TestEventService_Subscribe(t *testing.T) {
+	var (
+		now         = time.Date(2025, 5, 14, 12, 0, 0, 0, time.UTC)
+		pointID     = uuid.New()
+		otherID     = uuid.New()
+		receptionID = uuid.New()
+	)
+
+	kazan := entity.Event{Type: entity.EventTypeReceptionCreated, PointID: pointID, City: "Казань", ReceptionID: receptionID}
+	moscow := entity.Event{Type: entity.EventTypeReceptionClosed, PointID: otherID, City: "Москва", ReceptionID: receptionID}
+
+	for _, tc := range []struct {
+		name   string
+		filter dto.EventFilter
+		want   []entity.EventType
+	}{
+		{
+			name: "no filter",
+			want: []entity.EventType{entity.EventTypeReceptionCreated, entity.EventTypeReceptionClosed},
+		},
+		{
+			name:   "by point",
+			filter: dto.EventFilter{PointID: &otherID},
+			want:   []entity.EventType{entity.EventTypeReceptionClosed},
+		},
+		{
+			name:   "by city",
+			filter: dto.EventFilter{City: lo.ToPtr("Казань")},
+			want:   []entity.EventType{entity.EventTypeReceptionCreated},
+		},
+		{
+			name:   "point and city do not match",
+			filter: dto.EventFilter{PointID: &pointID, City: lo.ToPtr("Москва")},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			bus := events.NewBus(clockwork.NewFakeClockAt(now))
+			s := service.NewEventService(bus)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			ch := s.Subscribe(ctx, tc.filter)
+
+			assert.NoError(t, bus.Publish(ctx, kazan))
+			assert.NoError(t, bus.Publish(ctx, moscow))
+			cancel()
+
+			var got []entity.EventType
+			for event := range ch {
+				assert.Equal(t, now, event.OccurredAt)
+				got = append(got, event.Type)
+			}
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package service_test
+
+import (
+	"context"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+)
+
+// trManagerStub runs closures without a real transaction
+type trManagerStub struct{}
+
+func (trManagerStub) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (trManagerStub) DoWithSettings(ctx context.Context, _ trm.Settings, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// outboxStub records events added by services, Add fails with err when it is set.
+// Services only add events, the relay methods are left unimplemented.
+type outboxStub struct {
+	repository.Outbox
+	err    error
+	events []entity.Event
+}
+
+func (o *outboxStub) Add(_ context.Context, events ...entity.Event) error {
+	if o.err != nil {
+		return o.err
+	}
+
+	o.events = append(o.events, events...)
+	return nil
+}
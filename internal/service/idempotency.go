@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+)
+
+var (
+	ErrIdempotencyKeyReused         = NewError(CodeIdempotencyKeyReused, "idempotency key was already used for another request")
+	ErrIdempotentRequestInProgress  = NewError(CodeIdempotentRequestInProgress, "request with this idempotency key is still in progress")
+	ErrCannotProcessIdempotencyKey  = NewError(CodeInternal, "cannot process idempotency key")
+	ErrCannotSaveIdempotentResponse = NewError(CodeInternal, "cannot save idempotent response")
+	ErrCannotDeleteIdempotencyKeys  = NewError(CodeInternal, "cannot delete expired idempotency keys")
+)
+
+type IdempotencySettings struct {
+	TTL             time.Duration
+	CleanupInterval time.Duration
+}
+
+type IdempotencyService struct {
+	idempotencyRepo repository.Idempotency
+	clock           clockwork.Clock
+	settings        IdempotencySettings
+}
+
+func NewIdempotencyService(idempotencyRepo repository.Idempotency, clock clockwork.Clock, settings IdempotencySettings) *IdempotencyService {
+	return &IdempotencyService{
+		idempotencyRepo: idempotencyRepo,
+		clock:           clock,
+		settings:        settings,
+	}
+}
+
+// Run deletes expired keys every cleanup interval until ctx is done
+func (s *IdempotencyService) Run(ctx context.Context) {
+	ticker := s.clock.NewTicker(s.settings.CleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+			if err := s.DeleteExpired(ctx); err != nil {
+				log.Errorf("IdempotencyService.Run - s.DeleteExpired: %v", err)
+			}
+		}
+	}
+}
+
+// DeleteExpired removes keys past their TTL, Begin takes them over anyway, so this only bounds the table size
+func (s *IdempotencyService) DeleteExpired(ctx context.Context) error {
+	deleted, err := s.idempotencyRepo.DeleteExpired(ctx, s.clock.Now())
+	if err != nil {
+		log.Errorf("IdempotencyService.DeleteExpired - s.idempotencyRepo.DeleteExpired: %v", err)
+		return ErrCannotDeleteIdempotencyKeys
+	}
+
+	log.Debugf("IdempotencyService.DeleteExpired - deleted: %d", deleted)
+
+	return nil
+}
+
+// Begin reserves the key for the request identified by requestHash.
+// It returns nil when the caller should process the request and the stored response when it was already processed.
+func (s *IdempotencyService) Begin(ctx context.Context, userID uuid.UUID, key, requestHash string) (*entity.IdempotentResponse, error) {
+	now := s.clock.Now()
+
+	err := s.idempotencyRepo.Reserve(ctx, entity.IdempotencyRecord{
+		UserID:      userID,
+		Key:         key,
+		RequestHash: requestHash,
+		ExpiresAt:   now.Add(s.settings.TTL),
+	}, now)
+	if err == nil {
+		return nil, nil
+	}
+
+	if !errors.Is(err, repository.ErrAlreadyExists) {
+		log.Errorf("IdempotencyService.Begin - s.idempotencyRepo.Reserve: %v", err)
+		return nil, ErrCannotProcessIdempotencyKey
+	}
+
+	record, err := s.idempotencyRepo.Get(ctx, userID, key)
+	if err != nil {
+		// The first request has failed and released the key in the meantime
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrIdempotentRequestInProgress
+		}
+
+		log.Errorf("IdempotencyService.Begin - s.idempotencyRepo.Get: %v", err)
+		return nil, ErrCannotProcessIdempotencyKey
+	}
+
+	if record.RequestHash != requestHash {
+		return nil, ErrIdempotencyKeyReused
+	}
+
+	if record.Response == nil {
+		return nil, ErrIdempotentRequestInProgress
+	}
+
+	return record.Response, nil
+}
+
+// Complete stores the response to be replayed for the reserved key
+func (s *IdempotencyService) Complete(ctx context.Context, userID uuid.UUID, key string, response entity.IdempotentResponse) error {
+	if err := s.idempotencyRepo.SaveResponse(ctx, userID, key, response); err != nil {
+		log.Errorf("IdempotencyService.Complete - s.idempotencyRepo.SaveResponse: %v", err)
+		return ErrCannotSaveIdempotentResponse
+	}
+
+	return nil
+}
+
+// Release frees the reserved key, so the request can be retried with it
+func (s *IdempotencyService) Release(ctx context.Context, userID uuid.UUID, key string) {
+	if err := s.idempotencyRepo.Delete(ctx, userID, key); err != nil {
+		log.Errorf("IdempotencyService.Release - s.idempotencyRepo.Delete: %v", err)
+	}
+}
@@ -0,0 +1,235 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+	repomocks "github.com/spanwalla/pvz/internal/repository/mocks"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+func TestIdempotencyService_Begin(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		now          = time.Date(2025, 5, 19, 10, 0, 0, 0, time.UTC)
+		ttl          = 24 * time.Hour
+		userID       = uuid.New()
+		key          = "8d5c1a4e-create-pvz"
+		hash         = "3a7bd3e2360a3d29eea436fcfb7e44c735d117c42d1c1835420b6b9942dd4f1b"
+	)
+
+	reserved := entity.IdempotencyRecord{
+		UserID:      userID,
+		Key:         key,
+		RequestHash: hash,
+		ExpiresAt:   now.Add(ttl),
+	}
+
+	response := &entity.IdempotentResponse{
+		StatusCode:  http.StatusCreated,
+		ContentType: "application/json",
+		Body:        []byte(`{"id":"1"}`),
+	}
+
+	type MockBehavior func(r *repomocks.MockIdempotency)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         *entity.IdempotentResponse
+		wantErr      error
+	}{
+		{
+			name: "new key",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().Reserve(ctx, reserved, now).Return(nil)
+			},
+		},
+		{
+			name: "completed request is replayed",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().Reserve(ctx, reserved, now).Return(repository.ErrAlreadyExists)
+				r.EXPECT().Get(ctx, userID, key).Return(entity.IdempotencyRecord{
+					UserID:      userID,
+					Key:         key,
+					RequestHash: hash,
+					Response:    response,
+				}, nil)
+			},
+			want: response,
+		},
+		{
+			name: "key reused with another request",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().Reserve(ctx, reserved, now).Return(repository.ErrAlreadyExists)
+				r.EXPECT().Get(ctx, userID, key).Return(entity.IdempotencyRecord{
+					UserID:      userID,
+					Key:         key,
+					RequestHash: "another",
+					Response:    response,
+				}, nil)
+			},
+			wantErr: service.ErrIdempotencyKeyReused,
+		},
+		{
+			name: "request in progress",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().Reserve(ctx, reserved, now).Return(repository.ErrAlreadyExists)
+				r.EXPECT().Get(ctx, userID, key).Return(entity.IdempotencyRecord{
+					UserID:      userID,
+					Key:         key,
+					RequestHash: hash,
+				}, nil)
+			},
+			wantErr: service.ErrIdempotentRequestInProgress,
+		},
+		{
+			name: "key released in the meantime",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().Reserve(ctx, reserved, now).Return(repository.ErrAlreadyExists)
+				r.EXPECT().Get(ctx, userID, key).Return(entity.IdempotencyRecord{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrIdempotentRequestInProgress,
+		},
+		{
+			name: "cannot reserve key",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().Reserve(ctx, reserved, now).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotProcessIdempotencyKey,
+		},
+		{
+			name: "cannot get record",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().Reserve(ctx, reserved, now).Return(repository.ErrAlreadyExists)
+				r.EXPECT().Get(ctx, userID, key).Return(entity.IdempotencyRecord{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotProcessIdempotencyKey,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockIdempotencyRepo := repomocks.NewMockIdempotency(ctrl)
+
+			tc.mockBehavior(mockIdempotencyRepo)
+
+			s := service.NewIdempotencyService(mockIdempotencyRepo, clockwork.NewFakeClockAt(now), service.IdempotencySettings{TTL: ttl})
+
+			got, err := s.Begin(ctx, userID, key, hash)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestIdempotencyService_Complete(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		userID       = uuid.New()
+		key          = "8d5c1a4e-create-pvz"
+	)
+
+	response := entity.IdempotentResponse{StatusCode: http.StatusNoContent}
+
+	type MockBehavior func(r *repomocks.MockIdempotency)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().SaveResponse(ctx, userID, key, response).Return(nil)
+			},
+		},
+		{
+			name: "cannot save response",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().SaveResponse(ctx, userID, key, response).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotSaveIdempotentResponse,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockIdempotencyRepo := repomocks.NewMockIdempotency(ctrl)
+
+			tc.mockBehavior(mockIdempotencyRepo)
+
+			s := service.NewIdempotencyService(mockIdempotencyRepo, clockwork.NewFakeClock(), service.IdempotencySettings{TTL: time.Hour})
+
+			err := s.Complete(ctx, userID, key, response)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestIdempotencyService_DeleteExpired(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		now          = time.Date(2025, 5, 19, 10, 0, 0, 0, time.UTC)
+	)
+
+	type MockBehavior func(r *repomocks.MockIdempotency)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().DeleteExpired(ctx, now).Return(int64(3), nil)
+			},
+		},
+		{
+			name: "cannot delete keys",
+			mockBehavior: func(r *repomocks.MockIdempotency) {
+				r.EXPECT().DeleteExpired(ctx, now).Return(int64(0), arbitraryErr)
+			},
+			wantErr: service.ErrCannotDeleteIdempotencyKeys,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockIdempotencyRepo := repomocks.NewMockIdempotency(ctrl)
+
+			tc.mockBehavior(mockIdempotencyRepo)
+
+			s := service.NewIdempotencyService(mockIdempotencyRepo, clockwork.NewFakeClockAt(now), service.IdempotencySettings{TTL: time.Hour})
+
+			err := s.DeleteExpired(ctx)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	dto "github.com/spanwalla/pvz/internal/dto"
 	entity "github.com/spanwalla/pvz/internal/entity"
 	service "github.com/spanwalla/pvz/internal/service"
+	jwtkeys "github.com/spanwalla/pvz/pkg/jwtkeys"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -60,11 +61,25 @@ func (mr *MockAuthMockRecorder) DummyLogin(ctx, role any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DummyLogin", reflect.TypeOf((*MockAuth)(nil).DummyLogin), ctx, role)
 }
 
+// JWKS mocks base method.
+func (m *MockAuth) JWKS(ctx context.Context) []jwtkeys.JWK {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS", ctx)
+	ret0, _ := ret[0].([]jwtkeys.JWK)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockAuthMockRecorder) JWKS(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockAuth)(nil).JWKS), ctx)
+}
+
 // Login mocks base method.
-func (m *MockAuth) Login(ctx context.Context, email, password string) (string, error) {
+func (m *MockAuth) Login(ctx context.Context, email, password string) (service.TokenPair, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Login", ctx, email, password)
-	ret0, _ := ret[0].(string)
+	ret0, _ := ret[0].(service.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -75,19 +90,48 @@ func (mr *MockAuthMockRecorder) Login(ctx, email, password any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuth)(nil).Login), ctx, email, password)
 }
 
+// Logout mocks base method.
+func (m *MockAuth) Logout(ctx context.Context, claims *entity.TokenClaims, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, claims, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthMockRecorder) Logout(ctx, claims, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuth)(nil).Logout), ctx, claims, refreshToken)
+}
+
 // ParseToken mocks base method.
-func (m *MockAuth) ParseToken(token string) (*entity.TokenClaims, error) {
+func (m *MockAuth) ParseToken(ctx context.Context, token string) (*entity.TokenClaims, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ParseToken", token)
+	ret := m.ctrl.Call(m, "ParseToken", ctx, token)
 	ret0, _ := ret[0].(*entity.TokenClaims)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ParseToken indicates an expected call of ParseToken.
-func (mr *MockAuthMockRecorder) ParseToken(token any) *gomock.Call {
+func (mr *MockAuthMockRecorder) ParseToken(ctx, token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockAuth)(nil).ParseToken), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseToken", reflect.TypeOf((*MockAuth)(nil).ParseToken), ctx, token)
+}
+
+// Refresh mocks base method.
+func (m *MockAuth) Refresh(ctx context.Context, refreshToken string) (service.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(service.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockAuthMockRecorder) Refresh(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockAuth)(nil).Refresh), ctx, refreshToken)
 }
 
 // Register mocks base method.
@@ -105,6 +149,193 @@ func (mr *MockAuthMockRecorder) Register(ctx, email, password, role any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockAuth)(nil).Register), ctx, email, password, role)
 }
 
+// RevokeUserSessions mocks base method.
+func (m *MockAuth) RevokeUserSessions(ctx context.Context, userID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeUserSessions", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeUserSessions indicates an expected call of RevokeUserSessions.
+func (mr *MockAuthMockRecorder) RevokeUserSessions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeUserSessions", reflect.TypeOf((*MockAuth)(nil).RevokeUserSessions), ctx, userID)
+}
+
+// Run mocks base method.
+func (m *MockAuth) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockAuthMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockAuth)(nil).Run), ctx)
+}
+
+// MockCity is a mock of City interface.
+type MockCity struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityMockRecorder
+	isgomock struct{}
+}
+
+// MockCityMockRecorder is the mock recorder for MockCity.
+type MockCityMockRecorder struct {
+	mock *MockCity
+}
+
+// NewMockCity creates a new mock instance.
+func NewMockCity(ctrl *gomock.Controller) *MockCity {
+	mock := &MockCity{ctrl: ctrl}
+	mock.recorder = &MockCityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCity) EXPECT() *MockCityMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockCity) Create(ctx context.Context, name string) (entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, name)
+	ret0, _ := ret[0].(entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockCityMockRecorder) Create(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCity)(nil).Create), ctx, name)
+}
+
+// Deactivate mocks base method.
+func (m *MockCity) Deactivate(ctx context.Context, cityID int) (entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deactivate", ctx, cityID)
+	ret0, _ := ret[0].(entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deactivate indicates an expected call of Deactivate.
+func (mr *MockCityMockRecorder) Deactivate(ctx, cityID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deactivate", reflect.TypeOf((*MockCity)(nil).Deactivate), ctx, cityID)
+}
+
+// GetAll mocks base method.
+func (m *MockCity) GetAll(ctx context.Context, includeInactive bool) ([]entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, includeInactive)
+	ret0, _ := ret[0].([]entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockCityMockRecorder) GetAll(ctx, includeInactive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockCity)(nil).GetAll), ctx, includeInactive)
+}
+
+// Rename mocks base method.
+func (m *MockCity) Rename(ctx context.Context, cityID int, name string) (entity.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rename", ctx, cityID, name)
+	ret0, _ := ret[0].(entity.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rename indicates an expected call of Rename.
+func (mr *MockCityMockRecorder) Rename(ctx, cityID, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rename", reflect.TypeOf((*MockCity)(nil).Rename), ctx, cityID, name)
+}
+
+// MockIdempotency is a mock of Idempotency interface.
+type MockIdempotency struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyMockRecorder is the mock recorder for MockIdempotency.
+type MockIdempotencyMockRecorder struct {
+	mock *MockIdempotency
+}
+
+// NewMockIdempotency creates a new mock instance.
+func NewMockIdempotency(ctrl *gomock.Controller) *MockIdempotency {
+	mock := &MockIdempotency{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotency) EXPECT() *MockIdempotencyMockRecorder {
+	return m.recorder
+}
+
+// Begin mocks base method.
+func (m *MockIdempotency) Begin(ctx context.Context, userID uuid.UUID, key, requestHash string) (*entity.IdempotentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Begin", ctx, userID, key, requestHash)
+	ret0, _ := ret[0].(*entity.IdempotentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Begin indicates an expected call of Begin.
+func (mr *MockIdempotencyMockRecorder) Begin(ctx, userID, key, requestHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Begin", reflect.TypeOf((*MockIdempotency)(nil).Begin), ctx, userID, key, requestHash)
+}
+
+// Complete mocks base method.
+func (m *MockIdempotency) Complete(ctx context.Context, userID uuid.UUID, key string, response entity.IdempotentResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", ctx, userID, key, response)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyMockRecorder) Complete(ctx, userID, key, response any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotency)(nil).Complete), ctx, userID, key, response)
+}
+
+// Release mocks base method.
+func (m *MockIdempotency) Release(ctx context.Context, userID uuid.UUID, key string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Release", ctx, userID, key)
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIdempotencyMockRecorder) Release(ctx, userID, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIdempotency)(nil).Release), ctx, userID, key)
+}
+
+// Run mocks base method.
+func (m *MockIdempotency) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockIdempotencyMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockIdempotency)(nil).Run), ctx)
+}
+
 // MockPoint is a mock of Point interface.
 type MockPoint struct {
 	ctrl     *gomock.Controller
@@ -129,6 +360,21 @@ func (m *MockPoint) EXPECT() *MockPointMockRecorder {
 	return m.recorder
 }
 
+// ChangeStatus mocks base method.
+func (m *MockPoint) ChangeStatus(ctx context.Context, pointID uuid.UUID, status entity.PointStatus) (entity.Point, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStatus", ctx, pointID, status)
+	ret0, _ := ret[0].(entity.Point)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeStatus indicates an expected call of ChangeStatus.
+func (mr *MockPointMockRecorder) ChangeStatus(ctx, pointID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockPoint)(nil).ChangeStatus), ctx, pointID, status)
+}
+
 // CloseLastReception mocks base method.
 func (m *MockPoint) CloseLastReception(ctx context.Context, pointID uuid.UUID) (entity.Reception, error) {
 	m.ctrl.T.Helper()
@@ -145,18 +391,18 @@ func (mr *MockPointMockRecorder) CloseLastReception(ctx, pointID any) *gomock.Ca
 }
 
 // Create mocks base method.
-func (m *MockPoint) Create(ctx context.Context, city string) (entity.Point, error) {
+func (m *MockPoint) Create(ctx context.Context, point entity.Point) (entity.Point, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, city)
+	ret := m.ctrl.Call(m, "Create", ctx, point)
 	ret0, _ := ret[0].(entity.Point)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockPointMockRecorder) Create(ctx, city any) *gomock.Call {
+func (mr *MockPointMockRecorder) Create(ctx, point any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPoint)(nil).Create), ctx, city)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPoint)(nil).Create), ctx, point)
 }
 
 // DeleteLastProduct mocks base method.
@@ -173,34 +419,78 @@ func (mr *MockPointMockRecorder) DeleteLastProduct(ctx, pointID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockPoint)(nil).DeleteLastProduct), ctx, pointID)
 }
 
+// DeleteProduct mocks base method.
+func (m *MockPoint) DeleteProduct(ctx context.Context, pointID, productID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, pointID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockPointMockRecorder) DeleteProduct(ctx, pointID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockPoint)(nil).DeleteProduct), ctx, pointID, productID)
+}
+
 // GetAll mocks base method.
-func (m *MockPoint) GetAll(ctx context.Context) ([]entity.Point, error) {
+func (m *MockPoint) GetAll(ctx context.Context, status *entity.PointStatus) ([]entity.Point, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret := m.ctrl.Call(m, "GetAll", ctx, status)
 	ret0, _ := ret[0].([]entity.Point)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAll indicates an expected call of GetAll.
-func (mr *MockPointMockRecorder) GetAll(ctx any) *gomock.Call {
+func (mr *MockPointMockRecorder) GetAll(ctx, status any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPoint)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockPoint)(nil).GetAll), ctx, status)
+}
+
+// GetByID mocks base method.
+func (m *MockPoint) GetByID(ctx context.Context, pointID uuid.UUID) (entity.Point, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, pointID)
+	ret0, _ := ret[0].(entity.Point)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockPointMockRecorder) GetByID(ctx, pointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockPoint)(nil).GetByID), ctx, pointID)
 }
 
 // GetExtended mocks base method.
-func (m *MockPoint) GetExtended(ctx context.Context, start, end *time.Time, pagePtr, limitPtr *int) ([]dto.PointOutput, error) {
+func (m *MockPoint) GetExtended(ctx context.Context, filter dto.PointFilter, pagination dto.Pagination) (dto.PointPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetExtended", ctx, start, end, pagePtr, limitPtr)
-	ret0, _ := ret[0].([]dto.PointOutput)
+	ret := m.ctrl.Call(m, "GetExtended", ctx, filter, pagination)
+	ret0, _ := ret[0].(dto.PointPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetExtended indicates an expected call of GetExtended.
-func (mr *MockPointMockRecorder) GetExtended(ctx, start, end, pagePtr, limitPtr any) *gomock.Call {
+func (mr *MockPointMockRecorder) GetExtended(ctx, filter, pagination any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtended", reflect.TypeOf((*MockPoint)(nil).GetExtended), ctx, start, end, pagePtr, limitPtr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtended", reflect.TypeOf((*MockPoint)(nil).GetExtended), ctx, filter, pagination)
+}
+
+// GetNearby mocks base method.
+func (m *MockPoint) GetNearby(ctx context.Context, latitude, longitude, radius float64, limitPtr *int) ([]dto.NearbyPoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNearby", ctx, latitude, longitude, radius, limitPtr)
+	ret0, _ := ret[0].([]dto.NearbyPoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNearby indicates an expected call of GetNearby.
+func (mr *MockPointMockRecorder) GetNearby(ctx, latitude, longitude, radius, limitPtr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearby", reflect.TypeOf((*MockPoint)(nil).GetNearby), ctx, latitude, longitude, radius, limitPtr)
 }
 
 // MockProduct is a mock of Product interface.
@@ -228,18 +518,177 @@ func (m *MockProduct) EXPECT() *MockProductMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockProduct) Create(ctx context.Context, pointID uuid.UUID, productType entity.ProductType) (entity.Product, error) {
+func (m *MockProduct) Create(ctx context.Context, pointID uuid.UUID, productType entity.ProductType, itemCode string) (entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, pointID, productType, itemCode)
+	ret0, _ := ret[0].(entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockProductMockRecorder) Create(ctx, pointID, productType, itemCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProduct)(nil).Create), ctx, pointID, productType, itemCode)
+}
+
+// CreateBatch mocks base method.
+func (m *MockProduct) CreateBatch(ctx context.Context, pointID uuid.UUID, inputs []dto.ProductInput) ([]entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, pointID, inputs)
+	ret0, _ := ret[0].([]entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockProductMockRecorder) CreateBatch(ctx, pointID, inputs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockProduct)(nil).CreateBatch), ctx, pointID, inputs)
+}
+
+// GetByID mocks base method.
+func (m *MockProduct) GetByID(ctx context.Context, productID uuid.UUID) (entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, productID)
+	ret0, _ := ret[0].(entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockProductMockRecorder) GetByID(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockProduct)(nil).GetByID), ctx, productID)
+}
+
+// GetByItemCode mocks base method.
+func (m *MockProduct) GetByItemCode(ctx context.Context, itemCode string) (dto.ProductLookup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByItemCode", ctx, itemCode)
+	ret0, _ := ret[0].(dto.ProductLookup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByItemCode indicates an expected call of GetByItemCode.
+func (mr *MockProductMockRecorder) GetByItemCode(ctx, itemCode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByItemCode", reflect.TypeOf((*MockProduct)(nil).GetByItemCode), ctx, itemCode)
+}
+
+// Issue mocks base method.
+func (m *MockProduct) Issue(ctx context.Context, productID uuid.UUID) (entity.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, pointID, productType)
+	ret := m.ctrl.Call(m, "Issue", ctx, productID)
 	ret0, _ := ret[0].(entity.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// Issue indicates an expected call of Issue.
+func (mr *MockProductMockRecorder) Issue(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockProduct)(nil).Issue), ctx, productID)
+}
+
+// Return mocks base method.
+func (m *MockProduct) Return(ctx context.Context, productID uuid.UUID) (entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Return", ctx, productID)
+	ret0, _ := ret[0].(entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Return indicates an expected call of Return.
+func (mr *MockProductMockRecorder) Return(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Return", reflect.TypeOf((*MockProduct)(nil).Return), ctx, productID)
+}
+
+// MockProductType is a mock of ProductType interface.
+type MockProductType struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeMockRecorder
+	isgomock struct{}
+}
+
+// MockProductTypeMockRecorder is the mock recorder for MockProductType.
+type MockProductTypeMockRecorder struct {
+	mock *MockProductType
+}
+
+// NewMockProductType creates a new mock instance.
+func NewMockProductType(ctrl *gomock.Controller) *MockProductType {
+	mock := &MockProductType{ctrl: ctrl}
+	mock.recorder = &MockProductTypeMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductType) EXPECT() *MockProductTypeMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockProductType) Create(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, code, names)
+	ret0, _ := ret[0].(entity.ProductTypeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Create indicates an expected call of Create.
-func (mr *MockProductMockRecorder) Create(ctx, pointID, productType any) *gomock.Call {
+func (mr *MockProductTypeMockRecorder) Create(ctx, code, names any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProduct)(nil).Create), ctx, pointID, productType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockProductType)(nil).Create), ctx, code, names)
+}
+
+// GetAll mocks base method.
+func (m *MockProductType) GetAll(ctx context.Context, includeInactive bool) ([]entity.ProductTypeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, includeInactive)
+	ret0, _ := ret[0].([]entity.ProductTypeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockProductTypeMockRecorder) GetAll(ctx, includeInactive any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockProductType)(nil).GetAll), ctx, includeInactive)
+}
+
+// SetActive mocks base method.
+func (m *MockProductType) SetActive(ctx context.Context, code entity.ProductType, active bool) (entity.ProductTypeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetActive", ctx, code, active)
+	ret0, _ := ret[0].(entity.ProductTypeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetActive indicates an expected call of SetActive.
+func (mr *MockProductTypeMockRecorder) SetActive(ctx, code, active any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetActive", reflect.TypeOf((*MockProductType)(nil).SetActive), ctx, code, active)
+}
+
+// UpdateNames mocks base method.
+func (m *MockProductType) UpdateNames(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNames", ctx, code, names)
+	ret0, _ := ret[0].(entity.ProductTypeEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNames indicates an expected call of UpdateNames.
+func (mr *MockProductTypeMockRecorder) UpdateNames(ctx, code, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNames", reflect.TypeOf((*MockProductType)(nil).UpdateNames), ctx, code, names)
 }
 
 // MockReception is a mock of Reception interface.
@@ -280,3 +729,301 @@ func (mr *MockReceptionMockRecorder) Create(ctx, pointID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockReception)(nil).Create), ctx, pointID)
 }
+
+// GetActive mocks base method.
+func (m *MockReception) GetActive(ctx context.Context, pointID uuid.UUID) (dto.ReceptionDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActive", ctx, pointID)
+	ret0, _ := ret[0].(dto.ReceptionDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActive indicates an expected call of GetActive.
+func (mr *MockReceptionMockRecorder) GetActive(ctx, pointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActive", reflect.TypeOf((*MockReception)(nil).GetActive), ctx, pointID)
+}
+
+// GetByID mocks base method.
+func (m *MockReception) GetByID(ctx context.Context, receptionID uuid.UUID) (dto.ReceptionDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, receptionID)
+	ret0, _ := ret[0].(dto.ReceptionDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReceptionMockRecorder) GetByID(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReception)(nil).GetByID), ctx, receptionID)
+}
+
+// Reopen mocks base method.
+func (m *MockReception) Reopen(ctx context.Context, receptionID, moderatorID uuid.UUID, reason string) (entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reopen", ctx, receptionID, moderatorID, reason)
+	ret0, _ := ret[0].(entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reopen indicates an expected call of Reopen.
+func (mr *MockReceptionMockRecorder) Reopen(ctx, receptionID, moderatorID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reopen", reflect.TypeOf((*MockReception)(nil).Reopen), ctx, receptionID, moderatorID, reason)
+}
+
+// MockEvent is a mock of Event interface.
+type MockEvent struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventMockRecorder
+	isgomock struct{}
+}
+
+// MockEventMockRecorder is the mock recorder for MockEvent.
+type MockEventMockRecorder struct {
+	mock *MockEvent
+}
+
+// NewMockEvent creates a new mock instance.
+func NewMockEvent(ctrl *gomock.Controller) *MockEvent {
+	mock := &MockEvent{ctrl: ctrl}
+	mock.recorder = &MockEventMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEvent) EXPECT() *MockEventMockRecorder {
+	return m.recorder
+}
+
+// Subscribe mocks base method.
+func (m *MockEvent) Subscribe(ctx context.Context, filter dto.EventFilter) <-chan entity.Event {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, filter)
+	ret0, _ := ret[0].(<-chan entity.Event)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventMockRecorder) Subscribe(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEvent)(nil).Subscribe), ctx, filter)
+}
+
+// MockSchedule is a mock of Schedule interface.
+type MockSchedule struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduleMockRecorder is the mock recorder for MockSchedule.
+type MockScheduleMockRecorder struct {
+	mock *MockSchedule
+}
+
+// NewMockSchedule creates a new mock instance.
+func NewMockSchedule(ctrl *gomock.Controller) *MockSchedule {
+	mock := &MockSchedule{ctrl: ctrl}
+	mock.recorder = &MockScheduleMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchedule) EXPECT() *MockScheduleMockRecorder {
+	return m.recorder
+}
+
+// ClearOverride mocks base method.
+func (m *MockSchedule) ClearOverride(ctx context.Context, pointID uuid.UUID) (entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearOverride", ctx, pointID)
+	ret0, _ := ret[0].(entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearOverride indicates an expected call of ClearOverride.
+func (mr *MockScheduleMockRecorder) ClearOverride(ctx, pointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearOverride", reflect.TypeOf((*MockSchedule)(nil).ClearOverride), ctx, pointID)
+}
+
+// Get mocks base method.
+func (m *MockSchedule) Get(ctx context.Context, pointID uuid.UUID) (entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, pointID)
+	ret0, _ := ret[0].(entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockScheduleMockRecorder) Get(ctx, pointID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSchedule)(nil).Get), ctx, pointID)
+}
+
+// SetOverride mocks base method.
+func (m *MockSchedule) SetOverride(ctx context.Context, pointID uuid.UUID, until time.Time) (entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetOverride", ctx, pointID, until)
+	ret0, _ := ret[0].(entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetOverride indicates an expected call of SetOverride.
+func (mr *MockScheduleMockRecorder) SetOverride(ctx, pointID, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOverride", reflect.TypeOf((*MockSchedule)(nil).SetOverride), ctx, pointID, until)
+}
+
+// Update mocks base method.
+func (m *MockSchedule) Update(ctx context.Context, pointID uuid.UUID, schedule entity.Schedule) (entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, pointID, schedule)
+	ret0, _ := ret[0].(entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockScheduleMockRecorder) Update(ctx, pointID, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSchedule)(nil).Update), ctx, pointID, schedule)
+}
+
+// MockOutbox is a mock of Outbox interface.
+type MockOutbox struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxMockRecorder is the mock recorder for MockOutbox.
+type MockOutboxMockRecorder struct {
+	mock *MockOutbox
+}
+
+// NewMockOutbox creates a new mock instance.
+func NewMockOutbox(ctrl *gomock.Controller) *MockOutbox {
+	mock := &MockOutbox{ctrl: ctrl}
+	mock.recorder = &MockOutboxMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutbox) EXPECT() *MockOutboxMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockOutbox) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockOutboxMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockOutbox)(nil).Run), ctx)
+}
+
+// MockWebhook is a mock of Webhook interface.
+type MockWebhook struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookMockRecorder
+	isgomock struct{}
+}
+
+// MockWebhookMockRecorder is the mock recorder for MockWebhook.
+type MockWebhookMockRecorder struct {
+	mock *MockWebhook
+}
+
+// NewMockWebhook creates a new mock instance.
+func NewMockWebhook(ctrl *gomock.Controller) *MockWebhook {
+	mock := &MockWebhook{ctrl: ctrl}
+	mock.recorder = &MockWebhookMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhook) EXPECT() *MockWebhookMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebhook) Create(ctx context.Context, webhook entity.Webhook) (entity.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, webhook)
+	ret0, _ := ret[0].(entity.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebhookMockRecorder) Create(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebhook)(nil).Create), ctx, webhook)
+}
+
+// Delete mocks base method.
+func (m *MockWebhook) Delete(ctx context.Context, webhookID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, webhookID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWebhookMockRecorder) Delete(ctx, webhookID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWebhook)(nil).Delete), ctx, webhookID)
+}
+
+// GetAll mocks base method.
+func (m *MockWebhook) GetAll(ctx context.Context) ([]entity.Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]entity.Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockWebhookMockRecorder) GetAll(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockWebhook)(nil).GetAll), ctx)
+}
+
+// GetDeliveries mocks base method.
+func (m *MockWebhook) GetDeliveries(ctx context.Context, webhookID uuid.UUID, status *entity.WebhookDeliveryStatus, limitPtr *int) ([]entity.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", ctx, webhookID, status, limitPtr)
+	ret0, _ := ret[0].([]entity.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockWebhookMockRecorder) GetDeliveries(ctx, webhookID, status, limitPtr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockWebhook)(nil).GetDeliveries), ctx, webhookID, status, limitPtr)
+}
+
+// Run mocks base method.
+func (m *MockWebhook) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockWebhookMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockWebhook)(nil).Run), ctx)
+}
@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/events"
+	"github.com/spanwalla/pvz/internal/repository"
+)
+
+var (
+	ErrCannotRelayEvents        = NewError(CodeInternal, "cannot relay events")
+	ErrCannotDeleteOutboxEvents = NewError(CodeInternal, "cannot delete outbox events")
+)
+
+// Sink names identify delivery state stored for every event, renaming a sink relays all stored events to it again
+const (
+	OutboxSinkWebhooks = "webhooks"
+	OutboxSinkEvents   = "event_sink"
+)
+
+type OutboxSettings struct {
+	PollInterval   time.Duration
+	BatchSize      int
+	MaxAttempts    int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	// PublishTimeout bounds a single publish, a claimed batch is leased for as long as publishing all of it may take
+	PublishTimeout  time.Duration
+	Retention       time.Duration
+	CleanupInterval time.Duration
+}
+
+// OutboxSink is a durable destination of stored events.
+// Every sink keeps its own delivery state, so a failing sink neither repeats nor holds back events of the others.
+type OutboxSink struct {
+	Name      string
+	Publisher events.Publisher
+}
+
+// OutboxService relays events stored together with the changes they describe.
+// An event is published to each sink at least once: it is retried with backoff until the sink accepts it
+// or MaxAttempts is reached. Local subscribers are notified by every instance as soon as the event is committed.
+type OutboxService struct {
+	outboxRepo repository.Outbox
+	local      events.Publisher
+	sinks      []OutboxSink
+	clock      clockwork.Clock
+	settings   OutboxSettings
+}
+
+func NewOutboxService(outboxRepo repository.Outbox, local events.Publisher, sinks []OutboxSink, clock clockwork.Clock, settings OutboxSettings) *OutboxService {
+	return &OutboxService{
+		outboxRepo: outboxRepo,
+		local:      local,
+		sinks:      sinks,
+		clock:      clock,
+		settings:   settings,
+	}
+}
+
+// Run relays pending events every poll interval and deletes finished ones every cleanup interval until ctx is done
+func (s *OutboxService) Run(ctx context.Context) {
+	go s.broadcast(ctx)
+
+	ticker := s.clock.NewTicker(s.settings.PollInterval)
+	defer ticker.Stop()
+
+	cleanup := s.clock.NewTicker(s.settings.CleanupInterval)
+	defer cleanup.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+			// Only the earliest event of each point is claimed at once, so batches are repeated while they progress
+			for ctx.Err() == nil {
+				relayed, err := s.RelayBatch(ctx)
+				if err != nil {
+					log.Errorf("OutboxService.Run - s.RelayBatch: %v", err)
+				}
+				if relayed == 0 {
+					break
+				}
+			}
+		case <-cleanup.Chan():
+			if err := s.DeleteFinished(ctx); err != nil {
+				log.Errorf("OutboxService.Run - s.DeleteFinished: %v", err)
+			}
+		}
+	}
+}
+
+// broadcast passes committed events to local subscribers and listens again after the connection fails
+func (s *OutboxService) broadcast(ctx context.Context) {
+	for ctx.Err() == nil {
+		err := s.outboxRepo.Listen(ctx, func(event entity.Event) {
+			if err := s.local.Publish(ctx, event); err != nil {
+				log.Warnf("OutboxService.broadcast - s.local.Publish: event %s: %v", event.ID, err)
+			}
+		})
+		if err == nil {
+			continue
+		}
+
+		log.Errorf("OutboxService.broadcast - s.outboxRepo.Listen: %v", err)
+		select {
+		case <-ctx.Done():
+		case <-s.clock.After(s.settings.PollInterval):
+		}
+	}
+}
+
+// RelayBatch publishes one batch of due events to every sink and returns the number of attempts made.
+// Events are claimed with a lease and published outside any transaction, a sink that cannot be claimed from
+// does not stop the others.
+func (s *OutboxService) RelayBatch(ctx context.Context) (int, error) {
+	var relayed int
+	var failed bool
+
+	for _, sink := range s.sinks {
+		now := s.clock.Now()
+		lease := s.settings.PublishTimeout * time.Duration(s.settings.BatchSize)
+
+		dispatches, err := s.outboxRepo.ClaimDue(ctx, sink.Name, now, now.Add(lease), s.settings.BatchSize)
+		if err != nil {
+			log.Errorf("OutboxService.RelayBatch - s.outboxRepo.ClaimDue: sink %s: %v", sink.Name, err)
+			failed = true
+			continue
+		}
+
+		for _, dispatch := range dispatches {
+			delivery := s.attempt(ctx, sink, dispatch)
+			if err = s.outboxRepo.SaveAttempt(ctx, delivery); err != nil {
+				log.Errorf("OutboxService.RelayBatch - s.outboxRepo.SaveAttempt: %v", err)
+				failed = true
+			}
+		}
+
+		relayed += len(dispatches)
+	}
+
+	if failed {
+		return relayed, ErrCannotRelayEvents
+	}
+
+	return relayed, nil
+}
+
+// attempt publishes the event to the sink and returns the delivery updated with the outcome
+func (s *OutboxService) attempt(ctx context.Context, sink OutboxSink, dispatch dto.OutboxDispatch) entity.OutboxDelivery {
+	delivery := dispatch.Delivery
+	delivery.Attempts++
+
+	publishCtx, cancel := context.WithTimeout(ctx, s.settings.PublishTimeout)
+	err := sink.Publisher.Publish(publishCtx, dispatch.Event)
+	cancel()
+
+	now := s.clock.Now()
+	if err == nil {
+		delivery.Status = entity.OutboxDeliveryStatusPublished
+		delivery.LastError = nil
+		delivery.PublishedAt = &now
+		return delivery
+	}
+
+	log.Warnf("OutboxService.attempt - sink.Publisher.Publish: sink %s: event %s: %v", sink.Name, dispatch.Event.ID, err)
+
+	reason := failureReason(err)
+	delivery.LastError = &reason
+
+	if delivery.Attempts >= s.settings.MaxAttempts {
+		delivery.Status = entity.OutboxDeliveryStatusDead
+		return delivery
+	}
+
+	delivery.NextAttemptAt = now.Add(retryDelay(s.settings.RetryBaseDelay, s.settings.RetryMaxDelay, delivery.Attempts))
+	return delivery
+}
+
+// DeleteFinished removes events older than the retention that are published or dead for every sink
+func (s *OutboxService) DeleteFinished(ctx context.Context) error {
+	sinks := make([]string, 0, len(s.sinks))
+	for _, sink := range s.sinks {
+		sinks = append(sinks, sink.Name)
+	}
+
+	deleted, err := s.outboxRepo.DeleteFinished(ctx, sinks, s.clock.Now().Add(-s.settings.Retention))
+	if err != nil {
+		log.Errorf("OutboxService.DeleteFinished - s.outboxRepo.DeleteFinished: %v", err)
+		return ErrCannotDeleteOutboxEvents
+	}
+
+	log.Debugf("OutboxService.DeleteFinished - deleted: %d", deleted)
+
+	return nil
+}
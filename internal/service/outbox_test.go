@@ -0,0 +1,256 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	eventmocks "github.com/spanwalla/pvz/internal/events/mocks"
+	repomocks "github.com/spanwalla/pvz/internal/repository/mocks"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+func TestOutboxService_RelayBatch(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		longErr      = errors.New("x" + strings.Repeat("ж", 300)) // the cut falls in the middle of a two byte rune
+		ctx          = context.Background()
+		now          = time.Date(2025, 5, 24, 10, 0, 0, 0, time.UTC)
+		settings     = service.OutboxSettings{
+			PollInterval:   time.Second,
+			BatchSize:      100,
+			MaxAttempts:    3,
+			RetryBaseDelay: time.Second,
+			RetryMaxDelay:  time.Minute,
+			PublishTimeout: time.Second,
+		}
+		leaseUntil = now.Add(100 * time.Second)
+	)
+
+	created := entity.Event{
+		ID:          uuid.New(),
+		Type:        entity.EventTypeReceptionCreated,
+		PointID:     uuid.New(),
+		City:        "Казань",
+		ReceptionID: uuid.New(),
+		OccurredAt:  now.Add(-time.Second),
+	}
+
+	closed := entity.Event{
+		ID:          uuid.New(),
+		Type:        entity.EventTypeReceptionClosed,
+		PointID:     uuid.New(),
+		City:        "Москва",
+		ReceptionID: uuid.New(),
+		OccurredAt:  now.Add(-time.Second),
+	}
+
+	dispatch := func(event entity.Event, sink string, attempts int) dto.OutboxDispatch {
+		return dto.OutboxDispatch{
+			Event: event,
+			Delivery: entity.OutboxDelivery{
+				EventID:       event.ID,
+				Sink:          sink,
+				Status:        entity.OutboxDeliveryStatusPending,
+				Attempts:      attempts,
+				NextAttemptAt: leaseUntil,
+			},
+		}
+	}
+
+	published := func(event entity.Event, sink string, attempts int) entity.OutboxDelivery {
+		return entity.OutboxDelivery{
+			EventID:       event.ID,
+			Sink:          sink,
+			Status:        entity.OutboxDeliveryStatusPublished,
+			Attempts:      attempts,
+			NextAttemptAt: leaseUntil,
+			PublishedAt:   &now,
+		}
+	}
+
+	type MockBehavior func(o *repomocks.MockOutbox, webhooks, sink *eventmocks.MockPublisher)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         int
+		wantErr      error
+	}{
+		{
+			name: "published to every sink",
+			mockBehavior: func(o *repomocks.MockOutbox, webhooks, sink *eventmocks.MockPublisher) {
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkWebhooks, now, leaseUntil, settings.BatchSize).
+					Return([]dto.OutboxDispatch{dispatch(created, service.OutboxSinkWebhooks, 0), dispatch(closed, service.OutboxSinkWebhooks, 0)}, nil)
+				webhooks.EXPECT().Publish(gomock.Any(), created).Return(nil)
+				o.EXPECT().SaveAttempt(ctx, published(created, service.OutboxSinkWebhooks, 1)).Return(nil)
+				webhooks.EXPECT().Publish(gomock.Any(), closed).Return(nil)
+				o.EXPECT().SaveAttempt(ctx, published(closed, service.OutboxSinkWebhooks, 1)).Return(nil)
+
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkEvents, now, leaseUntil, settings.BatchSize).
+					Return([]dto.OutboxDispatch{dispatch(created, service.OutboxSinkEvents, 0)}, nil)
+				sink.EXPECT().Publish(gomock.Any(), created).Return(nil)
+				o.EXPECT().SaveAttempt(ctx, published(created, service.OutboxSinkEvents, 1)).Return(nil)
+			},
+			want: 3,
+		},
+		{
+			name: "nothing due",
+			mockBehavior: func(o *repomocks.MockOutbox, webhooks, sink *eventmocks.MockPublisher) {
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkWebhooks, now, leaseUntil, settings.BatchSize).Return(nil, nil)
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkEvents, now, leaseUntil, settings.BatchSize).Return(nil, nil)
+			},
+		},
+		{
+			name: "failed attempt is retried with backoff",
+			mockBehavior: func(o *repomocks.MockOutbox, webhooks, sink *eventmocks.MockPublisher) {
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkWebhooks, now, leaseUntil, settings.BatchSize).Return(nil, nil)
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkEvents, now, leaseUntil, settings.BatchSize).
+					Return([]dto.OutboxDispatch{dispatch(created, service.OutboxSinkEvents, 1)}, nil)
+				sink.EXPECT().Publish(gomock.Any(), created).Return(arbitraryErr)
+				o.EXPECT().SaveAttempt(ctx, entity.OutboxDelivery{
+					EventID:       created.ID,
+					Sink:          service.OutboxSinkEvents,
+					Status:        entity.OutboxDeliveryStatusPending,
+					Attempts:      2,
+					NextAttemptAt: now.Add(2 * time.Second),
+					LastError:     lo.ToPtr(arbitraryErr.Error()),
+				}).Return(nil)
+			},
+			want: 1,
+		},
+		{
+			name: "long error is cut on a rune boundary",
+			mockBehavior: func(o *repomocks.MockOutbox, webhooks, sink *eventmocks.MockPublisher) {
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkWebhooks, now, leaseUntil, settings.BatchSize).Return(nil, nil)
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkEvents, now, leaseUntil, settings.BatchSize).
+					Return([]dto.OutboxDispatch{dispatch(created, service.OutboxSinkEvents, 0)}, nil)
+				sink.EXPECT().Publish(gomock.Any(), created).Return(longErr)
+				o.EXPECT().SaveAttempt(ctx, entity.OutboxDelivery{
+					EventID:       created.ID,
+					Sink:          service.OutboxSinkEvents,
+					Status:        entity.OutboxDeliveryStatusPending,
+					Attempts:      1,
+					NextAttemptAt: now.Add(time.Second),
+					LastError:     lo.ToPtr("x" + strings.Repeat("ж", 255)),
+				}).Return(nil)
+			},
+			want: 1,
+		},
+		{
+			name: "last failed attempt is dead",
+			mockBehavior: func(o *repomocks.MockOutbox, webhooks, sink *eventmocks.MockPublisher) {
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkWebhooks, now, leaseUntil, settings.BatchSize).
+					Return([]dto.OutboxDispatch{dispatch(created, service.OutboxSinkWebhooks, 2)}, nil)
+				webhooks.EXPECT().Publish(gomock.Any(), created).Return(arbitraryErr)
+				o.EXPECT().SaveAttempt(ctx, entity.OutboxDelivery{
+					EventID:       created.ID,
+					Sink:          service.OutboxSinkWebhooks,
+					Status:        entity.OutboxDeliveryStatusDead,
+					Attempts:      3,
+					NextAttemptAt: leaseUntil,
+					LastError:     lo.ToPtr(arbitraryErr.Error()),
+				}).Return(nil)
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkEvents, now, leaseUntil, settings.BatchSize).Return(nil, nil)
+			},
+			want: 1,
+		},
+		{
+			name: "cannot claim events of one sink",
+			mockBehavior: func(o *repomocks.MockOutbox, webhooks, sink *eventmocks.MockPublisher) {
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkWebhooks, now, leaseUntil, settings.BatchSize).Return(nil, arbitraryErr)
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkEvents, now, leaseUntil, settings.BatchSize).
+					Return([]dto.OutboxDispatch{dispatch(closed, service.OutboxSinkEvents, 0)}, nil)
+				sink.EXPECT().Publish(gomock.Any(), closed).Return(nil)
+				o.EXPECT().SaveAttempt(ctx, published(closed, service.OutboxSinkEvents, 1)).Return(nil)
+			},
+			want:    1,
+			wantErr: service.ErrCannotRelayEvents,
+		},
+		{
+			name: "cannot save attempt",
+			mockBehavior: func(o *repomocks.MockOutbox, webhooks, sink *eventmocks.MockPublisher) {
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkWebhooks, now, leaseUntil, settings.BatchSize).
+					Return([]dto.OutboxDispatch{dispatch(created, service.OutboxSinkWebhooks, 0)}, nil)
+				webhooks.EXPECT().Publish(gomock.Any(), created).Return(nil)
+				o.EXPECT().SaveAttempt(ctx, published(created, service.OutboxSinkWebhooks, 1)).Return(arbitraryErr)
+				o.EXPECT().ClaimDue(ctx, service.OutboxSinkEvents, now, leaseUntil, settings.BatchSize).Return(nil, nil)
+			},
+			want:    1,
+			wantErr: service.ErrCannotRelayEvents,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockOutboxRepo := repomocks.NewMockOutbox(ctrl)
+			mockWebhooks := eventmocks.NewMockPublisher(ctrl)
+			mockSink := eventmocks.NewMockPublisher(ctrl)
+
+			tc.mockBehavior(mockOutboxRepo, mockWebhooks, mockSink)
+
+			sinks := []service.OutboxSink{
+				{Name: service.OutboxSinkWebhooks, Publisher: mockWebhooks},
+				{Name: service.OutboxSinkEvents, Publisher: mockSink},
+			}
+			s := service.NewOutboxService(mockOutboxRepo, eventmocks.NewMockPublisher(ctrl), sinks, clockwork.NewFakeClockAt(now), settings)
+
+			got, err := s.RelayBatch(ctx)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestOutboxService_DeleteFinished(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		ctx      = context.Background()
+		now      = time.Date(2025, 5, 24, 10, 0, 0, 0, time.UTC)
+		settings = service.OutboxSettings{Retention: 24 * time.Hour}
+		sinks    = []service.OutboxSink{{Name: service.OutboxSinkWebhooks}, {Name: service.OutboxSinkEvents}}
+		names    = []string{service.OutboxSinkWebhooks, service.OutboxSinkEvents}
+	)
+
+	for _, tc := range []struct {
+		name    string
+		repoErr error
+		wantErr error
+	}{
+		{
+			name: "deleted",
+		},
+		{
+			name:    "cannot delete",
+			repoErr: errors.New("arbitrary error"),
+			wantErr: service.ErrCannotDeleteOutboxEvents,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockOutboxRepo := repomocks.NewMockOutbox(ctrl)
+			mockOutboxRepo.EXPECT().DeleteFinished(ctx, names, now.Add(-settings.Retention)).Return(int64(3), tc.repoErr)
+
+			s := service.NewOutboxService(mockOutboxRepo, nil, sinks, clockwork.NewFakeClockAt(now), settings)
+
+			assert.ErrorIs(t, s.DeleteFinished(ctx), tc.wantErr)
+		})
+	}
+}
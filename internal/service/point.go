@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 
@@ -15,34 +16,55 @@ import (
 )
 
 var (
-	ErrCityNotFound            = errors.New("city not found")
-	ErrCannotCreatePoint       = errors.New("cannot create point")
-	ErrActiveReceptionNotFound = errors.New("active reception not found")
-	ErrProductNotFound         = errors.New("product not found")
-	ErrCannotCloseReception    = errors.New("cannot close reception")
-	ErrCannotDeleteLastProduct = errors.New("cannot delete last product")
-	ErrProductAlreadyDeleted   = errors.New("product already deleted")
-	ErrCannotGetPoints         = errors.New("cannot get points")
+	ErrCityNotFound            = NewError(CodeCityNotFound, "city not found")
+	ErrCannotCreatePoint       = NewError(CodeInternal, "cannot create point")
+	ErrActiveReceptionNotFound = NewError(CodeReceptionNotActive, "active reception not found")
+	ErrProductNotFound         = NewError(CodeProductNotFound, "product not found")
+	ErrCannotCloseReception    = NewError(CodeInternal, "cannot close reception")
+	ErrCannotDeleteLastProduct = NewError(CodeInternal, "cannot delete last product")
+	ErrCannotDeleteProduct     = NewError(CodeInternal, "cannot delete product")
+	ErrProductAlreadyDeleted   = NewError(CodeProductAlreadyDeleted, "product already deleted")
+	ErrCannotGetPoints         = NewError(CodeInternal, "cannot get points")
+	ErrCannotGetPoint          = NewError(CodeInternal, "cannot get point")
+	ErrInvalidCursor           = NewError(CodeInvalidCursor, "invalid cursor")
+	ErrPointNotFound           = NewError(CodePointNotFound, "point not found")
+	ErrPointSuspended          = NewError(CodePointSuspended, "point is suspended")
+	ErrPointClosed             = NewError(CodePointClosed, "point is closed")
+	ErrInvalidPointTransition  = NewError(CodeInvalidPointTransition, "invalid point status transition")
+	ErrCannotUpdatePoint       = NewError(CodeInternal, "cannot update point")
 )
 
 type PointService struct {
 	pointRepo     repository.Point
 	productRepo   repository.Product
 	receptionRepo repository.Reception
+	trManager     trm.Manager
+	outboxRepo    repository.Outbox
 	pointsCreated metrics.Counter
 }
 
-func NewPointService(pointRepo repository.Point, productRepo repository.Product, receptionRepo repository.Reception, pointsCreated metrics.Counter) *PointService {
+func NewPointService(pointRepo repository.Point, productRepo repository.Product, receptionRepo repository.Reception,
+	trManager trm.Manager, outboxRepo repository.Outbox, pointsCreated metrics.Counter) *PointService {
 	return &PointService{
 		pointRepo:     pointRepo,
 		productRepo:   productRepo,
 		receptionRepo: receptionRepo,
+		trManager:     trManager,
+		outboxRepo:    outboxRepo,
 		pointsCreated: pointsCreated,
 	}
 }
 
-func (s *PointService) Create(ctx context.Context, city string) (entity.Point, error) {
-	point, err := s.pointRepo.Create(ctx, city)
+func (s *PointService) Create(ctx context.Context, input entity.Point) (entity.Point, error) {
+	if len(input.TimeZone) == 0 {
+		input.TimeZone = entity.DefaultTimeZone
+	}
+
+	if _, err := time.LoadLocation(input.TimeZone); err != nil {
+		return entity.Point{}, ErrInvalidTimeZone
+	}
+
+	point, err := s.pointRepo.Create(ctx, input)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
 			return entity.Point{}, ErrCityNotFound
@@ -56,8 +78,8 @@ func (s *PointService) Create(ctx context.Context, city string) (entity.Point, e
 	return point, nil
 }
 
-func (s *PointService) GetAll(ctx context.Context) ([]entity.Point, error) {
-	points, err := s.pointRepo.GetAll(ctx)
+func (s *PointService) GetAll(ctx context.Context, status *entity.PointStatus) ([]entity.Point, error) {
+	points, err := s.pointRepo.GetAll(ctx, status)
 	if err != nil {
 		log.Errorf("PointService.GetAll - s.pointRepo.GetAll: %v", err)
 		return []entity.Point{}, ErrCannotGetPoints
@@ -66,84 +88,359 @@ func (s *PointService) GetAll(ctx context.Context) ([]entity.Point, error) {
 	return points, nil
 }
 
-func (s *PointService) GetExtended(ctx context.Context, start, end *time.Time, pagePtr, limitPtr *int) ([]dto.PointOutput, error) {
+func (s *PointService) GetByID(ctx context.Context, pointID uuid.UUID) (entity.Point, error) {
+	point, err := s.pointRepo.GetByID(ctx, pointID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.Point{}, ErrPointNotFound
+		}
+
+		log.Errorf("PointService.GetByID - s.pointRepo.GetByID: %v", err)
+		return entity.Point{}, ErrCannotGetPoint
+	}
+
+	return point, nil
+}
+
+func (s *PointService) GetNearby(ctx context.Context, latitude, longitude, radius float64, limitPtr *int) ([]dto.NearbyPoint, error) {
 	limit := DefaultLimit
 	if limitPtr != nil && *limitPtr > 0 {
 		limit = *limitPtr
 	}
 
-	page := DefaultPage
-	if pagePtr != nil && *pagePtr > 0 {
-		page = *pagePtr
+	points, err := s.pointRepo.GetNearby(ctx, latitude, longitude, radius, limit)
+	if err != nil {
+		log.Errorf("PointService.GetNearby - s.pointRepo.GetNearby: %v", err)
+		return []dto.NearbyPoint{}, ErrCannotGetPoints
+	}
+
+	return points, nil
+}
+
+// GetExtended returns a page of points with their receptions. Cursor pagination is stable while points are
+// being created, page numbers are kept for older clients.
+func (s *PointService) GetExtended(ctx context.Context, filter dto.PointFilter, pagination dto.Pagination) (dto.PointPage, error) {
+	limit := DefaultLimit
+	if pagination.Limit != nil && *pagination.Limit > 0 {
+		limit = *pagination.Limit
+	}
+
+	var (
+		after  *dto.PointCursor
+		offset int
+	)
+
+	sort := filter.Sort
+	if len(sort) == 0 {
+		sort = dto.PointSortCreatedAtAsc
 	}
 
-	offset := (page - 1) * limit
+	if len(pagination.Cursor) > 0 {
+		cursor, err := dto.DecodePointCursor(pagination.Cursor)
+		if err != nil || cursor.Sort != sort {
+			return dto.PointPage{}, ErrInvalidCursor
+		}
+		after = &cursor
+	} else {
+		page := DefaultPage
+		if pagination.Page != nil && *pagination.Page > 0 {
+			page = *pagination.Page
+		}
+		offset = (page - 1) * limit
+	}
 
-	points, err := s.pointRepo.GetExtended(ctx, start, end, offset, limit)
+	points, err := s.pointRepo.GetExtended(ctx, filter, after, offset, limit)
 	if err != nil {
 		log.Errorf("PointService.GetExtended - s.pointRepo.GetExtended: %v", err)
-		return []dto.PointOutput{}, ErrCannotGetPoints
+		return dto.PointPage{}, ErrCannotGetPoints
 	}
 
-	return points, nil
+	result := dto.PointPage{Points: make([]dto.PointOutput, len(points))}
+	for i, point := range points {
+		result.Points[i] = inPointLocation(point)
+	}
+
+	// A short page is the last one, so there is nothing to continue from
+	if len(points) == limit {
+		last := points[len(points)-1].Point
+		cursor := dto.PointCursor{Sort: sort, CreatedAt: last.CreatedAt, ID: last.ID}
+		if sort == dto.PointSortCityAsc || sort == dto.PointSortCityDesc {
+			cursor.City = last.City
+		}
+		result.NextCursor = cursor.Encode()
+	}
+
+	return result, nil
 }
 
-func (s *PointService) CloseLastReception(ctx context.Context, pointID uuid.UUID) (entity.Reception, error) {
-	receptionID, err := s.receptionRepo.GetActiveID(ctx, pointID)
+// ChangeStatus moves point to the given lifecycle status if the transition is allowed
+func (s *PointService) ChangeStatus(ctx context.Context, pointID uuid.UUID, status entity.PointStatus) (entity.Point, error) {
+	point, err := s.pointRepo.GetByID(ctx, pointID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return entity.Reception{}, ErrActiveReceptionNotFound
+			return entity.Point{}, ErrPointNotFound
 		}
 
-		log.Errorf("PointService.CloseLastReception - s.receptionRepo.GetActiveID: %v", err)
-		return entity.Reception{}, ErrCannotCloseReception
+		log.Errorf("PointService.ChangeStatus - s.pointRepo.GetByID: %v", err)
+		return entity.Point{}, ErrCannotUpdatePoint
+	}
+
+	if !point.Status.CanTransitionTo(status) {
+		return entity.Point{}, ErrInvalidPointTransition
+	}
+
+	point, err = s.pointRepo.UpdateStatus(ctx, pointID, point.Status, status)
+	if err != nil {
+		// Status was changed concurrently, so the transition we checked is no longer valid
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.Point{}, ErrInvalidPointTransition
+		}
+
+		log.Errorf("PointService.ChangeStatus - s.pointRepo.UpdateStatus: %v", err)
+		return entity.Point{}, ErrCannotUpdatePoint
 	}
 
-	log.Debugf("PointService.CloseLastReception - receptionID: %v", receptionID)
+	return point, nil
+}
+
+// CloseLastReception closes active reception of the point and moves its received products to storage.
+// The reception is locked for the whole transaction, so concurrent closings and deletions wait for each other.
+func (s *PointService) CloseLastReception(ctx context.Context, pointID uuid.UUID) (entity.Reception, error) {
+	var reception entity.Reception
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		receptionID, err := s.receptionRepo.GetActiveIDForUpdate(ctx, pointID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrActiveReceptionNotFound
+			}
+
+			log.Errorf("PointService.CloseLastReception - s.receptionRepo.GetActiveIDForUpdate: %v", err)
+			return ErrCannotCloseReception
+		}
+
+		log.Debugf("PointService.CloseLastReception - receptionID: %v", receptionID)
+
+		reception, err = s.receptionRepo.Close(ctx, receptionID)
+		if err != nil {
+			log.Errorf("PointService.CloseLastReception - s.receptionRepo.Close: %v", err)
+			return ErrCannotCloseReception
+		}
+
+		err = s.productRepo.UpdateStatusByReception(ctx, receptionID, entity.ProductStatusReceived, entity.ProductStatusStored)
+		if err != nil {
+			log.Errorf("PointService.CloseLastReception - s.productRepo.UpdateStatusByReception: %v", err)
+			return ErrCannotCloseReception
+		}
+
+		err = s.outboxRepo.Add(ctx, entity.Event{
+			Type:        entity.EventTypeReceptionClosed,
+			PointID:     pointID,
+			ReceptionID: receptionID,
+		})
+		if err != nil {
+			log.Errorf("PointService.CloseLastReception - s.outboxRepo.Add: %v", err)
+			return ErrCannotCloseReception
+		}
 
-	reception, err := s.receptionRepo.Close(ctx, receptionID)
+		return nil
+	})
 	if err != nil {
-		log.Errorf("PointService.CloseLastReception - s.receptionRepo.Close: %v", err)
+		switch {
+		case errors.Is(err, ErrActiveReceptionNotFound):
+			return entity.Reception{}, ErrActiveReceptionNotFound
+		case !errors.Is(err, ErrCannotCloseReception):
+			log.Errorf("PointService.CloseLastReception - s.trManager.Do: %v", err)
+		}
+
 		return entity.Reception{}, ErrCannotCloseReception
 	}
 
 	return reception, nil
 }
 
+// DeleteLastProduct deletes the latest product of the active reception.
+// The reception is locked for the whole transaction, so concurrent calls delete different products.
 func (s *PointService) DeleteLastProduct(ctx context.Context, pointID uuid.UUID) error {
-	receptionID, err := s.receptionRepo.GetActiveID(ctx, pointID)
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		receptionID, err := s.receptionRepo.GetActiveIDForUpdate(ctx, pointID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrActiveReceptionNotFound
+			}
+
+			log.Errorf("PointService.DeleteLastProduct - s.receptionRepo.GetActiveIDForUpdate: %v", err)
+			return ErrCannotDeleteLastProduct
+		}
+
+		log.Debugf("PointService.DeleteLastProduct - receptionID: %v", receptionID)
+
+		productID, err := s.productRepo.GetLatestID(ctx, receptionID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrProductNotFound
+			}
+
+			log.Errorf("PointService.DeleteLastProduct - s.productRepo.GetLatestID: %v", err)
+			return ErrCannotDeleteLastProduct
+		}
+
+		log.Debugf("PointService.DeleteLastProduct - productID: %v", productID)
+
+		err = s.productRepo.DeleteByID(ctx, productID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsDeleted) {
+				return ErrProductAlreadyDeleted
+			}
+
+			log.Errorf("PointService.DeleteLastProduct - s.productRepo.DeleteByID: %v", err)
+			return ErrCannotDeleteLastProduct
+		}
+
+		err = s.outboxRepo.Add(ctx, entity.Event{
+			Type:        entity.EventTypeProductDeleted,
+			PointID:     pointID,
+			ReceptionID: receptionID,
+			ProductID:   &productID,
+		})
+		if err != nil {
+			log.Errorf("PointService.DeleteLastProduct - s.outboxRepo.Add: %v", err)
+			return ErrCannotDeleteLastProduct
+		}
+
+		return nil
+	})
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
+		switch {
+		case errors.Is(err, ErrActiveReceptionNotFound):
 			return ErrActiveReceptionNotFound
+		case errors.Is(err, ErrProductNotFound):
+			return ErrProductNotFound
+		case errors.Is(err, ErrProductAlreadyDeleted):
+			return ErrProductAlreadyDeleted
+		case !errors.Is(err, ErrCannotDeleteLastProduct):
+			log.Errorf("PointService.DeleteLastProduct - s.trManager.Do: %v", err)
 		}
 
-		log.Errorf("PointService.DeleteLastProduct - s.receptionRepo.GetActiveID: %v", err)
 		return ErrCannotDeleteLastProduct
 	}
 
-	log.Debugf("PointService.DeleteLastProduct - receptionID: %v", receptionID)
+	return nil
+}
+
+func (s *PointService) DeleteProduct(ctx context.Context, pointID, productID uuid.UUID) error {
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		receptionID, err := s.receptionRepo.GetActiveIDForUpdate(ctx, pointID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrActiveReceptionNotFound
+			}
+
+			log.Errorf("PointService.DeleteProduct - s.receptionRepo.GetActiveIDForUpdate: %v", err)
+			return ErrCannotDeleteProduct
+		}
+
+		log.Debugf("PointService.DeleteProduct - receptionID: %v", receptionID)
+
+		err = s.productRepo.DeleteFromReception(ctx, receptionID, productID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsDeleted) {
+				return ErrProductNotFound
+			}
+
+			log.Errorf("PointService.DeleteProduct - s.productRepo.DeleteFromReception: %v", err)
+			return ErrCannotDeleteProduct
+		}
+
+		err = s.outboxRepo.Add(ctx, entity.Event{
+			Type:        entity.EventTypeProductDeleted,
+			PointID:     pointID,
+			ReceptionID: receptionID,
+			ProductID:   &productID,
+		})
+		if err != nil {
+			log.Errorf("PointService.DeleteProduct - s.outboxRepo.Add: %v", err)
+			return ErrCannotDeleteProduct
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrActiveReceptionNotFound):
+			return ErrActiveReceptionNotFound
+		case errors.Is(err, ErrProductNotFound):
+			return ErrProductNotFound
+		case !errors.Is(err, ErrCannotDeleteProduct):
+			log.Errorf("PointService.DeleteProduct - s.trManager.Do: %v", err)
+		}
+
+		return ErrCannotDeleteProduct
+	}
+
+	return nil
+}
 
-	productID, err := s.productRepo.GetLatestID(ctx, receptionID)
+// ensurePointActive returns the point only if it exists and accepts new receptions and products
+func ensurePointActive(ctx context.Context, pointRepo repository.Point, pointID uuid.UUID) (entity.Point, error) {
+	point, err := pointRepo.GetByID(ctx, pointID)
 	if err != nil {
 		if errors.Is(err, repository.ErrNotFound) {
-			return ErrProductNotFound
+			return entity.Point{}, ErrPointNotFound
 		}
 
-		log.Errorf("PointService.DeleteLastProduct - s.productRepo.GetLatestID: %v", err)
-		return ErrCannotDeleteLastProduct
+		return entity.Point{}, err
 	}
 
-	log.Debugf("PointService.DeleteLastProduct - productID: %v", productID)
+	switch point.Status {
+	case entity.PointStatusSuspended:
+		return entity.Point{}, ErrPointSuspended
+	case entity.PointStatusClosed:
+		return entity.Point{}, ErrPointClosed
+	default:
+		return point, nil
+	}
+}
 
-	err = s.productRepo.DeleteByID(ctx, productID)
+// inPointLocation returns copy of the report with timestamps converted to point local time
+func inPointLocation(output dto.PointOutput) dto.PointOutput {
+	loc, err := time.LoadLocation(output.Point.TimeZone)
 	if err != nil {
-		if errors.Is(err, repository.ErrNoRowsDeleted) {
-			return ErrProductAlreadyDeleted
+		log.Errorf("PointService.inPointLocation - time.LoadLocation: %v", err)
+		return output
+	}
+
+	output.Point.CreatedAt = output.Point.CreatedAt.In(loc)
+
+	if output.Receptions == nil {
+		return output
+	}
+
+	receptions := make([]dto.ReceptionResult, len(output.Receptions))
+	for i, reception := range output.Receptions {
+		reception.Reception.CreatedAt = reception.Reception.CreatedAt.In(loc)
+
+		if reception.Products != nil {
+			products := make([]dto.Product, len(reception.Products))
+			for j, product := range reception.Products {
+				product.CreatedAt = product.CreatedAt.In(loc)
+				products[j] = product
+			}
+			reception.Products = products
 		}
 
-		log.Errorf("PointService.DeleteLastProduct - s.productRepo.DeleteByID: %v", err)
-		return ErrCannotDeleteLastProduct
+		if reception.Reopenings != nil {
+			reopenings := make([]dto.ReceptionReopening, len(reception.Reopenings))
+			for j, reopening := range reception.Reopenings {
+				reopening.ReopenedAt = reopening.ReopenedAt.In(loc)
+				reopenings[j] = reopening
+			}
+			reception.Reopenings = reopenings
+		}
+
+		receptions[i] = reception
 	}
+	output.Receptions = receptions
 
-	return nil
+	return output
 }
@@ -26,43 +26,74 @@ func TestPointService_Create(t *testing.T) {
 	var (
 		arbitraryErr = errors.New("arbitrary error")
 		ctx          = context.Background()
-		city         = "Екатеринбург"
 		timestamp    = time.Now()
 	)
 
+	input := entity.Point{
+		City:      "Екатеринбург",
+		Address:   "ул. Малышева, 51",
+		Latitude:  lo.ToPtr(56.8361),
+		Longitude: lo.ToPtr(60.6153),
+		TimeZone:  "Asia/Yekaterinburg",
+	}
+
 	point := entity.Point{
 		ID:        uuid.New(),
-		City:      city,
+		City:      input.City,
 		CreatedAt: timestamp,
+		Status:    entity.PointStatusActive,
+		Address:   input.Address,
+		Latitude:  input.Latitude,
+		Longitude: input.Longitude,
+		TimeZone:  input.TimeZone,
 	}
 
 	type MockBehavior func(p *repomocks.MockPoint, m *metricmocks.MockCounter)
 
 	for _, tc := range []struct {
 		name         string
+		input        entity.Point
 		mockBehavior MockBehavior
 		want         entity.Point
 		wantErr      error
 	}{
 		{
-			name: "success",
+			name:  "success",
+			input: input,
+			mockBehavior: func(p *repomocks.MockPoint, m *metricmocks.MockCounter) {
+				p.EXPECT().Create(ctx, input).Return(point, nil)
+				m.EXPECT().Inc()
+			},
+			want: point,
+		},
+		{
+			name:  "default time zone",
+			input: entity.Point{City: input.City},
 			mockBehavior: func(p *repomocks.MockPoint, m *metricmocks.MockCounter) {
-				p.EXPECT().Create(ctx, city).Return(point, nil)
+				p.EXPECT().Create(ctx, entity.Point{City: input.City, TimeZone: entity.DefaultTimeZone}).Return(point, nil)
 				m.EXPECT().Inc()
 			},
 			want: point,
 		},
 		{
-			name: "city not found",
+			name:         "invalid time zone",
+			input:        entity.Point{City: input.City, TimeZone: "Mars/Olympus"},
+			mockBehavior: func(p *repomocks.MockPoint, m *metricmocks.MockCounter) {},
+			wantErr:      service.ErrInvalidTimeZone,
+		},
+		{
+			name:  "city not found",
+			input: input,
 			mockBehavior: func(p *repomocks.MockPoint, m *metricmocks.MockCounter) {
-				p.EXPECT().Create(ctx, city).Return(entity.Point{}, repository.ErrNotFound)
+				p.EXPECT().Create(ctx, input).Return(entity.Point{}, repository.ErrNotFound)
 			},
 			wantErr: service.ErrCityNotFound,
 		},
 		{
-			name: "cannot create point",
+			name:  "cannot create point",
+			input: input,
 			mockBehavior: func(p *repomocks.MockPoint, m *metricmocks.MockCounter) {
-				p.EXPECT().Create(ctx, city).Return(entity.Point{}, arbitraryErr)
+				p.EXPECT().Create(ctx, input).Return(entity.Point{}, arbitraryErr)
 			},
 			wantErr: service.ErrCannotCreatePoint,
 		},
@@ -78,9 +109,9 @@ func TestPointService_Create(t *testing.T) {
 
 			tc.mockBehavior(mockPointRepo, mockPointCounter)
 
-			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, mockPointCounter)
+			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, trManagerStub{}, &outboxStub{}, mockPointCounter)
 
-			got, err := s.Create(ctx, city)
+			got, err := s.Create(ctx, tc.input)
 
 			assert.ErrorIs(t, err, tc.wantErr)
 			assert.Equal(t, tc.want, got)
@@ -100,16 +131,19 @@ func TestPointService_GetAll(t *testing.T) {
 			ID:        uuid.New(),
 			City:      "Москва",
 			CreatedAt: time.Now(),
+			Status:    entity.PointStatusActive,
 		},
 		{
 			ID:        uuid.New(),
 			City:      "Санкт-Петербург",
 			CreatedAt: time.Now(),
+			Status:    entity.PointStatusSuspended,
 		},
 		{
 			ID:        uuid.New(),
 			City:      "Казань",
 			CreatedAt: time.Now(),
+			Status:    entity.PointStatusActive,
 		},
 	}
 
@@ -117,6 +151,7 @@ func TestPointService_GetAll(t *testing.T) {
 
 	for _, tc := range []struct {
 		name         string
+		status       *entity.PointStatus
 		mockBehavior MockBehavior
 		want         []entity.Point
 		wantErr      error
@@ -124,14 +159,22 @@ func TestPointService_GetAll(t *testing.T) {
 		{
 			name: "success",
 			mockBehavior: func(p *repomocks.MockPoint) {
-				p.EXPECT().GetAll(ctx).Return(points, nil)
+				p.EXPECT().GetAll(ctx, nil).Return(points, nil)
 			},
 			want: points,
 		},
+		{
+			name:   "filter by status",
+			status: lo.ToPtr(entity.PointStatusSuspended),
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetAll(ctx, lo.ToPtr(entity.PointStatusSuspended)).Return(points[1:2], nil)
+			},
+			want: points[1:2],
+		},
 		{
 			name: "cannot get all points",
 			mockBehavior: func(p *repomocks.MockPoint) {
-				p.EXPECT().GetAll(ctx).Return([]entity.Point{}, arbitraryErr)
+				p.EXPECT().GetAll(ctx, nil).Return([]entity.Point{}, arbitraryErr)
 			},
 			want:    []entity.Point{},
 			wantErr: service.ErrCannotGetPoints,
@@ -148,9 +191,167 @@ func TestPointService_GetAll(t *testing.T) {
 
 			tc.mockBehavior(mockPointRepo)
 
-			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, mockPointCounter)
+			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, trManagerStub{}, &outboxStub{}, mockPointCounter)
+
+			got, err := s.GetAll(ctx, tc.status)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPointService_GetByID(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		pointID      = uuid.New()
+	)
+
+	point := entity.Point{
+		ID:        pointID,
+		City:      "Москва",
+		CreatedAt: time.Now(),
+		Status:    entity.PointStatusActive,
+		TimeZone:  entity.DefaultTimeZone,
+	}
+
+	type MockBehavior func(p *repomocks.MockPoint)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         entity.Point
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+			},
+			want: point,
+		},
+		{
+			name: "point not found",
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(entity.Point{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrPointNotFound,
+		},
+		{
+			name: "cannot get point",
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(entity.Point{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotGetPoint,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockPointCounter := metricmocks.NewMockCounter(ctrl)
+
+			tc.mockBehavior(mockPointRepo)
+
+			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, trManagerStub{}, &outboxStub{}, mockPointCounter)
+
+			got, err := s.GetByID(ctx, pointID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPointService_GetNearby(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		latitude     = 55.7558
+		longitude    = 37.6173
+		radius       = 5000.0
+	)
+
+	points := []dto.NearbyPoint{
+		{
+			Point: entity.Point{
+				ID:        uuid.New(),
+				CreatedAt: time.Now(),
+				City:      "Москва",
+				Status:    entity.PointStatusActive,
+				Address:   "ул. Тверская, 7",
+				Latitude:  lo.ToPtr(55.7580),
+				Longitude: lo.ToPtr(37.6131),
+			},
+			Distance: 350.2,
+		},
+		{
+			Point: entity.Point{
+				ID:        uuid.New(),
+				CreatedAt: time.Now(),
+				City:      "Москва",
+				Status:    entity.PointStatusActive,
+				Address:   "ул. Арбат, 10",
+				Latitude:  lo.ToPtr(55.7516),
+				Longitude: lo.ToPtr(37.5957),
+			},
+			Distance: 1432.8,
+		},
+	}
+
+	type MockBehavior func(p *repomocks.MockPoint)
+
+	for _, tc := range []struct {
+		name         string
+		limit        *int
+		mockBehavior MockBehavior
+		want         []dto.NearbyPoint
+		wantErr      error
+	}{
+		{
+			name: "success with default limit",
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetNearby(ctx, latitude, longitude, radius, service.DefaultLimit).Return(points, nil)
+			},
+			want: points,
+		},
+		{
+			name:  "success with limit",
+			limit: lo.ToPtr(1),
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetNearby(ctx, latitude, longitude, radius, 1).Return(points[:1], nil)
+			},
+			want: points[:1],
+		},
+		{
+			name: "cannot get points",
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetNearby(ctx, latitude, longitude, radius, service.DefaultLimit).Return(nil, arbitraryErr)
+			},
+			want:    []dto.NearbyPoint{},
+			wantErr: service.ErrCannotGetPoints,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockPointCounter := metricmocks.NewMockCounter(ctrl)
+
+			tc.mockBehavior(mockPointRepo)
+
+			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, trManagerStub{}, &outboxStub{}, mockPointCounter)
 
-			got, err := s.GetAll(ctx)
+			got, err := s.GetNearby(ctx, latitude, longitude, radius, tc.limit)
 
 			assert.ErrorIs(t, err, tc.wantErr)
 			assert.Equal(t, tc.want, got)
@@ -165,7 +366,6 @@ func TestPointService_GetExtended(t *testing.T) {
 		ctx          = context.Background()
 		start        = lo.Must(time.Parse(time.RFC3339, "2025-03-15T14:00:00Z"))
 		end          = lo.Must(time.Parse(time.RFC3339, "2025-03-17T19:49:00Z"))
-		page         = service.DefaultPage
 		offset       = (service.DefaultPage - 1) * service.DefaultLimit
 		limit        = service.DefaultLimit
 	)
@@ -218,53 +418,180 @@ func TestPointService_GetExtended(t *testing.T) {
 		},
 	}
 
-	type args struct {
-		start *time.Time
-		end   *time.Time
+	yekaterinburg := lo.Must(time.LoadLocation("Asia/Yekaterinburg"))
+	registeredAt := lo.Must(time.Parse(time.RFC3339, "2025-04-13T20:30:00Z"))
+	openedAt := lo.Must(time.Parse(time.RFC3339, "2025-04-14T04:00:00Z"))
+	scannedAt := lo.Must(time.Parse(time.RFC3339, "2025-04-14T04:05:00Z"))
+
+	localPoint := func(loc *time.Location) []dto.PointOutput {
+		return []dto.PointOutput{
+			{
+				Point: dto.Point{
+					ID:        uuid.MustParse("0b0d4c0e-3a43-4f7c-9d47-6f6a3c8f3e21"),
+					CreatedAt: registeredAt.In(loc),
+					City:      "Екатеринбург",
+					Status:    entity.PointStatusActive,
+					TimeZone:  "Asia/Yekaterinburg",
+				},
+				Receptions: []dto.ReceptionResult{
+					{
+						Reception: dto.Reception{
+							ID:        uuid.MustParse("5f8e7a52-6b1f-4d8e-9a0c-1c2b3d4e5f60"),
+							PointID:   uuid.MustParse("0b0d4c0e-3a43-4f7c-9d47-6f6a3c8f3e21"),
+							CreatedAt: openedAt.In(loc),
+							Status:    entity.ReceptionStatusInProgress,
+						},
+						Products: []dto.Product{
+							{
+								ID:          uuid.MustParse("9a7b6c5d-4e3f-4a1b-8c2d-0e1f2a3b4c5d"),
+								ReceptionID: uuid.MustParse("5f8e7a52-6b1f-4d8e-9a0c-1c2b3d4e5f60"),
+								CreatedAt:   scannedAt.In(loc),
+								Type:        entity.ProductTypeShoes,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	cursor := dto.PointCursor{
+		Sort:      dto.PointSortCreatedAtAsc,
+		CreatedAt: lo.Must(time.Parse(time.RFC3339, "2025-04-12T10:00:00.123456Z")),
+		ID:        uuid.MustParse("11b2b7a6-3c52-4f0e-9c1a-7d2f7c1e0a01"),
+	}
+	nextCursor := dto.PointCursor{Sort: dto.PointSortCreatedAtAsc, CreatedAt: output[1].Point.CreatedAt, ID: output[1].Point.ID}.Encode()
+
+	cityCursor := dto.PointCursor{Sort: dto.PointSortCityDesc, City: "Москва", CreatedAt: cursor.CreatedAt, ID: cursor.ID}
+	cityFilter := dto.PointFilter{
+		City:            lo.ToPtr("Казань"),
+		ReceptionStatus: lo.ToPtr(entity.ReceptionStatus(entity.ReceptionStatusInProgress)),
+		ProductType:     lo.ToPtr(entity.ProductTypeElectronics),
+		Sort:            dto.PointSortCityDesc,
 	}
+
+	utcOutput := localPoint(time.UTC)
+	localOutput := localPoint(yekaterinburg)
+
 	type MockBehavior func(p *repomocks.MockPoint)
 
 	for _, tc := range []struct {
 		name         string
-		args         args
+		filter       dto.PointFilter
+		pagination   dto.Pagination
 		mockBehavior MockBehavior
-		want         []dto.PointOutput
+		want         dto.PointPage
 		wantErr      error
 	}{
 		{
 			name: "success",
-			args: args{
-				start: &start,
-				end:   &end,
+			filter: dto.PointFilter{
+				StartDate: &start,
+				EndDate:   &end,
 			},
 			mockBehavior: func(p *repomocks.MockPoint) {
-				p.EXPECT().GetExtended(ctx, &start, &end, offset, limit).Return(output, nil)
+				p.EXPECT().GetExtended(ctx, dto.PointFilter{StartDate: &start, EndDate: &end}, nil, offset, limit).Return(output, nil)
 			},
-			want: output,
+			want: dto.PointPage{Points: output},
 		},
 		{
-			name: "success without filters",
-			args: args{
-				start: nil,
-				end:   nil,
+			name:   "success without filters",
+			filter: dto.PointFilter{},
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetExtended(ctx, dto.PointFilter{}, nil, offset, limit).Return(output, nil)
+			},
+			want: dto.PointPage{Points: output},
+		},
+		{
+			name: "filter by status",
+			filter: dto.PointFilter{
+				Status: lo.ToPtr(entity.PointStatusActive),
+			},
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetExtended(ctx, dto.PointFilter{Status: lo.ToPtr(entity.PointStatusActive)}, nil, offset, limit).Return(output, nil)
 			},
+			want: dto.PointPage{Points: output},
+		},
+		{
+			name:   "renders local time",
+			filter: dto.PointFilter{},
 			mockBehavior: func(p *repomocks.MockPoint) {
-				p.EXPECT().GetExtended(ctx, nil, nil, offset, limit).Return(output, nil)
+				p.EXPECT().GetExtended(ctx, dto.PointFilter{}, nil, offset, limit).Return(utcOutput, nil)
 			},
-			want: output,
+			want: dto.PointPage{Points: localOutput},
 		},
 		{
 			name: "cannot get points",
-			args: args{
-				start: &start,
-				end:   &end,
+			filter: dto.PointFilter{
+				StartDate: &start,
+				EndDate:   &end,
 			},
 			mockBehavior: func(p *repomocks.MockPoint) {
-				p.EXPECT().GetExtended(ctx, &start, &end, offset, limit).Return([]dto.PointOutput{}, arbitraryErr)
+				p.EXPECT().GetExtended(ctx, dto.PointFilter{StartDate: &start, EndDate: &end}, nil, offset, limit).Return([]dto.PointOutput{}, arbitraryErr)
 			},
-			want:    []dto.PointOutput{},
 			wantErr: service.ErrCannotGetPoints,
 		},
+		{
+			name:       "page number",
+			pagination: dto.Pagination{Page: lo.ToPtr(3), Limit: lo.ToPtr(2)},
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetExtended(ctx, dto.PointFilter{}, nil, 4, 2).Return(output, nil)
+			},
+			want: dto.PointPage{Points: output, NextCursor: nextCursor},
+		},
+		{
+			name:       "cursor",
+			pagination: dto.Pagination{Page: lo.ToPtr(3), Limit: lo.ToPtr(2), Cursor: cursor.Encode()},
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetExtended(ctx, dto.PointFilter{}, &cursor, 0, 2).Return(output, nil)
+			},
+			want: dto.PointPage{Points: output, NextCursor: nextCursor},
+		},
+		{
+			name:       "last page by cursor",
+			pagination: dto.Pagination{Limit: lo.ToPtr(3), Cursor: cursor.Encode()},
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetExtended(ctx, dto.PointFilter{}, &cursor, 0, 3).Return(output, nil)
+			},
+			want: dto.PointPage{Points: output},
+		},
+		{
+			name:       "cursor without sort",
+			pagination: dto.Pagination{Limit: lo.ToPtr(3), Cursor: dto.PointCursor{CreatedAt: cursor.CreatedAt, ID: cursor.ID}.Encode()},
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetExtended(ctx, dto.PointFilter{}, &cursor, 0, 3).Return(output, nil)
+			},
+			want: dto.PointPage{Points: output},
+		},
+		{
+			name:         "invalid cursor",
+			pagination:   dto.Pagination{Cursor: "not-a-cursor"},
+			mockBehavior: func(p *repomocks.MockPoint) {},
+			wantErr:      service.ErrInvalidCursor,
+		},
+		{
+			name:       "sorted by city with reception filters",
+			filter:     cityFilter,
+			pagination: dto.Pagination{Limit: lo.ToPtr(2), Cursor: cityCursor.Encode()},
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetExtended(ctx, cityFilter, &cityCursor, 0, 2).Return(output, nil)
+			},
+			want: dto.PointPage{
+				Points: output,
+				NextCursor: dto.PointCursor{
+					Sort:      dto.PointSortCityDesc,
+					City:      output[1].Point.City,
+					CreatedAt: output[1].Point.CreatedAt,
+					ID:        output[1].Point.ID,
+				}.Encode(),
+			},
+		},
+		{
+			name:         "cursor made for another sort",
+			filter:       dto.PointFilter{Sort: dto.PointSortCreatedAtDesc},
+			pagination:   dto.Pagination{Cursor: cursor.Encode()},
+			mockBehavior: func(p *repomocks.MockPoint) {},
+			wantErr:      service.ErrInvalidCursor,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
@@ -277,9 +604,147 @@ func TestPointService_GetExtended(t *testing.T) {
 
 			tc.mockBehavior(mockPointRepo)
 
-			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, mockPointCounter)
+			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, trManagerStub{}, &outboxStub{}, mockPointCounter)
 
-			got, err := s.GetExtended(ctx, tc.args.start, tc.args.end, &page, &limit)
+			got, err := s.GetExtended(ctx, tc.filter, tc.pagination)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestPointService_ChangeStatus(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		pointID      = uuid.New()
+		timestamp    = time.Now()
+	)
+
+	active := entity.Point{
+		ID:        pointID,
+		CreatedAt: timestamp,
+		City:      "Москва",
+		Status:    entity.PointStatusActive,
+	}
+
+	suspended := entity.Point{
+		ID:        pointID,
+		CreatedAt: timestamp,
+		City:      "Москва",
+		Status:    entity.PointStatusSuspended,
+	}
+
+	closed := entity.Point{
+		ID:        pointID,
+		CreatedAt: timestamp,
+		City:      "Москва",
+		Status:    entity.PointStatusClosed,
+	}
+
+	type MockBehavior func(p *repomocks.MockPoint)
+
+	for _, tc := range []struct {
+		name         string
+		status       entity.PointStatus
+		mockBehavior MockBehavior
+		want         entity.Point
+		wantErr      error
+	}{
+		{
+			name:   "suspend",
+			status: entity.PointStatusSuspended,
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(active, nil)
+				p.EXPECT().UpdateStatus(ctx, pointID, entity.PointStatusActive, entity.PointStatusSuspended).Return(suspended, nil)
+			},
+			want: suspended,
+		},
+		{
+			name:   "resume",
+			status: entity.PointStatusActive,
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(suspended, nil)
+				p.EXPECT().UpdateStatus(ctx, pointID, entity.PointStatusSuspended, entity.PointStatusActive).Return(active, nil)
+			},
+			want: active,
+		},
+		{
+			name:   "close suspended",
+			status: entity.PointStatusClosed,
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(suspended, nil)
+				p.EXPECT().UpdateStatus(ctx, pointID, entity.PointStatusSuspended, entity.PointStatusClosed).Return(closed, nil)
+			},
+			want: closed,
+		},
+		{
+			name:   "resume closed",
+			status: entity.PointStatusActive,
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(closed, nil)
+			},
+			wantErr: service.ErrInvalidPointTransition,
+		},
+		{
+			name:   "suspend already suspended",
+			status: entity.PointStatusSuspended,
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(suspended, nil)
+			},
+			wantErr: service.ErrInvalidPointTransition,
+		},
+		{
+			name:   "point not found",
+			status: entity.PointStatusSuspended,
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(entity.Point{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrPointNotFound,
+		},
+		{
+			name:   "cannot get point",
+			status: entity.PointStatusSuspended,
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(entity.Point{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdatePoint,
+		},
+		{
+			name:   "status changed concurrently",
+			status: entity.PointStatusSuspended,
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(active, nil)
+				p.EXPECT().UpdateStatus(ctx, pointID, entity.PointStatusActive, entity.PointStatusSuspended).Return(entity.Point{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrInvalidPointTransition,
+		},
+		{
+			name:   "cannot update status",
+			status: entity.PointStatusSuspended,
+			mockBehavior: func(p *repomocks.MockPoint) {
+				p.EXPECT().GetByID(ctx, pointID).Return(active, nil)
+				p.EXPECT().UpdateStatus(ctx, pointID, entity.PointStatusActive, entity.PointStatusSuspended).Return(entity.Point{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdatePoint,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockPointCounter := metricmocks.NewMockCounter(ctrl)
+
+			tc.mockBehavior(mockPointRepo)
+
+			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, trManagerStub{}, &outboxStub{}, mockPointCounter)
+
+			got, err := s.ChangeStatus(ctx, pointID, tc.status)
 
 			assert.ErrorIs(t, err, tc.wantErr)
 			assert.Equal(t, tc.want, got)
@@ -304,44 +769,71 @@ func TestPointService_CloseLastReception(t *testing.T) {
 		Status:    entity.ReceptionStatusClosed,
 	}
 
-	type MockBehavior func(r *repomocks.MockReception)
+	type MockBehavior func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint)
 
 	for _, tc := range []struct {
 		name         string
 		mockBehavior MockBehavior
+		outboxErr    error
 		want         entity.Reception
+		wantEvents   []entity.Event
 		wantErr      error
 	}{
 		{
 			name: "success",
-			mockBehavior: func(r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(receptionID, nil)
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
 				r.EXPECT().Close(ctx, receptionID).Return(reception, nil)
+				p.EXPECT().UpdateStatusByReception(ctx, receptionID, entity.ProductStatusReceived, entity.ProductStatusStored).Return(nil)
 			},
 			want: reception,
+			wantEvents: []entity.Event{{
+				Type:        entity.EventTypeReceptionClosed,
+				PointID:     pointID,
+				ReceptionID: receptionID,
+			}},
+		},
+		{
+			name: "cannot store event",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
+				r.EXPECT().Close(ctx, receptionID).Return(reception, nil)
+				p.EXPECT().UpdateStatusByReception(ctx, receptionID, entity.ProductStatusReceived, entity.ProductStatusStored).Return(nil)
+			},
+			outboxErr: arbitraryErr,
+			wantErr:   service.ErrCannotCloseReception,
 		},
 		{
 			name: "active reception not found",
-			mockBehavior: func(r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(uuid.Nil, repository.ErrNotFound)
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(uuid.Nil, repository.ErrNotFound)
 			},
 			wantErr: service.ErrActiveReceptionNotFound,
 		},
 		{
 			name: "cannot find reception",
-			mockBehavior: func(r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(uuid.Nil, arbitraryErr)
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(uuid.Nil, arbitraryErr)
 			},
 			wantErr: service.ErrCannotCloseReception,
 		},
 		{
 			name: "cannot close reception",
-			mockBehavior: func(r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(receptionID, nil)
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
 				r.EXPECT().Close(ctx, receptionID).Return(entity.Reception{}, arbitraryErr)
 			},
 			wantErr: service.ErrCannotCloseReception,
 		},
+		{
+			name: "cannot store products",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
+				r.EXPECT().Close(ctx, receptionID).Return(reception, nil)
+				p.EXPECT().UpdateStatusByReception(ctx, receptionID, entity.ProductStatusReceived, entity.ProductStatusStored).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotCloseReception,
+		},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
@@ -351,15 +843,17 @@ func TestPointService_CloseLastReception(t *testing.T) {
 			mockProductRepo := repomocks.NewMockProduct(ctrl)
 			mockReceptionRepo := repomocks.NewMockReception(ctrl)
 			mockPointCounter := metricmocks.NewMockCounter(ctrl)
+			outbox := &outboxStub{err: tc.outboxErr}
 
-			tc.mockBehavior(mockReceptionRepo)
+			tc.mockBehavior(mockReceptionRepo, mockProductRepo, mockPointRepo)
 
-			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, mockPointCounter)
+			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, trManagerStub{}, outbox, mockPointCounter)
 
 			got, err := s.CloseLastReception(ctx, pointID)
 
 			assert.ErrorIs(t, err, tc.wantErr)
 			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantEvents, outbox.events)
 		})
 	}
 }
@@ -374,55 +868,73 @@ func TestPointService_DeleteLastProduct(t *testing.T) {
 		productID    = uuid.New()
 	)
 
-	type MockBehavior func(p *repomocks.MockProduct, r *repomocks.MockReception)
+	type MockBehavior func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint)
 
 	for _, tc := range []struct {
 		name         string
 		mockBehavior MockBehavior
+		outboxErr    error
+		wantEvents   []entity.Event
 		wantErr      error
 	}{
 		{
 			name: "success",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(receptionID, nil)
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().GetLatestID(ctx, receptionID).Return(productID, nil)
+				p.EXPECT().DeleteByID(ctx, productID).Return(nil)
+			},
+			wantEvents: []entity.Event{{
+				Type:        entity.EventTypeProductDeleted,
+				PointID:     pointID,
+				ReceptionID: receptionID,
+				ProductID:   &productID,
+			}},
+		},
+		{
+			name: "cannot store event",
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
 				p.EXPECT().GetLatestID(ctx, receptionID).Return(productID, nil)
 				p.EXPECT().DeleteByID(ctx, productID).Return(nil)
 			},
+			outboxErr: arbitraryErr,
+			wantErr:   service.ErrCannotDeleteLastProduct,
 		},
 		{
 			name: "active reception not found",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(uuid.Nil, repository.ErrNotFound)
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(uuid.Nil, repository.ErrNotFound)
 			},
 			wantErr: service.ErrActiveReceptionNotFound,
 		},
 		{
 			name: "cannot get active reception",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(uuid.Nil, arbitraryErr)
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(uuid.Nil, arbitraryErr)
 			},
 			wantErr: service.ErrCannotDeleteLastProduct,
 		},
 		{
 			name: "product not found",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(receptionID, nil)
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
 				p.EXPECT().GetLatestID(ctx, receptionID).Return(uuid.Nil, repository.ErrNotFound)
 			},
 			wantErr: service.ErrProductNotFound,
 		},
 		{
 			name: "cannot get last product",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(receptionID, nil)
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
 				p.EXPECT().GetLatestID(ctx, receptionID).Return(uuid.Nil, arbitraryErr)
 			},
 			wantErr: service.ErrCannotDeleteLastProduct,
 		},
 		{
 			name: "no rows deleted",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(receptionID, nil)
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
 				p.EXPECT().GetLatestID(ctx, receptionID).Return(productID, nil)
 				p.EXPECT().DeleteByID(ctx, productID).Return(repository.ErrNoRowsDeleted)
 			},
@@ -430,8 +942,8 @@ func TestPointService_DeleteLastProduct(t *testing.T) {
 		},
 		{
 			name: "cannot delete last product",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(receptionID, nil)
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
 				p.EXPECT().GetLatestID(ctx, receptionID).Return(productID, nil)
 				p.EXPECT().DeleteByID(ctx, productID).Return(arbitraryErr)
 			},
@@ -446,14 +958,110 @@ func TestPointService_DeleteLastProduct(t *testing.T) {
 			mockProductRepo := repomocks.NewMockProduct(ctrl)
 			mockReceptionRepo := repomocks.NewMockReception(ctrl)
 			mockPointCounter := metricmocks.NewMockCounter(ctrl)
+			outbox := &outboxStub{err: tc.outboxErr}
 
-			tc.mockBehavior(mockProductRepo, mockReceptionRepo)
+			tc.mockBehavior(mockProductRepo, mockReceptionRepo, mockPointRepo)
 
-			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, mockPointCounter)
+			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, trManagerStub{}, outbox, mockPointCounter)
 
 			err := s.DeleteLastProduct(ctx, pointID)
 
 			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantEvents, outbox.events)
+		})
+	}
+}
+
+func TestPointService_DeleteProduct(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		pointID      = uuid.New()
+		receptionID  = uuid.New()
+		productID    = uuid.New()
+	)
+
+	type MockBehavior func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		outboxErr    error
+		wantEvents   []entity.Event
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().DeleteFromReception(ctx, receptionID, productID).Return(nil)
+			},
+			wantEvents: []entity.Event{{
+				Type:        entity.EventTypeProductDeleted,
+				PointID:     pointID,
+				ReceptionID: receptionID,
+				ProductID:   &productID,
+			}},
+		},
+		{
+			name: "cannot store event",
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().DeleteFromReception(ctx, receptionID, productID).Return(nil)
+			},
+			outboxErr: arbitraryErr,
+			wantErr:   service.ErrCannotDeleteProduct,
+		},
+		{
+			name: "active reception not found",
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(uuid.Nil, repository.ErrNotFound)
+			},
+			wantErr: service.ErrActiveReceptionNotFound,
+		},
+		{
+			name: "cannot get active reception",
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(uuid.Nil, arbitraryErr)
+			},
+			wantErr: service.ErrCannotDeleteProduct,
+		},
+		{
+			name: "product not found in active reception",
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().DeleteFromReception(ctx, receptionID, productID).Return(repository.ErrNoRowsDeleted)
+			},
+			wantErr: service.ErrProductNotFound,
+		},
+		{
+			name: "cannot delete product",
+			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, pt *repomocks.MockPoint) {
+				r.EXPECT().GetActiveIDForUpdate(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().DeleteFromReception(ctx, receptionID, productID).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotDeleteProduct,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockPointCounter := metricmocks.NewMockCounter(ctrl)
+			outbox := &outboxStub{err: tc.outboxErr}
+
+			tc.mockBehavior(mockProductRepo, mockReceptionRepo, mockPointRepo)
+
+			s := service.NewPointService(mockPointRepo, mockProductRepo, mockReceptionRepo, trManagerStub{}, outbox, mockPointCounter)
+
+			err := s.DeleteProduct(ctx, pointID, productID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.wantEvents, outbox.events)
 		})
 	}
 }
@@ -4,51 +4,302 @@ import (
 	"context"
 	"errors"
 
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/samber/lo"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/spanwalla/pvz/internal/dto"
 	"github.com/spanwalla/pvz/internal/entity"
 	"github.com/spanwalla/pvz/internal/metrics"
 	"github.com/spanwalla/pvz/internal/repository"
 )
 
 var (
-	ErrCannotCreateProduct = errors.New("cannot create product")
+	ErrCannotCreateProduct      = NewError(CodeInternal, "cannot create product")
+	ErrProductAlreadyScanned    = NewError(CodeProductAlreadyScanned, "product with this item code is already in an open reception")
+	ErrCannotGetProduct         = NewError(CodeInternal, "cannot get product")
+	ErrInvalidProductTransition = NewError(CodeInvalidProductTransition, "invalid product status transition")
+	ErrCannotUpdateProduct      = NewError(CodeInternal, "cannot update product")
+	ErrDuplicateItemCode        = NewError(CodeDuplicateItemCode, "item code is repeated in the batch")
 )
 
 type ProductService struct {
 	productRepo     repository.Product
 	receptionRepo   repository.Reception
+	pointRepo       repository.Point
+	scheduleRepo    repository.Schedule
+	trManager       trm.Manager
+	clock           clockwork.Clock
+	outboxRepo      repository.Outbox
 	productsCreated metrics.Counter
 }
 
-func NewProductService(productRepo repository.Product, receptionRepo repository.Reception, productsCreated metrics.Counter) *ProductService {
+func NewProductService(productRepo repository.Product, receptionRepo repository.Reception, pointRepo repository.Point, scheduleRepo repository.Schedule, trManager trm.Manager, clock clockwork.Clock, outboxRepo repository.Outbox, productsCreated metrics.Counter) *ProductService {
 	return &ProductService{
 		productRepo:     productRepo,
 		receptionRepo:   receptionRepo,
+		pointRepo:       pointRepo,
+		scheduleRepo:    scheduleRepo,
+		trManager:       trManager,
+		clock:           clock,
+		outboxRepo:      outboxRepo,
 		productsCreated: productsCreated,
 	}
 }
 
-func (s *ProductService) Create(ctx context.Context, pointID uuid.UUID, productType entity.ProductType) (entity.Product, error) {
-	receptionID, err := s.receptionRepo.GetActiveID(ctx, pointID)
+func (s *ProductService) Create(ctx context.Context, pointID uuid.UUID, productType entity.ProductType, itemCode string) (entity.Product, error) {
+	point, err := ensurePointActive(ctx, s.pointRepo, pointID)
 	if err != nil {
-		if errors.Is(err, repository.ErrNotFound) {
-			return entity.Product{}, ErrActiveReceptionNotFound
+		if errors.Is(err, ErrPointNotFound) || errors.Is(err, ErrPointSuspended) || errors.Is(err, ErrPointClosed) {
+			return entity.Product{}, err
+		}
+
+		log.Errorf("ProductService.Create - ensurePointActive: %v", err)
+		return entity.Product{}, ErrCannotCreateProduct
+	}
+
+	if err := ensurePointOpen(ctx, s.scheduleRepo, s.clock.Now(), pointID); err != nil {
+		if errors.Is(err, ErrPointNotFound) || errors.Is(err, ErrPointOutsideWorkingHours) {
+			return entity.Product{}, err
 		}
 
-		log.Errorf("ProductService.Create - s.receptionRepo.GetActiveID: %v", err)
+		log.Errorf("ProductService.Create - ensurePointOpen: %v", err)
 		return entity.Product{}, ErrCannotCreateProduct
 	}
 
-	log.Debugf("ProductService.Create - receptionID: %v", receptionID)
+	var product entity.Product
+
+	err = s.trManager.Do(ctx, func(ctx context.Context) error {
+		// Shared lock keeps the reception open until the product is stored, concurrent adds don't block each other
+		receptionID, err := s.receptionRepo.GetActiveIDForShare(ctx, pointID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrActiveReceptionNotFound
+			}
+
+			log.Errorf("ProductService.Create - s.receptionRepo.GetActiveIDForShare: %v", err)
+			return ErrCannotCreateProduct
+		}
+
+		log.Debugf("ProductService.Create - receptionID: %v", receptionID)
+
+		// Item codes of open receptions are unique in the database, concurrent scans of one code can't both pass
+		product, err = s.productRepo.Create(ctx, receptionID, entity.CanonicalProductType(productType), itemCode)
+		if err != nil {
+			switch {
+			case errors.Is(err, repository.ErrNotFound):
+				return ErrProductTypeNotFound
+			case errors.Is(err, repository.ErrAlreadyExists):
+				return ErrProductAlreadyScanned
+			}
 
-	product, err := s.productRepo.Create(ctx, receptionID, productType)
+			log.Errorf("ProductService.Create - s.productRepo.Create: %v", err)
+			return ErrCannotCreateProduct
+		}
+
+		err = s.outboxRepo.Add(ctx, entity.Event{
+			Type:        entity.EventTypeProductAdded,
+			PointID:     pointID,
+			City:        point.City,
+			ReceptionID: receptionID,
+			ProductID:   &product.ID,
+		})
+		if err != nil {
+			log.Errorf("ProductService.Create - s.outboxRepo.Add: %v", err)
+			return ErrCannotCreateProduct
+		}
+
+		return nil
+	})
 	if err != nil {
-		log.Errorf("ProductService.Create - s.productRepo.Create: %v", err)
+		switch {
+		case errors.Is(err, ErrActiveReceptionNotFound):
+			return entity.Product{}, ErrActiveReceptionNotFound
+		case errors.Is(err, ErrProductAlreadyScanned):
+			return entity.Product{}, ErrProductAlreadyScanned
+		case errors.Is(err, ErrProductTypeNotFound):
+			return entity.Product{}, ErrProductTypeNotFound
+		case !errors.Is(err, ErrCannotCreateProduct):
+			log.Errorf("ProductService.Create - s.trManager.Do: %v", err)
+		}
+
 		return entity.Product{}, ErrCannotCreateProduct
 	}
 
 	s.productsCreated.Inc()
+
+	return product, nil
+}
+
+// CreateBatch adds all products to the active reception of the point in one transaction.
+// The reception is locked against closing, so either every product is added or none.
+func (s *ProductService) CreateBatch(ctx context.Context, pointID uuid.UUID, inputs []dto.ProductInput) ([]entity.Product, error) {
+	point, err := ensurePointActive(ctx, s.pointRepo, pointID)
+	if err != nil {
+		if errors.Is(err, ErrPointNotFound) || errors.Is(err, ErrPointSuspended) || errors.Is(err, ErrPointClosed) {
+			return nil, err
+		}
+
+		log.Errorf("ProductService.CreateBatch - ensurePointActive: %v", err)
+		return nil, ErrCannotCreateProduct
+	}
+
+	if err := ensurePointOpen(ctx, s.scheduleRepo, s.clock.Now(), pointID); err != nil {
+		if errors.Is(err, ErrPointNotFound) || errors.Is(err, ErrPointOutsideWorkingHours) {
+			return nil, err
+		}
+
+		log.Errorf("ProductService.CreateBatch - ensurePointOpen: %v", err)
+		return nil, ErrCannotCreateProduct
+	}
+
+	itemCodes := make([]string, len(inputs))
+	canonical := make([]dto.ProductInput, len(inputs))
+	for i, input := range inputs {
+		itemCodes[i] = input.ItemCode
+		canonical[i] = dto.ProductInput{Type: entity.CanonicalProductType(input.Type), ItemCode: input.ItemCode}
+	}
+
+	if len(lo.Uniq(itemCodes)) != len(itemCodes) {
+		return nil, ErrDuplicateItemCode
+	}
+
+	var (
+		receptionID uuid.UUID
+		products    []entity.Product
+	)
+
+	err = s.trManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+
+		receptionID, err = s.receptionRepo.GetActiveIDForShare(ctx, pointID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrActiveReceptionNotFound
+			}
+
+			log.Errorf("ProductService.CreateBatch - s.receptionRepo.GetActiveIDForShare: %v", err)
+			return ErrCannotCreateProduct
+		}
+
+		log.Debugf("ProductService.CreateBatch - receptionID: %v", receptionID)
+
+		products, err = s.productRepo.CreateBatch(ctx, receptionID, canonical)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrProductTypeNotFound
+			}
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				return ErrProductAlreadyScanned
+			}
+
+			log.Errorf("ProductService.CreateBatch - s.productRepo.CreateBatch: %v", err)
+			return ErrCannotCreateProduct
+		}
+
+		added := make([]entity.Event, len(products))
+		for i, product := range products {
+			added[i] = entity.Event{
+				Type:        entity.EventTypeProductAdded,
+				PointID:     pointID,
+				City:        point.City,
+				ReceptionID: receptionID,
+				ProductID:   &product.ID,
+			}
+		}
+
+		if err = s.outboxRepo.Add(ctx, added...); err != nil {
+			log.Errorf("ProductService.CreateBatch - s.outboxRepo.Add: %v", err)
+			return ErrCannotCreateProduct
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrActiveReceptionNotFound):
+			return nil, ErrActiveReceptionNotFound
+		case errors.Is(err, ErrProductAlreadyScanned):
+			return nil, ErrProductAlreadyScanned
+		case errors.Is(err, ErrProductTypeNotFound):
+			return nil, ErrProductTypeNotFound
+		case !errors.Is(err, ErrCannotCreateProduct):
+			log.Errorf("ProductService.CreateBatch - s.trManager.Do: %v", err)
+		}
+
+		return nil, ErrCannotCreateProduct
+	}
+
+	s.productsCreated.Add(float64(len(products)))
+
+	return products, nil
+}
+
+func (s *ProductService) GetByItemCode(ctx context.Context, itemCode string) (dto.ProductLookup, error) {
+	lookup, err := s.productRepo.GetByItemCode(ctx, itemCode)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return dto.ProductLookup{}, ErrProductNotFound
+		}
+
+		log.Errorf("ProductService.GetByItemCode - s.productRepo.GetByItemCode: %v", err)
+		return dto.ProductLookup{}, ErrCannotGetProduct
+	}
+
+	return lookup, nil
+}
+
+func (s *ProductService) GetByID(ctx context.Context, productID uuid.UUID) (entity.Product, error) {
+	product, err := s.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.Product{}, ErrProductNotFound
+		}
+
+		log.Errorf("ProductService.GetByID - s.productRepo.GetByID: %v", err)
+		return entity.Product{}, ErrCannotGetProduct
+	}
+
+	return product, nil
+}
+
+// Issue hands stored product over to the customer
+func (s *ProductService) Issue(ctx context.Context, productID uuid.UUID) (entity.Product, error) {
+	return s.changeStatus(ctx, productID, entity.ProductStatusIssued)
+}
+
+// Return sends stored product back to the sender
+func (s *ProductService) Return(ctx context.Context, productID uuid.UUID) (entity.Product, error) {
+	return s.changeStatus(ctx, productID, entity.ProductStatusReturned)
+}
+
+func (s *ProductService) changeStatus(ctx context.Context, productID uuid.UUID, status entity.ProductStatus) (entity.Product, error) {
+	product, err := s.productRepo.GetByID(ctx, productID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.Product{}, ErrProductNotFound
+		}
+
+		log.Errorf("ProductService.changeStatus - s.productRepo.GetByID: %v", err)
+		return entity.Product{}, ErrCannotUpdateProduct
+	}
+
+	if !product.Status.CanTransitionTo(status) {
+		return entity.Product{}, ErrInvalidProductTransition
+	}
+
+	product, err = s.productRepo.UpdateStatus(ctx, productID, product.Status, status)
+	if err != nil {
+		// Status was changed concurrently, so the transition we checked is no longer valid
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.Product{}, ErrInvalidProductTransition
+		}
+
+		log.Errorf("ProductService.changeStatus - s.productRepo.UpdateStatus: %v", err)
+		return entity.Product{}, ErrCannotUpdateProduct
+	}
+
 	return product, nil
 }
@@ -1,6 +1,7 @@
 package service_test
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"io"
@@ -8,10 +9,12 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/spanwalla/pvz/internal/dto"
 	"github.com/spanwalla/pvz/internal/entity"
 	metricmocks "github.com/spanwalla/pvz/internal/metrics/mocks"
 	"github.com/spanwalla/pvz/internal/repository"
@@ -27,52 +30,184 @@ func TestProductService_Create(t *testing.T) {
 		pointID      = uuid.New()
 		receptionID  = uuid.New()
 		productType  = entity.ProductTypeClothes
+		itemCode     = "4607084350111"
 		timestamp    = time.Now()
+		now          = time.Date(2025, time.May, 9, 12, 0, 0, 0, time.UTC) // 15:00 in Moscow
 	)
 
+	point := entity.Point{
+		ID:        pointID,
+		CreatedAt: timestamp,
+		City:      "Москва",
+		Status:    entity.PointStatusActive,
+	}
+
+	schedule := entity.Schedule{TimeZone: entity.DefaultTimeZone}
+
 	product := entity.Product{
 		ID:          uuid.New(),
 		ReceptionID: receptionID,
 		CreatedAt:   timestamp,
 		Type:        productType,
+		ItemCode:    itemCode,
 	}
 
-	type MockBehavior func(p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter)
+	type MockBehavior func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter)
 
 	for _, tc := range []struct {
 		name         string
+		productType  entity.ProductType
 		mockBehavior MockBehavior
+		outboxErr    error
 		want         entity.Product
+		wantEvents   []entity.Event
 		wantErr      error
 	}{
 		{
 			name: "success",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(receptionID, nil)
-				p.EXPECT().Create(ctx, receptionID, productType).Return(product, nil)
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().Create(ctx, receptionID, productType, itemCode).Return(product, nil)
+				m.EXPECT().Inc()
+			},
+			want: product,
+			wantEvents: []entity.Event{{
+				Type:        entity.EventTypeProductAdded,
+				PointID:     pointID,
+				City:        point.City,
+				ReceptionID: receptionID,
+				ProductID:   &product.ID,
+			}},
+		},
+		{
+			name:        "legacy product type",
+			productType: "одежда",
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().Create(ctx, receptionID, productType, itemCode).Return(product, nil)
 				m.EXPECT().Inc()
 			},
 			want: product,
+			wantEvents: []entity.Event{{
+				Type:        entity.EventTypeProductAdded,
+				PointID:     pointID,
+				City:        point.City,
+				ReceptionID: receptionID,
+				ProductID:   &product.ID,
+			}},
+		},
+		{
+			name: "cannot store event",
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().Create(ctx, receptionID, productType, itemCode).Return(product, nil)
+			},
+			outboxErr: arbitraryErr,
+			wantErr:   service.ErrCannotCreateProduct,
+		},
+		{
+			name: "point not found",
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(entity.Point{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrPointNotFound,
+		},
+		{
+			name: "point suspended",
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(entity.Point{ID: pointID, Status: entity.PointStatusSuspended}, nil)
+			},
+			wantErr: service.ErrPointSuspended,
+		},
+		{
+			name: "point closed",
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(entity.Point{ID: pointID, Status: entity.PointStatusClosed}, nil)
+			},
+			wantErr: service.ErrPointClosed,
+		},
+		{
+			name: "cannot get point",
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(entity.Point{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotCreateProduct,
+		},
+		{
+			name: "closed on holiday",
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(entity.Schedule{
+					TimeZone: entity.DefaultTimeZone,
+					WorkingHours: []entity.WorkingHours{
+						{Weekday: time.Friday, OpensAt: "09:00", ClosesAt: "21:00"},
+					},
+					Holidays: []entity.Holiday{
+						{Date: "2025-05-09"},
+					},
+				}, nil)
+			},
+			wantErr: service.ErrPointOutsideWorkingHours,
+		},
+		{
+			name: "cannot get schedule",
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(entity.Schedule{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotCreateProduct,
 		},
 		{
 			name: "active reception not found",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(uuid.Nil, repository.ErrNotFound)
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(uuid.Nil, repository.ErrNotFound)
 			},
 			wantErr: service.ErrActiveReceptionNotFound,
 		},
 		{
 			name: "cannot get reception id",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(uuid.Nil, arbitraryErr)
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(uuid.Nil, arbitraryErr)
 			},
 			wantErr: service.ErrCannotCreateProduct,
 		},
+		{
+			name: "product already scanned",
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().Create(ctx, receptionID, productType, itemCode).Return(entity.Product{}, repository.ErrAlreadyExists)
+			},
+			wantErr: service.ErrProductAlreadyScanned,
+		},
+		{
+			name: "product type not found",
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().Create(ctx, receptionID, productType, itemCode).Return(entity.Product{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrProductTypeNotFound,
+		},
 		{
 			name: "cannot create product",
-			mockBehavior: func(p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
-				r.EXPECT().GetActiveID(ctx, pointID).Return(receptionID, nil)
-				p.EXPECT().Create(ctx, receptionID, productType).Return(entity.Product{}, arbitraryErr)
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().Create(ctx, receptionID, productType, itemCode).Return(entity.Product{}, arbitraryErr)
 			},
 			wantErr: service.ErrCannotCreateProduct,
 		},
@@ -81,15 +216,515 @@ func TestProductService_Create(t *testing.T) {
 			t.Parallel()
 			ctrl := gomock.NewController(t)
 
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockProductCounter := metricmocks.NewMockCounter(ctrl)
+			outbox := &outboxStub{err: tc.outboxErr}
+
+			tc.mockBehavior(mockPointRepo, mockScheduleRepo, mockProductRepo, mockReceptionRepo, mockProductCounter)
+
+			s := service.NewProductService(mockProductRepo, mockReceptionRepo, mockPointRepo, mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClockAt(now), outbox, mockProductCounter)
+
+			got, err := s.Create(ctx, pointID, cmp.Or(tc.productType, productType), itemCode)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantEvents, outbox.events)
+		})
+	}
+}
+
+func TestProductService_CreateBatch(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		pointID      = uuid.New()
+		receptionID  = uuid.New()
+		now          = time.Date(2025, time.May, 9, 12, 0, 0, 0, time.UTC)
+	)
+
+	point := entity.Point{ID: pointID, City: "Казань", Status: entity.PointStatusActive}
+	schedule := entity.Schedule{TimeZone: entity.DefaultTimeZone}
+
+	inputs := []dto.ProductInput{
+		{Type: entity.ProductTypeElectronics, ItemCode: "RA644000001RU"},
+		{Type: entity.ProductTypeShoes, ItemCode: "RA644000002RU"},
+	}
+
+	products := []entity.Product{
+		{ID: uuid.New(), ReceptionID: receptionID, Type: entity.ProductTypeElectronics, ItemCode: "RA644000001RU", Status: entity.ProductStatusReceived},
+		{ID: uuid.New(), ReceptionID: receptionID, Type: entity.ProductTypeShoes, ItemCode: "RA644000002RU", Status: entity.ProductStatusReceived},
+	}
+
+	added := make([]entity.Event, len(products))
+	for i, product := range products {
+		added[i] = entity.Event{
+			Type:        entity.EventTypeProductAdded,
+			PointID:     pointID,
+			City:        point.City,
+			ReceptionID: receptionID,
+			ProductID:   &product.ID,
+		}
+	}
+
+	type MockBehavior func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter)
+
+	for _, tc := range []struct {
+		name         string
+		inputs       []dto.ProductInput
+		mockBehavior MockBehavior
+		outboxErr    error
+		want         []entity.Product
+		wantEvents   []entity.Event
+		wantErr      error
+	}{
+		{
+			name:   "success",
+			inputs: inputs,
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().CreateBatch(ctx, receptionID, inputs).Return(products, nil)
+				m.EXPECT().Add(float64(2))
+			},
+			want:       products,
+			wantEvents: added,
+		},
+		{
+			name: "legacy product types",
+			inputs: []dto.ProductInput{
+				{Type: "электроника", ItemCode: "RA644000001RU"},
+				{Type: "обувь", ItemCode: "RA644000002RU"},
+			},
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().CreateBatch(ctx, receptionID, inputs).Return(products, nil)
+				m.EXPECT().Add(float64(2))
+			},
+			want:       products,
+			wantEvents: added,
+		},
+		{
+			name:   "point suspended",
+			inputs: inputs,
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(entity.Point{ID: pointID, Status: entity.PointStatusSuspended}, nil)
+			},
+			wantErr: service.ErrPointSuspended,
+		},
+		{
+			name: "duplicate item code in batch",
+			inputs: []dto.ProductInput{
+				{Type: entity.ProductTypeElectronics, ItemCode: "RA644000001RU"},
+				{Type: entity.ProductTypeShoes, ItemCode: "RA644000001RU"},
+			},
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+			},
+			wantErr: service.ErrDuplicateItemCode,
+		},
+		{
+			name:   "reception closed before lock",
+			inputs: inputs,
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(uuid.Nil, repository.ErrNotFound)
+			},
+			wantErr: service.ErrActiveReceptionNotFound,
+		},
+		{
+			name:   "cannot lock reception",
+			inputs: inputs,
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(uuid.Nil, arbitraryErr)
+			},
+			wantErr: service.ErrCannotCreateProduct,
+		},
+		{
+			name:   "product already scanned",
+			inputs: inputs,
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().CreateBatch(ctx, receptionID, inputs).Return(nil, repository.ErrAlreadyExists)
+			},
+			wantErr: service.ErrProductAlreadyScanned,
+		},
+		{
+			name:   "product type not found",
+			inputs: inputs,
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().CreateBatch(ctx, receptionID, inputs).Return(nil, repository.ErrNotFound)
+			},
+			wantErr: service.ErrProductTypeNotFound,
+		},
+		{
+			name:   "cannot create products",
+			inputs: inputs,
+			mockBehavior: func(pt *repomocks.MockPoint, sc *repomocks.MockSchedule, p *repomocks.MockProduct, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().GetActiveIDForShare(ctx, pointID).Return(receptionID, nil)
+				p.EXPECT().CreateBatch(ctx, receptionID, inputs).Return(nil, arbitraryErr)
+			},
+			wantErr: service.ErrCannotCreateProduct,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockProductCounter := metricmocks.NewMockCounter(ctrl)
+			outbox := &outboxStub{err: tc.outboxErr}
+
+			tc.mockBehavior(mockPointRepo, mockScheduleRepo, mockProductRepo, mockReceptionRepo, mockProductCounter)
+
+			s := service.NewProductService(mockProductRepo, mockReceptionRepo, mockPointRepo, mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClockAt(now), outbox, mockProductCounter)
+
+			got, err := s.CreateBatch(ctx, pointID, tc.inputs)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantEvents, outbox.events)
+		})
+	}
+}
+
+func TestProductService_GetByItemCode(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		itemCode     = "RA644000001RU"
+		pointID      = uuid.New()
+		receptionID  = uuid.New()
+	)
+
+	lookup := dto.ProductLookup{
+		Product: entity.Product{
+			ID:          uuid.New(),
+			ReceptionID: receptionID,
+			CreatedAt:   time.Now(),
+			Type:        entity.ProductTypeShoes,
+			ItemCode:    itemCode,
+		},
+		Reception: entity.Reception{
+			ID:        receptionID,
+			PointID:   pointID,
+			CreatedAt: time.Now().Add(-time.Hour),
+			Status:    entity.ReceptionStatusInProgress,
+		},
+		Point: entity.Point{
+			ID:        pointID,
+			CreatedAt: time.Now().Add(-time.Hour * 24),
+			City:      "Казань",
+		},
+	}
+
+	type MockBehavior func(p *repomocks.MockProduct)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         dto.ProductLookup
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByItemCode(ctx, itemCode).Return(lookup, nil)
+			},
+			want: lookup,
+		},
+		{
+			name: "product not found",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByItemCode(ctx, itemCode).Return(dto.ProductLookup{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrProductNotFound,
+		},
+		{
+			name: "cannot get product",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByItemCode(ctx, itemCode).Return(dto.ProductLookup{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotGetProduct,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockProductCounter := metricmocks.NewMockCounter(ctrl)
+
+			tc.mockBehavior(mockProductRepo)
+
+			s := service.NewProductService(mockProductRepo, mockReceptionRepo, mockPointRepo, mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClock(), &outboxStub{}, mockProductCounter)
+
+			got, err := s.GetByItemCode(ctx, itemCode)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestProductService_GetByID(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		productID    = uuid.New()
+	)
+
+	product := entity.Product{
+		ID:          productID,
+		ReceptionID: uuid.New(),
+		CreatedAt:   time.Now(),
+		Type:        entity.ProductTypeClothes,
+		ItemCode:    "RA644000002RU",
+		Status:      entity.ProductStatusStored,
+	}
+
+	type MockBehavior func(p *repomocks.MockProduct)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         entity.Product
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByID(ctx, productID).Return(product, nil)
+			},
+			want: product,
+		},
+		{
+			name: "product not found",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByID(ctx, productID).Return(entity.Product{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrProductNotFound,
+		},
+		{
+			name: "cannot get product",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByID(ctx, productID).Return(entity.Product{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotGetProduct,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockProductCounter := metricmocks.NewMockCounter(ctrl)
+
+			tc.mockBehavior(mockProductRepo)
+
+			s := service.NewProductService(mockProductRepo, mockReceptionRepo, mockPointRepo, mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClock(), &outboxStub{}, mockProductCounter)
+
+			got, err := s.GetByID(ctx, productID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestProductService_Issue(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		productID    = uuid.New()
+	)
+
+	product := entity.Product{
+		ID:          productID,
+		ReceptionID: uuid.New(),
+		CreatedAt:   time.Now().Add(-time.Hour),
+		Type:        entity.ProductTypeElectronics,
+		ItemCode:    "RA644000001RU",
+		Status:      entity.ProductStatusStored,
+	}
+
+	issued := product
+	issued.Status = entity.ProductStatusIssued
+
+	type MockBehavior func(p *repomocks.MockProduct)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         entity.Product
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByID(ctx, productID).Return(product, nil)
+				p.EXPECT().UpdateStatus(ctx, productID, entity.ProductStatusStored, entity.ProductStatusIssued).Return(issued, nil)
+			},
+			want: issued,
+		},
+		{
+			name: "product not found",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByID(ctx, productID).Return(entity.Product{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrProductNotFound,
+		},
+		{
+			name: "product is not stored yet",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				received := product
+				received.Status = entity.ProductStatusReceived
+				p.EXPECT().GetByID(ctx, productID).Return(received, nil)
+			},
+			wantErr: service.ErrInvalidProductTransition,
+		},
+		{
+			name: "product already issued",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByID(ctx, productID).Return(issued, nil)
+			},
+			wantErr: service.ErrInvalidProductTransition,
+		},
+		{
+			name: "status changed concurrently",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByID(ctx, productID).Return(product, nil)
+				p.EXPECT().UpdateStatus(ctx, productID, entity.ProductStatusStored, entity.ProductStatusIssued).Return(entity.Product{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrInvalidProductTransition,
+		},
+		{
+			name: "cannot get product",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByID(ctx, productID).Return(entity.Product{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdateProduct,
+		},
+		{
+			name: "cannot update product",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByID(ctx, productID).Return(product, nil)
+				p.EXPECT().UpdateStatus(ctx, productID, entity.ProductStatusStored, entity.ProductStatusIssued).Return(entity.Product{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdateProduct,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockProductCounter := metricmocks.NewMockCounter(ctrl)
+
+			tc.mockBehavior(mockProductRepo)
+
+			s := service.NewProductService(mockProductRepo, mockReceptionRepo, mockPointRepo, mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClock(), &outboxStub{}, mockProductCounter)
+
+			got, err := s.Issue(ctx, productID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestProductService_Return(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		ctx       = context.Background()
+		productID = uuid.New()
+	)
+
+	product := entity.Product{
+		ID:          productID,
+		ReceptionID: uuid.New(),
+		CreatedAt:   time.Now().Add(-time.Hour),
+		Type:        entity.ProductTypeClothes,
+		ItemCode:    "RA644000002RU",
+		Status:      entity.ProductStatusStored,
+	}
+
+	returned := product
+	returned.Status = entity.ProductStatusReturned
+
+	type MockBehavior func(p *repomocks.MockProduct)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         entity.Product
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				p.EXPECT().GetByID(ctx, productID).Return(product, nil)
+				p.EXPECT().UpdateStatus(ctx, productID, entity.ProductStatusStored, entity.ProductStatusReturned).Return(returned, nil)
+			},
+			want: returned,
+		},
+		{
+			name: "issued product cannot be returned",
+			mockBehavior: func(p *repomocks.MockProduct) {
+				issued := product
+				issued.Status = entity.ProductStatusIssued
+				p.EXPECT().GetByID(ctx, productID).Return(issued, nil)
+			},
+			wantErr: service.ErrInvalidProductTransition,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
 			mockProductRepo := repomocks.NewMockProduct(ctrl)
 			mockReceptionRepo := repomocks.NewMockReception(ctrl)
 			mockProductCounter := metricmocks.NewMockCounter(ctrl)
 
-			tc.mockBehavior(mockProductRepo, mockReceptionRepo, mockProductCounter)
+			tc.mockBehavior(mockProductRepo)
 
-			s := service.NewProductService(mockProductRepo, mockReceptionRepo, mockProductCounter)
+			s := service.NewProductService(mockProductRepo, mockReceptionRepo, mockPointRepo, mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClock(), &outboxStub{}, mockProductCounter)
 
-			got, err := s.Create(ctx, pointID, productType)
+			got, err := s.Return(ctx, productID)
 
 			assert.ErrorIs(t, err, tc.wantErr)
 			assert.Equal(t, tc.want, got)
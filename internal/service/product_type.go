@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+)
+
+var (
+	ErrProductTypeNotFound      = NewError(CodeProductTypeNotFound, "product type not found")
+	ErrProductTypeAlreadyExists = NewError(CodeProductTypeAlreadyExists, "product type already exists")
+	ErrProductTypeNameRequired  = NewError(CodeProductTypeNameRequired, "product type name for default locale is required")
+	ErrCannotCreateProductType  = NewError(CodeInternal, "cannot create product type")
+	ErrCannotGetProductTypes    = NewError(CodeInternal, "cannot get product types")
+	ErrCannotUpdateProductType  = NewError(CodeInternal, "cannot update product type")
+)
+
+type ProductTypeService struct {
+	productTypeRepo repository.ProductType
+}
+
+func NewProductTypeService(productTypeRepo repository.ProductType) *ProductTypeService {
+	return &ProductTypeService{
+		productTypeRepo: productTypeRepo,
+	}
+}
+
+func (s *ProductTypeService) Create(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error) {
+	if _, ok := names[entity.DefaultLocale]; !ok {
+		return entity.ProductTypeEntry{}, ErrProductTypeNameRequired
+	}
+
+	productType, err := s.productTypeRepo.Create(ctx, code, names)
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			return entity.ProductTypeEntry{}, ErrProductTypeAlreadyExists
+		}
+
+		log.Errorf("ProductTypeService.Create - s.productTypeRepo.Create: %v", err)
+		return entity.ProductTypeEntry{}, ErrCannotCreateProductType
+	}
+
+	return productType, nil
+}
+
+func (s *ProductTypeService) GetAll(ctx context.Context, includeInactive bool) ([]entity.ProductTypeEntry, error) {
+	productTypes, err := s.productTypeRepo.GetAll(ctx, includeInactive)
+	if err != nil {
+		log.Errorf("ProductTypeService.GetAll - s.productTypeRepo.GetAll: %v", err)
+		return []entity.ProductTypeEntry{}, ErrCannotGetProductTypes
+	}
+
+	return productTypes, nil
+}
+
+func (s *ProductTypeService) UpdateNames(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error) {
+	if _, ok := names[entity.DefaultLocale]; !ok {
+		return entity.ProductTypeEntry{}, ErrProductTypeNameRequired
+	}
+
+	productType, err := s.productTypeRepo.UpdateNames(ctx, code, names)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.ProductTypeEntry{}, ErrProductTypeNotFound
+		}
+
+		log.Errorf("ProductTypeService.UpdateNames - s.productTypeRepo.UpdateNames: %v", err)
+		return entity.ProductTypeEntry{}, ErrCannotUpdateProductType
+	}
+
+	return productType, nil
+}
+
+func (s *ProductTypeService) SetActive(ctx context.Context, code entity.ProductType, active bool) (entity.ProductTypeEntry, error) {
+	productType, err := s.productTypeRepo.SetActive(ctx, code, active)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.ProductTypeEntry{}, ErrProductTypeNotFound
+		}
+
+		log.Errorf("ProductTypeService.SetActive - s.productTypeRepo.SetActive: %v", err)
+		return entity.ProductTypeEntry{}, ErrCannotUpdateProductType
+	}
+
+	return productType, nil
+}
@@ -0,0 +1,284 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+	repomocks "github.com/spanwalla/pvz/internal/repository/mocks"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+func TestProductTypeService_Create(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		code         = entity.ProductType("cosmetics")
+		names        = map[string]string{"ru": "косметика", "en": "cosmetics"}
+	)
+
+	productType := entity.ProductTypeEntry{
+		ID:        4,
+		Code:      code,
+		Names:     names,
+		IsActive:  true,
+		CreatedAt: time.Now(),
+	}
+
+	type MockBehavior func(p *repomocks.MockProductType)
+
+	for _, tc := range []struct {
+		name         string
+		names        map[string]string
+		mockBehavior MockBehavior
+		want         entity.ProductTypeEntry
+		wantErr      error
+	}{
+		{
+			name:  "success",
+			names: names,
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().Create(ctx, code, names).Return(productType, nil)
+			},
+			want: productType,
+		},
+		{
+			name:         "default locale name is missing",
+			names:        map[string]string{"en": "cosmetics"},
+			mockBehavior: func(p *repomocks.MockProductType) {},
+			wantErr:      service.ErrProductTypeNameRequired,
+		},
+		{
+			name:  "product type already exists",
+			names: names,
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().Create(ctx, code, names).Return(entity.ProductTypeEntry{}, repository.ErrAlreadyExists)
+			},
+			wantErr: service.ErrProductTypeAlreadyExists,
+		},
+		{
+			name:  "cannot create product type",
+			names: names,
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().Create(ctx, code, names).Return(entity.ProductTypeEntry{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotCreateProductType,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockProductTypeRepo := repomocks.NewMockProductType(ctrl)
+
+			tc.mockBehavior(mockProductTypeRepo)
+
+			s := service.NewProductTypeService(mockProductTypeRepo)
+
+			got, err := s.Create(ctx, code, tc.names)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestProductTypeService_GetAll(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+	)
+
+	productTypes := []entity.ProductTypeEntry{
+		{ID: 2, Code: entity.ProductTypeClothes, Names: map[string]string{"ru": "одежда"}, IsActive: true},
+		{ID: 1, Code: entity.ProductTypeElectronics, Names: map[string]string{"ru": "электроника"}, IsActive: true},
+	}
+
+	type MockBehavior func(p *repomocks.MockProductType)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         []entity.ProductTypeEntry
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().GetAll(ctx, false).Return(productTypes, nil)
+			},
+			want: productTypes,
+		},
+		{
+			name: "cannot get product types",
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().GetAll(ctx, false).Return(nil, arbitraryErr)
+			},
+			want:    []entity.ProductTypeEntry{},
+			wantErr: service.ErrCannotGetProductTypes,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockProductTypeRepo := repomocks.NewMockProductType(ctrl)
+
+			tc.mockBehavior(mockProductTypeRepo)
+
+			s := service.NewProductTypeService(mockProductTypeRepo)
+
+			got, err := s.GetAll(ctx, false)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestProductTypeService_UpdateNames(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		code         = entity.ProductTypeShoes
+		names        = map[string]string{"ru": "обувь", "en": "footwear"}
+	)
+
+	productType := entity.ProductTypeEntry{
+		ID:       3,
+		Code:     code,
+		Names:    names,
+		IsActive: true,
+	}
+
+	type MockBehavior func(p *repomocks.MockProductType)
+
+	for _, tc := range []struct {
+		name         string
+		names        map[string]string
+		mockBehavior MockBehavior
+		want         entity.ProductTypeEntry
+		wantErr      error
+	}{
+		{
+			name:  "success",
+			names: names,
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().UpdateNames(ctx, code, names).Return(productType, nil)
+			},
+			want: productType,
+		},
+		{
+			name:         "default locale name is missing",
+			names:        map[string]string{"en": "footwear"},
+			mockBehavior: func(p *repomocks.MockProductType) {},
+			wantErr:      service.ErrProductTypeNameRequired,
+		},
+		{
+			name:  "product type not found",
+			names: names,
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().UpdateNames(ctx, code, names).Return(entity.ProductTypeEntry{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrProductTypeNotFound,
+		},
+		{
+			name:  "cannot update product type",
+			names: names,
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().UpdateNames(ctx, code, names).Return(entity.ProductTypeEntry{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdateProductType,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockProductTypeRepo := repomocks.NewMockProductType(ctrl)
+
+			tc.mockBehavior(mockProductTypeRepo)
+
+			s := service.NewProductTypeService(mockProductTypeRepo)
+
+			got, err := s.UpdateNames(ctx, code, tc.names)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestProductTypeService_SetActive(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		code         = entity.ProductTypeShoes
+	)
+
+	productType := entity.ProductTypeEntry{
+		ID:       3,
+		Code:     code,
+		Names:    map[string]string{"ru": "обувь"},
+		IsActive: false,
+	}
+
+	type MockBehavior func(p *repomocks.MockProductType)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         entity.ProductTypeEntry
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().SetActive(ctx, code, false).Return(productType, nil)
+			},
+			want: productType,
+		},
+		{
+			name: "product type not found",
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().SetActive(ctx, code, false).Return(entity.ProductTypeEntry{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrProductTypeNotFound,
+		},
+		{
+			name: "cannot update product type",
+			mockBehavior: func(p *repomocks.MockProductType) {
+				p.EXPECT().SetActive(ctx, code, false).Return(entity.ProductTypeEntry{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdateProductType,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockProductTypeRepo := repomocks.NewMockProductType(ctrl)
+
+			tc.mockBehavior(mockProductTypeRepo)
+
+			s := service.NewProductTypeService(mockProductTypeRepo)
+
+			got, err := s.SetActive(ctx, code, false)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
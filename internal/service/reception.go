@@ -4,42 +4,216 @@ import (
 	"context"
 	"errors"
 
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/spanwalla/pvz/internal/dto"
 	"github.com/spanwalla/pvz/internal/entity"
 	"github.com/spanwalla/pvz/internal/metrics"
 	"github.com/spanwalla/pvz/internal/repository"
 )
 
 var (
-	ErrReceptionAlreadyOpened = errors.New("reception already opened")
-	ErrCannotCreateReception  = errors.New("cannot create reception")
+	ErrReceptionAlreadyOpened  = NewError(CodeReceptionAlreadyOpened, "reception already opened")
+	ErrCannotCreateReception   = NewError(CodeInternal, "cannot create reception")
+	ErrClosedReceptionNotFound = NewError(CodeClosedReceptionNotFound, "closed reception not found")
+	ErrCannotReopenReception   = NewError(CodeInternal, "cannot reopen reception")
+	ErrReceptionNotFound       = NewError(CodeReceptionNotFound, "reception not found")
+	ErrCannotGetReception      = NewError(CodeInternal, "cannot get reception")
 )
 
 type ReceptionService struct {
 	receptionRepo     repository.Reception
+	productRepo       repository.Product
+	pointRepo         repository.Point
+	scheduleRepo      repository.Schedule
+	trManager         trm.Manager
+	clock             clockwork.Clock
+	outboxRepo        repository.Outbox
 	receptionsCreated metrics.Counter
 }
 
-func NewReceptionService(receptionRepo repository.Reception, receptionsCreated metrics.Counter) *ReceptionService {
+func NewReceptionService(receptionRepo repository.Reception, productRepo repository.Product, pointRepo repository.Point, scheduleRepo repository.Schedule, trManager trm.Manager, clock clockwork.Clock, outboxRepo repository.Outbox, receptionsCreated metrics.Counter) *ReceptionService {
 	return &ReceptionService{
 		receptionRepo:     receptionRepo,
+		productRepo:       productRepo,
+		pointRepo:         pointRepo,
+		scheduleRepo:      scheduleRepo,
+		trManager:         trManager,
+		clock:             clock,
+		outboxRepo:        outboxRepo,
 		receptionsCreated: receptionsCreated,
 	}
 }
 
 func (s *ReceptionService) Create(ctx context.Context, pointID uuid.UUID) (entity.Reception, error) {
-	reception, err := s.receptionRepo.Create(ctx, pointID)
+	point, err := ensurePointActive(ctx, s.pointRepo, pointID)
 	if err != nil {
-		if errors.Is(err, repository.ErrAlreadyExists) {
+		if errors.Is(err, ErrPointNotFound) || errors.Is(err, ErrPointSuspended) || errors.Is(err, ErrPointClosed) {
+			return entity.Reception{}, err
+		}
+
+		log.Errorf("ReceptionService.Create - ensurePointActive: %v", err)
+		return entity.Reception{}, ErrCannotCreateReception
+	}
+
+	if err := ensurePointOpen(ctx, s.scheduleRepo, s.clock.Now(), pointID); err != nil {
+		if errors.Is(err, ErrPointNotFound) || errors.Is(err, ErrPointOutsideWorkingHours) {
+			return entity.Reception{}, err
+		}
+
+		log.Errorf("ReceptionService.Create - ensurePointOpen: %v", err)
+		return entity.Reception{}, ErrCannotCreateReception
+	}
+
+	var reception entity.Reception
+
+	err = s.trManager.Do(ctx, func(ctx context.Context) error {
+		reception, err = s.receptionRepo.Create(ctx, pointID)
+		if err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				return ErrReceptionAlreadyOpened
+			}
+
+			log.Errorf("ReceptionService.Create - s.receptionRepo.Create: %v", err)
+			return ErrCannotCreateReception
+		}
+
+		err = s.outboxRepo.Add(ctx, entity.Event{
+			Type:        entity.EventTypeReceptionCreated,
+			PointID:     pointID,
+			City:        point.City,
+			ReceptionID: reception.ID,
+		})
+		if err != nil {
+			log.Errorf("ReceptionService.Create - s.outboxRepo.Add: %v", err)
+			return ErrCannotCreateReception
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrReceptionAlreadyOpened):
 			return entity.Reception{}, ErrReceptionAlreadyOpened
+		case !errors.Is(err, ErrCannotCreateReception):
+			log.Errorf("ReceptionService.Create - s.trManager.Do: %v", err)
 		}
 
-		log.Errorf("ReceptionService.Create - s.receptionRepo.Create: %v", err)
 		return entity.Reception{}, ErrCannotCreateReception
 	}
 
 	s.receptionsCreated.Inc()
+
+	return reception, nil
+}
+
+// GetByID returns the reception together with all its products
+func (s *ReceptionService) GetByID(ctx context.Context, receptionID uuid.UUID) (dto.ReceptionDetails, error) {
+	reception, err := s.receptionRepo.GetByID(ctx, receptionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return dto.ReceptionDetails{}, ErrReceptionNotFound
+		}
+
+		log.Errorf("ReceptionService.GetByID - s.receptionRepo.GetByID: %v", err)
+		return dto.ReceptionDetails{}, ErrCannotGetReception
+	}
+
+	products, err := s.productRepo.GetByReception(ctx, receptionID)
+	if err != nil {
+		log.Errorf("ReceptionService.GetByID - s.productRepo.GetByReception: %v", err)
+		return dto.ReceptionDetails{}, ErrCannotGetReception
+	}
+
+	return dto.ReceptionDetails{Reception: reception, Products: products}, nil
+}
+
+// GetActive returns the in-progress reception of the point together with its products
+func (s *ReceptionService) GetActive(ctx context.Context, pointID uuid.UUID) (dto.ReceptionDetails, error) {
+	if _, err := s.pointRepo.GetByID(ctx, pointID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return dto.ReceptionDetails{}, ErrPointNotFound
+		}
+
+		log.Errorf("ReceptionService.GetActive - s.pointRepo.GetByID: %v", err)
+		return dto.ReceptionDetails{}, ErrCannotGetReception
+	}
+
+	reception, err := s.receptionRepo.GetActive(ctx, pointID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return dto.ReceptionDetails{}, ErrActiveReceptionNotFound
+		}
+
+		log.Errorf("ReceptionService.GetActive - s.receptionRepo.GetActive: %v", err)
+		return dto.ReceptionDetails{}, ErrCannotGetReception
+	}
+
+	products, err := s.productRepo.GetByReception(ctx, reception.ID)
+	if err != nil {
+		log.Errorf("ReceptionService.GetActive - s.productRepo.GetByReception: %v", err)
+		return dto.ReceptionDetails{}, ErrCannotGetReception
+	}
+
+	return dto.ReceptionDetails{Reception: reception, Products: products}, nil
+}
+
+// Reopen moves closed reception back to `in_progress`, returns its stored products to received
+// and records who approved it and why
+func (s *ReceptionService) Reopen(ctx context.Context, receptionID, moderatorID uuid.UUID, reason string) (entity.Reception, error) {
+	var reception entity.Reception
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+
+		reception, err = s.receptionRepo.Reopen(ctx, receptionID)
+		if err != nil {
+			switch {
+			case errors.Is(err, repository.ErrNotFound):
+				return ErrClosedReceptionNotFound
+			case errors.Is(err, repository.ErrAlreadyExists):
+				return ErrReceptionAlreadyOpened
+			}
+
+			log.Errorf("ReceptionService.Reopen - s.receptionRepo.Reopen: %v", err)
+			return ErrCannotReopenReception
+		}
+
+		err = s.productRepo.UpdateStatusByReception(ctx, receptionID, entity.ProductStatusStored, entity.ProductStatusReceived)
+		if err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				return ErrProductAlreadyScanned
+			}
+
+			log.Errorf("ReceptionService.Reopen - s.productRepo.UpdateStatusByReception: %v", err)
+			return ErrCannotReopenReception
+		}
+
+		_, err = s.receptionRepo.CreateReopening(ctx, receptionID, moderatorID, reason)
+		if err != nil {
+			log.Errorf("ReceptionService.Reopen - s.receptionRepo.CreateReopening: %v", err)
+			return ErrCannotReopenReception
+		}
+
+		return nil
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrClosedReceptionNotFound):
+			return entity.Reception{}, ErrClosedReceptionNotFound
+		case errors.Is(err, ErrReceptionAlreadyOpened):
+			return entity.Reception{}, ErrReceptionAlreadyOpened
+		case errors.Is(err, ErrProductAlreadyScanned):
+			return entity.Reception{}, ErrProductAlreadyScanned
+		case !errors.Is(err, ErrCannotReopenReception):
+			log.Errorf("ReceptionService.Reopen - s.trManager.Do: %v", err)
+		}
+
+		return entity.Reception{}, ErrCannotReopenReception
+	}
+
 	return reception, nil
 }
@@ -8,10 +8,13 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/samber/lo"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 
+	"github.com/spanwalla/pvz/internal/dto"
 	"github.com/spanwalla/pvz/internal/entity"
 	metricmocks "github.com/spanwalla/pvz/internal/metrics/mocks"
 	"github.com/spanwalla/pvz/internal/repository"
@@ -26,8 +29,18 @@ func TestReceptionService_Create(t *testing.T) {
 		ctx          = context.Background()
 		pointID      = uuid.New()
 		timestamp    = time.Now()
+		now          = time.Date(2025, time.May, 5, 3, 0, 0, 0, time.UTC) // Monday, 06:00 in Moscow
 	)
 
+	point := entity.Point{
+		ID:        pointID,
+		CreatedAt: timestamp,
+		City:      "Москва",
+		Status:    entity.PointStatusActive,
+	}
+
+	schedule := entity.Schedule{TimeZone: entity.DefaultTimeZone}
+
 	reception := entity.Reception{
 		ID:        uuid.New(),
 		PointID:   pointID,
@@ -35,32 +48,127 @@ func TestReceptionService_Create(t *testing.T) {
 		Status:    entity.ReceptionStatusInProgress,
 	}
 
-	type MockBehavior func(r *repomocks.MockReception, m *metricmocks.MockCounter)
+	type MockBehavior func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter)
 
 	for _, tc := range []struct {
 		name         string
 		mockBehavior MockBehavior
+		outboxErr    error
 		want         entity.Reception
+		wantEvents   []entity.Event
 		wantErr      error
 	}{
 		{
 			name: "success",
-			mockBehavior: func(r *repomocks.MockReception, m *metricmocks.MockCounter) {
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().Create(ctx, pointID).Return(reception, nil)
+				m.EXPECT().Inc()
+			},
+			want: reception,
+			wantEvents: []entity.Event{{
+				Type:        entity.EventTypeReceptionCreated,
+				PointID:     pointID,
+				City:        point.City,
+				ReceptionID: reception.ID,
+			}},
+		},
+		{
+			name: "cannot store event",
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+				r.EXPECT().Create(ctx, pointID).Return(reception, nil)
+			},
+			outboxErr: arbitraryErr,
+			wantErr:   service.ErrCannotCreateReception,
+		},
+		{
+			name: "point not found",
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(entity.Point{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrPointNotFound,
+		},
+		{
+			name: "point suspended",
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(entity.Point{ID: pointID, Status: entity.PointStatusSuspended}, nil)
+			},
+			wantErr: service.ErrPointSuspended,
+		},
+		{
+			name: "point closed",
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(entity.Point{ID: pointID, Status: entity.PointStatusClosed}, nil)
+			},
+			wantErr: service.ErrPointClosed,
+		},
+		{
+			name: "cannot get point",
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(entity.Point{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotCreateReception,
+		},
+		{
+			name: "outside working hours",
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(entity.Schedule{
+					TimeZone: entity.DefaultTimeZone,
+					WorkingHours: []entity.WorkingHours{
+						{Weekday: time.Monday, OpensAt: "09:00", ClosesAt: "21:00"},
+					},
+				}, nil)
+			},
+			wantErr: service.ErrPointOutsideWorkingHours,
+		},
+		{
+			name: "outside working hours with moderator override",
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(entity.Schedule{
+					TimeZone: entity.DefaultTimeZone,
+					WorkingHours: []entity.WorkingHours{
+						{Weekday: time.Monday, OpensAt: "09:00", ClosesAt: "21:00"},
+					},
+					OverrideUntil: lo.ToPtr(now.Add(time.Hour)),
+				}, nil)
 				r.EXPECT().Create(ctx, pointID).Return(reception, nil)
 				m.EXPECT().Inc()
 			},
 			want: reception,
+			wantEvents: []entity.Event{{
+				Type:        entity.EventTypeReceptionCreated,
+				PointID:     pointID,
+				City:        point.City,
+				ReceptionID: reception.ID,
+			}},
+		},
+		{
+			name: "cannot get schedule",
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(entity.Schedule{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotCreateReception,
 		},
 		{
 			name: "reception already opened",
-			mockBehavior: func(r *repomocks.MockReception, m *metricmocks.MockCounter) {
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
 				r.EXPECT().Create(ctx, pointID).Return(entity.Reception{}, repository.ErrAlreadyExists)
 			},
 			wantErr: service.ErrReceptionAlreadyOpened,
 		},
 		{
 			name: "cannot create reception",
-			mockBehavior: func(r *repomocks.MockReception, m *metricmocks.MockCounter) {
+			mockBehavior: func(p *repomocks.MockPoint, sc *repomocks.MockSchedule, r *repomocks.MockReception, m *metricmocks.MockCounter) {
+				p.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
 				r.EXPECT().Create(ctx, pointID).Return(entity.Reception{}, arbitraryErr)
 			},
 			wantErr: service.ErrCannotCreateReception,
@@ -70,15 +178,314 @@ func TestReceptionService_Create(t *testing.T) {
 			t.Parallel()
 			ctrl := gomock.NewController(t)
 
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
 			mockReceptionRepo := repomocks.NewMockReception(ctrl)
 			mockReceptionCounter := metricmocks.NewMockCounter(ctrl)
+			outbox := &outboxStub{err: tc.outboxErr}
 
-			tc.mockBehavior(mockReceptionRepo, mockReceptionCounter)
+			tc.mockBehavior(mockPointRepo, mockScheduleRepo, mockReceptionRepo, mockReceptionCounter)
 
-			s := service.NewReceptionService(mockReceptionRepo, mockReceptionCounter)
+			s := service.NewReceptionService(mockReceptionRepo, mockProductRepo, mockPointRepo, mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClockAt(now), outbox, mockReceptionCounter)
 
 			got, err := s.Create(ctx, pointID)
 
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.wantEvents, outbox.events)
+		})
+	}
+}
+
+func TestReceptionService_GetByID(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		receptionID  = uuid.New()
+	)
+
+	reception := entity.Reception{
+		ID:        receptionID,
+		PointID:   uuid.New(),
+		CreatedAt: time.Now(),
+		Status:    entity.ReceptionStatusClosed,
+	}
+
+	products := []entity.Product{
+		{ID: uuid.New(), ReceptionID: receptionID, Type: entity.ProductTypeShoes, ItemCode: "RA644000001RU", Status: entity.ProductStatusStored},
+		{ID: uuid.New(), ReceptionID: receptionID, Type: entity.ProductTypeClothes, ItemCode: "RA644000002RU", Status: entity.ProductStatusIssued},
+	}
+
+	type MockBehavior func(r *repomocks.MockReception, p *repomocks.MockProduct)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         dto.ReceptionDetails
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().GetByID(ctx, receptionID).Return(reception, nil)
+				p.EXPECT().GetByReception(ctx, receptionID).Return(products, nil)
+			},
+			want: dto.ReceptionDetails{Reception: reception, Products: products},
+		},
+		{
+			name: "reception not found",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().GetByID(ctx, receptionID).Return(entity.Reception{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrReceptionNotFound,
+		},
+		{
+			name: "cannot get reception",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().GetByID(ctx, receptionID).Return(entity.Reception{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotGetReception,
+		},
+		{
+			name: "cannot get products",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().GetByID(ctx, receptionID).Return(reception, nil)
+				p.EXPECT().GetByReception(ctx, receptionID).Return(nil, arbitraryErr)
+			},
+			wantErr: service.ErrCannotGetReception,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockReceptionCounter := metricmocks.NewMockCounter(ctrl)
+
+			tc.mockBehavior(mockReceptionRepo, mockProductRepo)
+
+			s := service.NewReceptionService(mockReceptionRepo, mockProductRepo, mockPointRepo, mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClock(), &outboxStub{}, mockReceptionCounter)
+
+			got, err := s.GetByID(ctx, receptionID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestReceptionService_GetActive(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		pointID      = uuid.New()
+		receptionID  = uuid.New()
+	)
+
+	point := entity.Point{ID: pointID, City: "Москва", Status: entity.PointStatusActive}
+
+	reception := entity.Reception{
+		ID:        receptionID,
+		PointID:   pointID,
+		CreatedAt: time.Now(),
+		Status:    entity.ReceptionStatusInProgress,
+	}
+
+	products := []entity.Product{
+		{ID: uuid.New(), ReceptionID: receptionID, Type: entity.ProductTypeElectronics, ItemCode: "RA644000003RU", Status: entity.ProductStatusReceived},
+	}
+
+	type MockBehavior func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         dto.ReceptionDetails
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				r.EXPECT().GetActive(ctx, pointID).Return(reception, nil)
+				p.EXPECT().GetByReception(ctx, receptionID).Return(products, nil)
+			},
+			want: dto.ReceptionDetails{Reception: reception, Products: products},
+		},
+		{
+			name: "point not found",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(entity.Point{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrPointNotFound,
+		},
+		{
+			name: "cannot get point",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(entity.Point{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotGetReception,
+		},
+		{
+			name: "active reception not found",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				r.EXPECT().GetActive(ctx, pointID).Return(entity.Reception{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrActiveReceptionNotFound,
+		},
+		{
+			name: "cannot get active reception",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				r.EXPECT().GetActive(ctx, pointID).Return(entity.Reception{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotGetReception,
+		},
+		{
+			name: "cannot get products",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct, pt *repomocks.MockPoint) {
+				pt.EXPECT().GetByID(ctx, pointID).Return(point, nil)
+				r.EXPECT().GetActive(ctx, pointID).Return(reception, nil)
+				p.EXPECT().GetByReception(ctx, receptionID).Return(nil, arbitraryErr)
+			},
+			wantErr: service.ErrCannotGetReception,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockReceptionCounter := metricmocks.NewMockCounter(ctrl)
+
+			tc.mockBehavior(mockReceptionRepo, mockProductRepo, mockPointRepo)
+
+			s := service.NewReceptionService(mockReceptionRepo, mockProductRepo, mockPointRepo, mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClock(), &outboxStub{}, mockReceptionCounter)
+
+			got, err := s.GetActive(ctx, pointID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestReceptionService_Reopen(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		receptionID  = uuid.New()
+		moderatorID  = uuid.New()
+		reason       = "truck was closed too early"
+		now          = time.Now()
+	)
+
+	reception := entity.Reception{
+		ID:        receptionID,
+		PointID:   uuid.New(),
+		CreatedAt: time.Now().Add(-time.Hour),
+		Status:    entity.ReceptionStatusInProgress,
+	}
+
+	reopening := entity.ReceptionReopening{
+		ID:          uuid.New(),
+		ReceptionID: receptionID,
+		ReopenedBy:  moderatorID,
+		Reason:      reason,
+		ReopenedAt:  time.Now(),
+	}
+
+	type MockBehavior func(r *repomocks.MockReception, p *repomocks.MockProduct)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         entity.Reception
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().Reopen(ctx, receptionID).Return(reception, nil)
+				p.EXPECT().UpdateStatusByReception(ctx, receptionID, entity.ProductStatusStored, entity.ProductStatusReceived).Return(nil)
+				r.EXPECT().CreateReopening(ctx, receptionID, moderatorID, reason).Return(reopening, nil)
+			},
+			want: reception,
+		},
+		{
+			name: "closed reception not found",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().Reopen(ctx, receptionID).Return(entity.Reception{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrClosedReceptionNotFound,
+		},
+		{
+			name: "another reception already opened",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().Reopen(ctx, receptionID).Return(entity.Reception{}, repository.ErrAlreadyExists)
+			},
+			wantErr: service.ErrReceptionAlreadyOpened,
+		},
+		{
+			name: "cannot reopen reception",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().Reopen(ctx, receptionID).Return(entity.Reception{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotReopenReception,
+		},
+		{
+			name: "cannot return products to reception",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().Reopen(ctx, receptionID).Return(reception, nil)
+				p.EXPECT().UpdateStatusByReception(ctx, receptionID, entity.ProductStatusStored, entity.ProductStatusReceived).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotReopenReception,
+		},
+		{
+			name: "product scanned into another open reception",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().Reopen(ctx, receptionID).Return(reception, nil)
+				p.EXPECT().UpdateStatusByReception(ctx, receptionID, entity.ProductStatusStored, entity.ProductStatusReceived).Return(repository.ErrAlreadyExists)
+			},
+			wantErr: service.ErrProductAlreadyScanned,
+		},
+		{
+			name: "cannot record reopening",
+			mockBehavior: func(r *repomocks.MockReception, p *repomocks.MockProduct) {
+				r.EXPECT().Reopen(ctx, receptionID).Return(reception, nil)
+				p.EXPECT().UpdateStatusByReception(ctx, receptionID, entity.ProductStatusStored, entity.ProductStatusReceived).Return(nil)
+				r.EXPECT().CreateReopening(ctx, receptionID, moderatorID, reason).Return(entity.ReceptionReopening{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotReopenReception,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockProductRepo := repomocks.NewMockProduct(ctrl)
+			mockPointRepo := repomocks.NewMockPoint(ctrl)
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+			mockReceptionRepo := repomocks.NewMockReception(ctrl)
+			mockReceptionCounter := metricmocks.NewMockCounter(ctrl)
+
+			tc.mockBehavior(mockReceptionRepo, mockProductRepo)
+
+			s := service.NewReceptionService(mockReceptionRepo, mockProductRepo, mockPointRepo, mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClockAt(now), &outboxStub{}, mockReceptionCounter)
+
+			got, err := s.Reopen(ctx, receptionID, moderatorID, reason)
+
 			assert.ErrorIs(t, err, tc.wantErr)
 			assert.Equal(t, tc.want, got)
 		})
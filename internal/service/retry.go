@@ -0,0 +1,37 @@
+package service
+
+import (
+	"time"
+	"unicode/utf8"
+)
+
+// maxErrorLength limits the stored reason of a failed attempt
+const maxErrorLength = 512
+
+// retryDelay doubles the base delay after every failed attempt up to the max delay
+func retryDelay(base, maxDelay time.Duration, attempts int) time.Duration {
+	delay := base
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+
+	return min(delay, maxDelay)
+}
+
+// failureReason returns the error message cut to maxErrorLength bytes on a rune boundary, the database rejects invalid UTF-8
+func failureReason(err error) string {
+	message := err.Error()
+	if len(message) <= maxErrorLength {
+		return message
+	}
+
+	end := maxErrorLength
+	for end > 0 && !utf8.RuneStart(message[end]) {
+		end--
+	}
+
+	return message[:end]
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/avito-tech/go-transaction-manager/trm/v2"
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+)
+
+var (
+	ErrInvalidTimeZone          = NewError(CodeInvalidTimeZone, "invalid time zone")
+	ErrInvalidSchedule          = NewError(CodeInvalidSchedule, "invalid schedule")
+	ErrInvalidOverride          = NewError(CodeInvalidOverride, "override must end in the future")
+	ErrCannotGetSchedule        = NewError(CodeInternal, "cannot get schedule")
+	ErrCannotUpdateSchedule     = NewError(CodeInternal, "cannot update schedule")
+	ErrPointOutsideWorkingHours = NewError(CodePointOutsideWorkingHours, "point is outside working hours")
+)
+
+type ScheduleService struct {
+	scheduleRepo repository.Schedule
+	trManager    trm.Manager
+	clock        clockwork.Clock
+}
+
+func NewScheduleService(scheduleRepo repository.Schedule, trManager trm.Manager, clock clockwork.Clock) *ScheduleService {
+	return &ScheduleService{
+		scheduleRepo: scheduleRepo,
+		trManager:    trManager,
+		clock:        clock,
+	}
+}
+
+func (s *ScheduleService) Get(ctx context.Context, pointID uuid.UUID) (entity.Schedule, error) {
+	schedule, err := s.scheduleRepo.Get(ctx, pointID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.Schedule{}, ErrPointNotFound
+		}
+
+		log.Errorf("ScheduleService.Get - s.scheduleRepo.Get: %v", err)
+		return entity.Schedule{}, ErrCannotGetSchedule
+	}
+
+	return schedule, nil
+}
+
+// Update replaces time zone, weekly working hours and holiday exceptions of the point
+func (s *ScheduleService) Update(ctx context.Context, pointID uuid.UUID, schedule entity.Schedule) (entity.Schedule, error) {
+	if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+		return entity.Schedule{}, ErrInvalidTimeZone
+	}
+
+	if !validWorkingHours(schedule.WorkingHours) || !validHolidays(schedule.Holidays) {
+		return entity.Schedule{}, ErrInvalidSchedule
+	}
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		if err := s.scheduleRepo.UpdateTimeZone(ctx, pointID, schedule.TimeZone); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return ErrPointNotFound
+			}
+
+			log.Errorf("ScheduleService.Update - s.scheduleRepo.UpdateTimeZone: %v", err)
+			return ErrCannotUpdateSchedule
+		}
+
+		if err := s.scheduleRepo.ReplaceWorkingHours(ctx, pointID, schedule.WorkingHours); err != nil {
+			log.Errorf("ScheduleService.Update - s.scheduleRepo.ReplaceWorkingHours: %v", err)
+			return ErrCannotUpdateSchedule
+		}
+
+		if err := s.scheduleRepo.ReplaceHolidays(ctx, pointID, schedule.Holidays); err != nil {
+			log.Errorf("ScheduleService.Update - s.scheduleRepo.ReplaceHolidays: %v", err)
+			return ErrCannotUpdateSchedule
+		}
+
+		return nil
+	})
+	if err != nil {
+		return entity.Schedule{}, err
+	}
+
+	return s.Get(ctx, pointID)
+}
+
+// SetOverride lets employees open receptions and add products outside working hours until the given moment
+func (s *ScheduleService) SetOverride(ctx context.Context, pointID uuid.UUID, until time.Time) (entity.Schedule, error) {
+	if !until.After(s.clock.Now()) {
+		return entity.Schedule{}, ErrInvalidOverride
+	}
+
+	return s.setOverride(ctx, pointID, &until)
+}
+
+func (s *ScheduleService) ClearOverride(ctx context.Context, pointID uuid.UUID) (entity.Schedule, error) {
+	return s.setOverride(ctx, pointID, nil)
+}
+
+func (s *ScheduleService) setOverride(ctx context.Context, pointID uuid.UUID, until *time.Time) (entity.Schedule, error) {
+	if err := s.scheduleRepo.SetOverride(ctx, pointID, until); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.Schedule{}, ErrPointNotFound
+		}
+
+		log.Errorf("ScheduleService.setOverride - s.scheduleRepo.SetOverride: %v", err)
+		return entity.Schedule{}, ErrCannotUpdateSchedule
+	}
+
+	return s.Get(ctx, pointID)
+}
+
+func validWorkingHours(workingHours []entity.WorkingHours) bool {
+	seen := make(map[time.Weekday]struct{}, len(workingHours))
+	for _, hours := range workingHours {
+		if hours.Weekday < time.Sunday || hours.Weekday > time.Saturday {
+			return false
+		}
+
+		if _, ok := seen[hours.Weekday]; ok {
+			return false
+		}
+		seen[hours.Weekday] = struct{}{}
+
+		if !validInterval(hours.OpensAt, hours.ClosesAt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validHolidays(holidays []entity.Holiday) bool {
+	seen := make(map[string]struct{}, len(holidays))
+	for _, holiday := range holidays {
+		if _, err := time.Parse(entity.DateLayout, holiday.Date); err != nil {
+			return false
+		}
+
+		if _, ok := seen[holiday.Date]; ok {
+			return false
+		}
+		seen[holiday.Date] = struct{}{}
+
+		if holiday.OpensAt == nil && holiday.ClosesAt == nil {
+			continue
+		}
+
+		if holiday.OpensAt == nil || holiday.ClosesAt == nil || !validInterval(*holiday.OpensAt, *holiday.ClosesAt) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func validInterval(opensAt, closesAt string) bool {
+	opens, err := time.Parse(entity.TimeOfDayLayout, opensAt)
+	if err != nil {
+		return false
+	}
+
+	closes, err := time.Parse(entity.TimeOfDayLayout, closesAt)
+	if err != nil {
+		return false
+	}
+
+	return opens.Before(closes)
+}
+
+// ensurePointOpen returns nil only if point schedule allows operations at the given moment
+func ensurePointOpen(ctx context.Context, scheduleRepo repository.Schedule, now time.Time, pointID uuid.UUID) error {
+	schedule, err := scheduleRepo.Get(ctx, pointID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrPointNotFound
+		}
+
+		return err
+	}
+
+	open, err := schedule.IsOpen(now)
+	if err != nil {
+		return err
+	}
+
+	if !open {
+		return ErrPointOutsideWorkingHours
+	}
+
+	return nil
+}
@@ -0,0 +1,287 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+	repomocks "github.com/spanwalla/pvz/internal/repository/mocks"
+	"github.com/spanwalla/pvz/internal/service"
+)
+
+func TestScheduleService_Get(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		pointID      = uuid.New()
+	)
+
+	schedule := entity.Schedule{
+		TimeZone: "Europe/Samara",
+		WorkingHours: []entity.WorkingHours{
+			{Weekday: time.Monday, OpensAt: "09:00", ClosesAt: "21:00"},
+		},
+		Holidays: []entity.Holiday{
+			{Date: "2025-06-12"},
+		},
+	}
+
+	type MockBehavior func(sc *repomocks.MockSchedule)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		want         entity.Schedule
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(sc *repomocks.MockSchedule) {
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+			},
+			want: schedule,
+		},
+		{
+			name: "point not found",
+			mockBehavior: func(sc *repomocks.MockSchedule) {
+				sc.EXPECT().Get(ctx, pointID).Return(entity.Schedule{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrPointNotFound,
+		},
+		{
+			name: "cannot get schedule",
+			mockBehavior: func(sc *repomocks.MockSchedule) {
+				sc.EXPECT().Get(ctx, pointID).Return(entity.Schedule{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotGetSchedule,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+
+			tc.mockBehavior(mockScheduleRepo)
+
+			s := service.NewScheduleService(mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClock())
+
+			got, err := s.Get(ctx, pointID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestScheduleService_Update(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		pointID      = uuid.New()
+	)
+
+	schedule := entity.Schedule{
+		TimeZone: "Asia/Novosibirsk",
+		WorkingHours: []entity.WorkingHours{
+			{Weekday: time.Monday, OpensAt: "09:00", ClosesAt: "21:00"},
+			{Weekday: time.Saturday, OpensAt: "10:00", ClosesAt: "18:00"},
+		},
+		Holidays: []entity.Holiday{
+			{Date: "2025-05-01"},
+			{Date: "2025-05-09", OpensAt: lo.ToPtr("12:00"), ClosesAt: lo.ToPtr("16:00")},
+		},
+	}
+
+	type MockBehavior func(sc *repomocks.MockSchedule)
+
+	for _, tc := range []struct {
+		name         string
+		input        entity.Schedule
+		mockBehavior MockBehavior
+		want         entity.Schedule
+		wantErr      error
+	}{
+		{
+			name:  "success",
+			input: schedule,
+			mockBehavior: func(sc *repomocks.MockSchedule) {
+				sc.EXPECT().UpdateTimeZone(ctx, pointID, schedule.TimeZone).Return(nil)
+				sc.EXPECT().ReplaceWorkingHours(ctx, pointID, schedule.WorkingHours).Return(nil)
+				sc.EXPECT().ReplaceHolidays(ctx, pointID, schedule.Holidays).Return(nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+			},
+			want: schedule,
+		},
+		{
+			name:         "invalid time zone",
+			input:        entity.Schedule{TimeZone: "Europe/Atlantis"},
+			mockBehavior: func(sc *repomocks.MockSchedule) {},
+			wantErr:      service.ErrInvalidTimeZone,
+		},
+		{
+			name: "duplicate weekday",
+			input: entity.Schedule{
+				TimeZone: schedule.TimeZone,
+				WorkingHours: []entity.WorkingHours{
+					{Weekday: time.Monday, OpensAt: "09:00", ClosesAt: "13:00"},
+					{Weekday: time.Monday, OpensAt: "14:00", ClosesAt: "21:00"},
+				},
+			},
+			mockBehavior: func(sc *repomocks.MockSchedule) {},
+			wantErr:      service.ErrInvalidSchedule,
+		},
+		{
+			name: "closes before opens",
+			input: entity.Schedule{
+				TimeZone: schedule.TimeZone,
+				WorkingHours: []entity.WorkingHours{
+					{Weekday: time.Friday, OpensAt: "21:00", ClosesAt: "09:00"},
+				},
+			},
+			mockBehavior: func(sc *repomocks.MockSchedule) {},
+			wantErr:      service.ErrInvalidSchedule,
+		},
+		{
+			name: "holiday without closing time",
+			input: entity.Schedule{
+				TimeZone: schedule.TimeZone,
+				Holidays: []entity.Holiday{
+					{Date: "2025-05-09", OpensAt: lo.ToPtr("12:00")},
+				},
+			},
+			mockBehavior: func(sc *repomocks.MockSchedule) {},
+			wantErr:      service.ErrInvalidSchedule,
+		},
+		{
+			name:  "point not found",
+			input: schedule,
+			mockBehavior: func(sc *repomocks.MockSchedule) {
+				sc.EXPECT().UpdateTimeZone(ctx, pointID, schedule.TimeZone).Return(repository.ErrNotFound)
+			},
+			wantErr: service.ErrPointNotFound,
+		},
+		{
+			name:  "cannot replace working hours",
+			input: schedule,
+			mockBehavior: func(sc *repomocks.MockSchedule) {
+				sc.EXPECT().UpdateTimeZone(ctx, pointID, schedule.TimeZone).Return(nil)
+				sc.EXPECT().ReplaceWorkingHours(ctx, pointID, schedule.WorkingHours).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdateSchedule,
+		},
+		{
+			name:  "cannot replace holidays",
+			input: schedule,
+			mockBehavior: func(sc *repomocks.MockSchedule) {
+				sc.EXPECT().UpdateTimeZone(ctx, pointID, schedule.TimeZone).Return(nil)
+				sc.EXPECT().ReplaceWorkingHours(ctx, pointID, schedule.WorkingHours).Return(nil)
+				sc.EXPECT().ReplaceHolidays(ctx, pointID, schedule.Holidays).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdateSchedule,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+
+			tc.mockBehavior(mockScheduleRepo)
+
+			s := service.NewScheduleService(mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClock())
+
+			got, err := s.Update(ctx, pointID, tc.input)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestScheduleService_SetOverride(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		pointID      = uuid.New()
+		now          = time.Date(2025, time.May, 5, 20, 0, 0, 0, time.UTC)
+		until        = now.Add(2 * time.Hour)
+	)
+
+	schedule := entity.Schedule{
+		TimeZone:      entity.DefaultTimeZone,
+		OverrideUntil: &until,
+	}
+
+	type MockBehavior func(sc *repomocks.MockSchedule)
+
+	for _, tc := range []struct {
+		name         string
+		until        time.Time
+		mockBehavior MockBehavior
+		want         entity.Schedule
+		wantErr      error
+	}{
+		{
+			name:  "success",
+			until: until,
+			mockBehavior: func(sc *repomocks.MockSchedule) {
+				sc.EXPECT().SetOverride(ctx, pointID, &until).Return(nil)
+				sc.EXPECT().Get(ctx, pointID).Return(schedule, nil)
+			},
+			want: schedule,
+		},
+		{
+			name:         "override in the past",
+			until:        now.Add(-time.Minute),
+			mockBehavior: func(sc *repomocks.MockSchedule) {},
+			wantErr:      service.ErrInvalidOverride,
+		},
+		{
+			name:  "point not found",
+			until: until,
+			mockBehavior: func(sc *repomocks.MockSchedule) {
+				sc.EXPECT().SetOverride(ctx, pointID, &until).Return(repository.ErrNotFound)
+			},
+			wantErr: service.ErrPointNotFound,
+		},
+		{
+			name:  "cannot set override",
+			until: until,
+			mockBehavior: func(sc *repomocks.MockSchedule) {
+				sc.EXPECT().SetOverride(ctx, pointID, &until).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotUpdateSchedule,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockScheduleRepo := repomocks.NewMockSchedule(ctrl)
+
+			tc.mockBehavior(mockScheduleRepo)
+
+			s := service.NewScheduleService(mockScheduleRepo, trManagerStub{}, clockwork.NewFakeClockAt(now))
+
+			got, err := s.SetOverride(ctx, pointID, tc.until)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
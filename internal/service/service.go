@@ -10,9 +10,11 @@ import (
 
 	"github.com/spanwalla/pvz/internal/dto"
 	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/events"
 	"github.com/spanwalla/pvz/internal/metrics"
 	"github.com/spanwalla/pvz/internal/repository"
 	"github.com/spanwalla/pvz/pkg/hasher"
+	"github.com/spanwalla/pvz/pkg/jwtkeys"
 )
 
 //go:generate go tool mockgen -source=service.go -destination=mocks/mock_service.go -package=mocks
@@ -28,51 +30,146 @@ type RegisterOutput struct {
 	Role  entity.RoleType
 }
 
+// TokenPair is issued on login and on every refresh, the refresh token can be used only once
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
+
 type Auth interface {
 	DummyLogin(ctx context.Context, role entity.RoleType) (string, error)
-	Login(ctx context.Context, email, password string) (string, error)
+	Login(ctx context.Context, email, password string) (TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (TokenPair, error)
+	Logout(ctx context.Context, claims *entity.TokenClaims, refreshToken string) error
+	RevokeUserSessions(ctx context.Context, userID uuid.UUID) error
 	Register(ctx context.Context, email, password string, role entity.RoleType) (RegisterOutput, error)
-	ParseToken(token string) (*entity.TokenClaims, error)
+	ParseToken(ctx context.Context, token string) (*entity.TokenClaims, error)
+	JWKS(ctx context.Context) []jwtkeys.JWK
+	// Run deletes expired tokens until ctx is done
+	Run(ctx context.Context)
+}
+
+type City interface {
+	Create(ctx context.Context, name string) (entity.City, error)
+	GetAll(ctx context.Context, includeInactive bool) ([]entity.City, error)
+	Rename(ctx context.Context, cityID int, name string) (entity.City, error)
+	Deactivate(ctx context.Context, cityID int) (entity.City, error)
+}
+
+type Idempotency interface {
+	Begin(ctx context.Context, userID uuid.UUID, key, requestHash string) (*entity.IdempotentResponse, error)
+	Complete(ctx context.Context, userID uuid.UUID, key string, response entity.IdempotentResponse) error
+	Release(ctx context.Context, userID uuid.UUID, key string)
+	// Run deletes expired keys until ctx is done
+	Run(ctx context.Context)
 }
 
 type Point interface {
-	Create(ctx context.Context, city string) (entity.Point, error)
-	GetAll(ctx context.Context) ([]entity.Point, error)
-	GetExtended(ctx context.Context, start, end *time.Time, pagePtr, limitPtr *int) ([]dto.PointOutput, error)
+	Create(ctx context.Context, point entity.Point) (entity.Point, error)
+	GetAll(ctx context.Context, status *entity.PointStatus) ([]entity.Point, error)
+	GetByID(ctx context.Context, pointID uuid.UUID) (entity.Point, error)
+	GetNearby(ctx context.Context, latitude, longitude, radius float64, limitPtr *int) ([]dto.NearbyPoint, error)
+	GetExtended(ctx context.Context, filter dto.PointFilter, pagination dto.Pagination) (dto.PointPage, error)
+	ChangeStatus(ctx context.Context, pointID uuid.UUID, status entity.PointStatus) (entity.Point, error)
 	CloseLastReception(ctx context.Context, pointID uuid.UUID) (entity.Reception, error)
 	DeleteLastProduct(ctx context.Context, pointID uuid.UUID) error
+	DeleteProduct(ctx context.Context, pointID, productID uuid.UUID) error
 }
 
 type Product interface {
-	Create(ctx context.Context, pointID uuid.UUID, productType entity.ProductType) (entity.Product, error)
+	Create(ctx context.Context, pointID uuid.UUID, productType entity.ProductType, itemCode string) (entity.Product, error)
+	CreateBatch(ctx context.Context, pointID uuid.UUID, inputs []dto.ProductInput) ([]entity.Product, error)
+	GetByItemCode(ctx context.Context, itemCode string) (dto.ProductLookup, error)
+	GetByID(ctx context.Context, productID uuid.UUID) (entity.Product, error)
+	Issue(ctx context.Context, productID uuid.UUID) (entity.Product, error)
+	Return(ctx context.Context, productID uuid.UUID) (entity.Product, error)
+}
+
+type ProductType interface {
+	Create(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error)
+	GetAll(ctx context.Context, includeInactive bool) ([]entity.ProductTypeEntry, error)
+	UpdateNames(ctx context.Context, code entity.ProductType, names map[string]string) (entity.ProductTypeEntry, error)
+	SetActive(ctx context.Context, code entity.ProductType, active bool) (entity.ProductTypeEntry, error)
 }
 
 type Reception interface {
 	Create(ctx context.Context, pointID uuid.UUID) (entity.Reception, error)
+	GetByID(ctx context.Context, receptionID uuid.UUID) (dto.ReceptionDetails, error)
+	GetActive(ctx context.Context, pointID uuid.UUID) (dto.ReceptionDetails, error)
+	Reopen(ctx context.Context, receptionID, moderatorID uuid.UUID, reason string) (entity.Reception, error)
+}
+
+type Event interface {
+	Subscribe(ctx context.Context, filter dto.EventFilter) <-chan entity.Event
+}
+
+type Schedule interface {
+	Get(ctx context.Context, pointID uuid.UUID) (entity.Schedule, error)
+	Update(ctx context.Context, pointID uuid.UUID, schedule entity.Schedule) (entity.Schedule, error)
+	SetOverride(ctx context.Context, pointID uuid.UUID, until time.Time) (entity.Schedule, error)
+	ClearOverride(ctx context.Context, pointID uuid.UUID) (entity.Schedule, error)
+}
+
+type Outbox interface {
+	// Run relays stored events and deletes finished ones until ctx is done
+	Run(ctx context.Context)
+}
+
+type Webhook interface {
+	Create(ctx context.Context, webhook entity.Webhook) (entity.Webhook, error)
+	GetAll(ctx context.Context) ([]entity.Webhook, error)
+	Delete(ctx context.Context, webhookID uuid.UUID) error
+	GetDeliveries(ctx context.Context, webhookID uuid.UUID, status *entity.WebhookDeliveryStatus, limitPtr *int) ([]entity.WebhookDelivery, error)
+	// Run delivers queued webhooks until ctx is done
+	Run(ctx context.Context)
 }
 
 type Services struct {
 	Auth
+	City
+	Event
+	Idempotency
+	Outbox
 	Point
 	Product
+	ProductType
 	Reception
+	Schedule
+	Webhook
 }
 
 type Dependencies struct {
 	Repos          *repository.Repositories
 	Counters       *metrics.Counters
+	Events         *events.Bus
+	EventSink      events.Publisher
 	Transaction    *manager.Manager
 	PasswordHasher hasher.PasswordHasher
 	Clock          clockwork.Clock
-	SecretKey      string
-	TokenTTL       time.Duration
+	Idempotency    IdempotencySettings
+	Auth           AuthSettings
+	Outbox         OutboxSettings
+	Webhooks       WebhookSettings
 }
 
 func New(deps Dependencies) *Services {
+	webhook := NewWebhookService(deps.Repos.Webhook, deps.Clock, deps.Webhooks)
+	sinks := []OutboxSink{
+		{Name: OutboxSinkWebhooks, Publisher: webhook},
+		{Name: OutboxSinkEvents, Publisher: deps.EventSink},
+	}
+
 	return &Services{
-		Auth:      NewAuthService(deps.Repos.User, deps.PasswordHasher, deps.Clock, deps.SecretKey, deps.TokenTTL),
-		Point:     NewPointService(deps.Repos.Point, deps.Repos.Product, deps.Repos.Reception, deps.Counters.PointsCreated),
-		Product:   NewProductService(deps.Repos.Product, deps.Repos.Reception, deps.Counters.ProductsCreated),
-		Reception: NewReceptionService(deps.Repos.Reception, deps.Counters.ReceptionsCreated),
+		Auth:        NewAuthService(deps.Repos.User, deps.Repos.RefreshToken, deps.Repos.RevokedToken, deps.Transaction, deps.PasswordHasher, deps.Clock, deps.Auth),
+		City:        NewCityService(deps.Repos.City),
+		Event:       NewEventService(deps.Events),
+		Idempotency: NewIdempotencyService(deps.Repos.Idempotency, deps.Clock, deps.Idempotency),
+		Outbox:      NewOutboxService(deps.Repos.Outbox, deps.Events, sinks, deps.Clock, deps.Outbox),
+		Point:       NewPointService(deps.Repos.Point, deps.Repos.Product, deps.Repos.Reception, deps.Transaction, deps.Repos.Outbox, deps.Counters.PointsCreated),
+		Product:     NewProductService(deps.Repos.Product, deps.Repos.Reception, deps.Repos.Point, deps.Repos.Schedule, deps.Transaction, deps.Clock, deps.Repos.Outbox, deps.Counters.ProductsCreated),
+		ProductType: NewProductTypeService(deps.Repos.ProductType),
+		Reception:   NewReceptionService(deps.Repos.Reception, deps.Repos.Product, deps.Repos.Point, deps.Repos.Schedule, deps.Transaction, deps.Clock, deps.Repos.Outbox, deps.Counters.ReceptionsCreated),
+		Schedule:    NewScheduleService(deps.Repos.Schedule, deps.Transaction, deps.Clock),
+		Webhook:     webhook,
 	}
 }
@@ -0,0 +1,315 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+	"github.com/spanwalla/pvz/pkg/netguard"
+)
+
+// Headers of a webhook request. The signature is hex encoded HMAC-SHA256 of "<timestamp>.<body>" keyed by the secret.
+const (
+	WebhookIDHeader        = "X-Webhook-Id"
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+var (
+	ErrWebhookNotFound       = NewError(CodeWebhookNotFound, "webhook not found")
+	ErrInvalidWebhookURL     = NewError(CodeInvalidWebhookURL, "webhook url must be absolute http or https url")
+	ErrForbiddenWebhookURL   = NewError(CodeInvalidWebhookURL, "webhook url must resolve to public addresses")
+	ErrCannotCreateWebhook   = NewError(CodeInternal, "cannot create webhook")
+	ErrCannotGetWebhooks     = NewError(CodeInternal, "cannot get webhooks")
+	ErrCannotDeleteWebhook   = NewError(CodeInternal, "cannot delete webhook")
+	ErrCannotGetDeliveries   = NewError(CodeInternal, "cannot get webhook deliveries")
+	ErrCannotDeliverWebhooks = NewError(CodeInternal, "cannot deliver webhooks")
+)
+
+type WebhookSettings struct {
+	PollInterval   time.Duration
+	BatchSize      int
+	MaxAttempts    int
+	RetryBaseDelay time.Duration
+	RetryMaxDelay  time.Duration
+	Timeout        time.Duration
+	// AllowPrivateTargets lets webhooks reach loopback, private and link-local addresses, e.g. a local test receiver
+	AllowPrivateTargets bool
+}
+
+type WebhookService struct {
+	webhookRepo repository.Webhook
+	clock       clockwork.Clock
+	client      *http.Client
+	settings    WebhookSettings
+}
+
+func NewWebhookService(webhookRepo repository.Webhook, clock clockwork.Clock, settings WebhookSettings) *WebhookService {
+	dialer := net.Dialer{Timeout: settings.Timeout, KeepAlive: 30 * time.Second}
+	// Pool and handshake limits match http.DefaultTransport
+	transport := &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+	if !settings.AllowPrivateTargets {
+		// Every connection, including redirects, is checked after DNS resolution, a proxy would hide the target
+		transport.Proxy = nil
+		transport.DialContext = netguard.Dialer(dialer).DialContext
+	}
+
+	return &WebhookService{
+		webhookRepo: webhookRepo,
+		clock:       clock,
+		client:      &http.Client{Timeout: settings.Timeout, Transport: transport},
+		settings:    settings,
+	}
+}
+
+// Create registers the webhook. Its host is resolved once here to reject internal targets early,
+// deliveries check the address again when connecting.
+func (s *WebhookService) Create(ctx context.Context, webhook entity.Webhook) (entity.Webhook, error) {
+	target, err := url.Parse(webhook.URL)
+	if err != nil || (target.Scheme != "http" && target.Scheme != "https") || len(target.Host) == 0 {
+		return entity.Webhook{}, ErrInvalidWebhookURL
+	}
+
+	if !s.settings.AllowPrivateTargets {
+		if err = netguard.CheckHost(ctx, target.Hostname()); err != nil {
+			log.Debugf("WebhookService.Create - netguard.CheckHost: %v", err)
+			return entity.Webhook{}, ErrForbiddenWebhookURL
+		}
+	}
+
+	webhook, err = s.webhookRepo.Create(ctx, webhook)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return entity.Webhook{}, ErrPointNotFound
+		}
+
+		log.Errorf("WebhookService.Create - s.webhookRepo.Create: %v", err)
+		return entity.Webhook{}, ErrCannotCreateWebhook
+	}
+
+	return webhook, nil
+}
+
+func (s *WebhookService) GetAll(ctx context.Context) ([]entity.Webhook, error) {
+	webhooks, err := s.webhookRepo.GetAll(ctx)
+	if err != nil {
+		log.Errorf("WebhookService.GetAll - s.webhookRepo.GetAll: %v", err)
+		return nil, ErrCannotGetWebhooks
+	}
+
+	return webhooks, nil
+}
+
+func (s *WebhookService) Delete(ctx context.Context, webhookID uuid.UUID) error {
+	if err := s.webhookRepo.Delete(ctx, webhookID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return ErrWebhookNotFound
+		}
+
+		log.Errorf("WebhookService.Delete - s.webhookRepo.Delete: %v", err)
+		return ErrCannotDeleteWebhook
+	}
+
+	return nil
+}
+
+func (s *WebhookService) GetDeliveries(ctx context.Context, webhookID uuid.UUID, status *entity.WebhookDeliveryStatus, limitPtr *int) ([]entity.WebhookDelivery, error) {
+	if _, err := s.webhookRepo.GetByID(ctx, webhookID); err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, ErrWebhookNotFound
+		}
+
+		log.Errorf("WebhookService.GetDeliveries - s.webhookRepo.GetByID: %v", err)
+		return nil, ErrCannotGetDeliveries
+	}
+
+	limit := DefaultLimit
+	if limitPtr != nil && *limitPtr > 0 {
+		limit = *limitPtr
+	}
+
+	deliveries, err := s.webhookRepo.GetDeliveries(ctx, webhookID, status, limit)
+	if err != nil {
+		log.Errorf("WebhookService.GetDeliveries - s.webhookRepo.GetDeliveries: %v", err)
+		return nil, ErrCannotGetDeliveries
+	}
+
+	return deliveries, nil
+}
+
+// webhookPayload is the body of a webhook request, its id stays the same across retries
+type webhookPayload struct {
+	ID         uuid.UUID        `json:"id"`
+	Type       entity.EventType `json:"type"`
+	OccurredAt time.Time        `json:"occurredAt"`
+	Data       webhookEventData `json:"data"`
+}
+
+type webhookEventData struct {
+	PointID     uuid.UUID  `json:"pvzId"`
+	City        string     `json:"city"`
+	ReceptionID uuid.UUID  `json:"receptionId"`
+	ProductID   *uuid.UUID `json:"productId,omitempty"`
+}
+
+// Publish queues the event for every matching webhook, the event id is sent as the payload id
+func (s *WebhookService) Publish(ctx context.Context, event entity.Event) error {
+	payload, err := json.Marshal(webhookPayload{
+		ID:         event.ID,
+		Type:       event.Type,
+		OccurredAt: event.OccurredAt,
+		Data: webhookEventData{
+			PointID:     event.PointID,
+			City:        event.City,
+			ReceptionID: event.ReceptionID,
+			ProductID:   event.ProductID,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("WebhookService.Publish - json.Marshal: %w", err)
+	}
+
+	if _, err = s.webhookRepo.EnqueueDeliveries(ctx, event, payload, s.clock.Now()); err != nil {
+		return fmt.Errorf("WebhookService.Publish - s.webhookRepo.EnqueueDeliveries: %w", err)
+	}
+
+	return nil
+}
+
+// Run delivers due webhooks every poll interval until ctx is done
+func (s *WebhookService) Run(ctx context.Context) {
+	ticker := s.clock.NewTicker(s.settings.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.Chan():
+			if _, err := s.DeliverDue(ctx); err != nil {
+				log.Errorf("WebhookService.Run - s.DeliverDue: %v", err)
+			}
+		}
+	}
+}
+
+// DeliverDue sends one batch of due deliveries and returns the number of attempts made.
+//
+// Claimed deliveries are leased for as long as sending the whole batch may take, so other instances skip them.
+// Failed attempts are retried with exponential backoff, after MaxAttempts the delivery is dead.
+func (s *WebhookService) DeliverDue(ctx context.Context) (int, error) {
+	now := s.clock.Now()
+
+	lease := s.settings.Timeout * time.Duration(s.settings.BatchSize)
+
+	dispatches, err := s.webhookRepo.ClaimDue(ctx, now, now.Add(lease), s.settings.BatchSize)
+	if err != nil {
+		log.Errorf("WebhookService.DeliverDue - s.webhookRepo.ClaimDue: %v", err)
+		return 0, ErrCannotDeliverWebhooks
+	}
+
+	for _, dispatch := range dispatches {
+		delivery := s.attempt(ctx, dispatch)
+		if err = s.webhookRepo.SaveAttempt(ctx, delivery); err != nil {
+			log.Errorf("WebhookService.DeliverDue - s.webhookRepo.SaveAttempt: %v", err)
+		}
+	}
+
+	return len(dispatches), nil
+}
+
+// attempt sends the delivery and returns it updated with the outcome
+func (s *WebhookService) attempt(ctx context.Context, dispatch dto.WebhookDispatch) entity.WebhookDelivery {
+	delivery := dispatch.Delivery
+	delivery.Attempts++
+
+	statusCode, err := s.send(ctx, dispatch)
+	if statusCode != 0 {
+		delivery.ResponseStatus = &statusCode
+	}
+
+	now := s.clock.Now()
+	if err == nil {
+		delivery.Status = entity.WebhookDeliveryStatusDelivered
+		delivery.LastError = nil
+		delivery.DeliveredAt = &now
+		return delivery
+	}
+
+	message := failureReason(err)
+	delivery.LastError = &message
+
+	if delivery.Attempts >= s.settings.MaxAttempts {
+		delivery.Status = entity.WebhookDeliveryStatusDead
+		return delivery
+	}
+
+	delivery.NextAttemptAt = now.Add(retryDelay(s.settings.RetryBaseDelay, s.settings.RetryMaxDelay, delivery.Attempts))
+	return delivery
+}
+
+// send posts the signed payload, any status outside 2xx is an error
+func (s *WebhookService) send(ctx context.Context, dispatch dto.WebhookDispatch) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dispatch.URL, bytes.NewReader(dispatch.Delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("http.NewRequestWithContext: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(s.clock.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookIDHeader, dispatch.Delivery.EventID.String())
+	req.Header.Set(WebhookEventHeader, string(dispatch.Delivery.EventType))
+	req.Header.Set(WebhookTimestampHeader, timestamp)
+	req.Header.Set(WebhookSignatureHeader, "sha256="+SignWebhook(dispatch.Secret, timestamp, dispatch.Delivery.Payload))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("client.Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// Body is drained, so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// SignWebhook returns the hex encoded signature of the payload sent at timestamp
+func SignWebhook(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
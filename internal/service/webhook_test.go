@@ -0,0 +1,617 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
+	"github.com/samber/lo"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/spanwalla/pvz/internal/dto"
+	"github.com/spanwalla/pvz/internal/entity"
+	"github.com/spanwalla/pvz/internal/repository"
+	repomocks "github.com/spanwalla/pvz/internal/repository/mocks"
+	"github.com/spanwalla/pvz/internal/service"
+	"github.com/spanwalla/pvz/pkg/netguard"
+)
+
+var webhookSettings = service.WebhookSettings{
+	PollInterval:   time.Second,
+	BatchSize:      50,
+	MaxAttempts:    4,
+	RetryBaseDelay: 10 * time.Second,
+	RetryMaxDelay:  30 * time.Second,
+	Timeout:        time.Second,
+	// Deliveries are sent to a local httptest receiver
+	AllowPrivateTargets: true,
+}
+
+func TestWebhookService_Create(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		pointID      = uuid.New()
+	)
+
+	input := entity.Webhook{
+		URL:        "https://partner.example.com/hooks/pvz",
+		EventTypes: []entity.EventType{entity.EventTypeReceptionClosed},
+		PointID:    &pointID,
+		Secret:     "0123456789abcdef",
+	}
+
+	created := input
+	created.ID = uuid.New()
+	created.CreatedAt = time.Now()
+
+	type MockBehavior func(w *repomocks.MockWebhook)
+
+	for _, tc := range []struct {
+		name         string
+		url          string
+		mockBehavior MockBehavior
+		want         entity.Webhook
+		wantErr      error
+	}{
+		{
+			name: "success",
+			url:  input.URL,
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().Create(ctx, input).Return(created, nil)
+			},
+			want: created,
+		},
+		{
+			name:         "unsupported scheme",
+			url:          "ftp://partner.example.com/hooks",
+			mockBehavior: func(w *repomocks.MockWebhook) {},
+			wantErr:      service.ErrInvalidWebhookURL,
+		},
+		{
+			name:         "relative url",
+			url:          "/hooks/pvz",
+			mockBehavior: func(w *repomocks.MockWebhook) {},
+			wantErr:      service.ErrInvalidWebhookURL,
+		},
+		{
+			name: "point not found",
+			url:  input.URL,
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().Create(ctx, input).Return(entity.Webhook{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrPointNotFound,
+		},
+		{
+			name: "cannot create webhook",
+			url:  input.URL,
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().Create(ctx, input).Return(entity.Webhook{}, arbitraryErr)
+			},
+			wantErr: service.ErrCannotCreateWebhook,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockWebhookRepo := repomocks.NewMockWebhook(ctrl)
+
+			tc.mockBehavior(mockWebhookRepo)
+
+			s := service.NewWebhookService(mockWebhookRepo, clockwork.NewFakeClock(), webhookSettings)
+
+			webhook := input
+			webhook.URL = tc.url
+			got, err := s.Create(ctx, webhook)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWebhookService_Create_PrivateTargets(t *testing.T) {
+	log.SetOutput(io.Discard)
+	ctx := context.Background()
+
+	settings := webhookSettings
+	settings.AllowPrivateTargets = false
+
+	input := entity.Webhook{
+		EventTypes: []entity.EventType{entity.EventTypeReceptionClosed},
+		Secret:     "0123456789abcdef",
+	}
+
+	for _, tc := range []struct {
+		name    string
+		url     string
+		wantErr error
+	}{
+		{
+			name: "public address",
+			url:  "https://93.184.216.34/hooks/pvz",
+		},
+		{
+			name:    "loopback",
+			url:     "http://127.0.0.1:8080/hooks/pvz",
+			wantErr: service.ErrForbiddenWebhookURL,
+		},
+		{
+			name:    "ipv6 loopback",
+			url:     "http://[::1]/hooks/pvz",
+			wantErr: service.ErrForbiddenWebhookURL,
+		},
+		{
+			name:    "private network",
+			url:     "http://10.0.0.5/hooks/pvz",
+			wantErr: service.ErrForbiddenWebhookURL,
+		},
+		{
+			name:    "link-local metadata endpoint",
+			url:     "http://169.254.169.254/latest/meta-data",
+			wantErr: service.ErrForbiddenWebhookURL,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			webhook := input
+			webhook.URL = tc.url
+
+			mockWebhookRepo := repomocks.NewMockWebhook(ctrl)
+			if tc.wantErr == nil {
+				mockWebhookRepo.EXPECT().Create(ctx, webhook).Return(webhook, nil)
+			}
+
+			s := service.NewWebhookService(mockWebhookRepo, clockwork.NewFakeClock(), settings)
+
+			_, err := s.Create(ctx, webhook)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestWebhookService_Delete(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		webhookID    = uuid.New()
+	)
+
+	type MockBehavior func(w *repomocks.MockWebhook)
+
+	for _, tc := range []struct {
+		name         string
+		mockBehavior MockBehavior
+		wantErr      error
+	}{
+		{
+			name: "success",
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().Delete(ctx, webhookID).Return(nil)
+			},
+		},
+		{
+			name: "webhook not found",
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().Delete(ctx, webhookID).Return(repository.ErrNotFound)
+			},
+			wantErr: service.ErrWebhookNotFound,
+		},
+		{
+			name: "cannot delete webhook",
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().Delete(ctx, webhookID).Return(arbitraryErr)
+			},
+			wantErr: service.ErrCannotDeleteWebhook,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockWebhookRepo := repomocks.NewMockWebhook(ctrl)
+
+			tc.mockBehavior(mockWebhookRepo)
+
+			s := service.NewWebhookService(mockWebhookRepo, clockwork.NewFakeClock(), webhookSettings)
+
+			err := s.Delete(ctx, webhookID)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestWebhookService_GetDeliveries(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		webhookID    = uuid.New()
+		dead         = entity.WebhookDeliveryStatusDead
+		limit        = 25
+		zero         = 0
+	)
+
+	deliveries := []entity.WebhookDelivery{{ID: uuid.New(), WebhookID: webhookID, Status: dead, Attempts: 4}}
+
+	type MockBehavior func(w *repomocks.MockWebhook)
+
+	for _, tc := range []struct {
+		name         string
+		status       *entity.WebhookDeliveryStatus
+		limit        *int
+		mockBehavior MockBehavior
+		want         []entity.WebhookDelivery
+		wantErr      error
+	}{
+		{
+			name:   "default limit",
+			status: &dead,
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().GetByID(ctx, webhookID).Return(entity.Webhook{ID: webhookID}, nil)
+				w.EXPECT().GetDeliveries(ctx, webhookID, &dead, service.DefaultLimit).Return(deliveries, nil)
+			},
+			want: deliveries,
+		},
+		{
+			name:  "custom limit",
+			limit: &limit,
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().GetByID(ctx, webhookID).Return(entity.Webhook{ID: webhookID}, nil)
+				w.EXPECT().GetDeliveries(ctx, webhookID, nil, limit).Return(deliveries, nil)
+			},
+			want: deliveries,
+		},
+		{
+			name:  "non-positive limit",
+			limit: &zero,
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().GetByID(ctx, webhookID).Return(entity.Webhook{ID: webhookID}, nil)
+				w.EXPECT().GetDeliveries(ctx, webhookID, nil, service.DefaultLimit).Return(deliveries, nil)
+			},
+			want: deliveries,
+		},
+		{
+			name: "webhook not found",
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().GetByID(ctx, webhookID).Return(entity.Webhook{}, repository.ErrNotFound)
+			},
+			wantErr: service.ErrWebhookNotFound,
+		},
+		{
+			name: "cannot get deliveries",
+			mockBehavior: func(w *repomocks.MockWebhook) {
+				w.EXPECT().GetByID(ctx, webhookID).Return(entity.Webhook{ID: webhookID}, nil)
+				w.EXPECT().GetDeliveries(ctx, webhookID, nil, service.DefaultLimit).Return(nil, arbitraryErr)
+			},
+			wantErr: service.ErrCannotGetDeliveries,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mockWebhookRepo := repomocks.NewMockWebhook(ctrl)
+
+			tc.mockBehavior(mockWebhookRepo)
+
+			s := service.NewWebhookService(mockWebhookRepo, clockwork.NewFakeClock(), webhookSettings)
+
+			got, err := s.GetDeliveries(ctx, webhookID, tc.status, tc.limit)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestWebhookService_Publish(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		arbitraryErr = errors.New("arbitrary error")
+		ctx          = context.Background()
+		now          = time.Date(2025, 5, 23, 10, 0, 1, 0, time.UTC)
+		eventID      = uuid.New()
+		pointID      = uuid.New()
+		receptionID  = uuid.New()
+		productID    = uuid.New()
+	)
+
+	event := entity.Event{
+		ID:          eventID,
+		Type:        entity.EventTypeProductAdded,
+		PointID:     pointID,
+		City:        "Казань",
+		ReceptionID: receptionID,
+		ProductID:   &productID,
+		OccurredAt:  time.Date(2025, 5, 23, 10, 0, 0, 0, time.UTC),
+	}
+
+	for _, tc := range []struct {
+		name     string
+		enqueued error
+		wantErr  error
+	}{
+		{
+			name: "success",
+		},
+		{
+			name:     "cannot enqueue deliveries",
+			enqueued: arbitraryErr,
+			wantErr:  arbitraryErr,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			var payload []byte
+			mockWebhookRepo := repomocks.NewMockWebhook(ctrl)
+			mockWebhookRepo.EXPECT().
+				EnqueueDeliveries(ctx, event, gomock.Any(), now).
+				DoAndReturn(func(_ context.Context, _ entity.Event, body []byte, _ time.Time) (int64, error) {
+					payload = body
+					return 1, tc.enqueued
+				})
+
+			s := service.NewWebhookService(mockWebhookRepo, clockwork.NewFakeClockAt(now), webhookSettings)
+
+			err := s.Publish(ctx, event)
+
+			assert.ErrorIs(t, err, tc.wantErr)
+
+			var got map[string]any
+			assert.NoError(t, json.Unmarshal(payload, &got))
+			assert.Equal(t, eventID.String(), got["id"])
+			assert.Equal(t, "product_added", got["type"])
+			assert.Equal(t, "2025-05-23T10:00:00Z", got["occurredAt"])
+			assert.Equal(t, map[string]any{
+				"pvzId":       pointID.String(),
+				"city":        "Казань",
+				"receptionId": receptionID.String(),
+				"productId":   productID.String(),
+			}, got["data"])
+		})
+	}
+}
+
+func TestWebhookService_DeliverDue(t *testing.T) {
+	log.SetOutput(io.Discard)
+	var (
+		now     = time.Date(2025, 5, 23, 10, 0, 0, 0, time.UTC)
+		ctx     = context.Background()
+		secret  = "0123456789abcdef"
+		payload = []byte(`{"id":"1","type":"reception_closed"}`)
+	)
+
+	pending := entity.WebhookDelivery{
+		ID:            uuid.New(),
+		WebhookID:     uuid.New(),
+		EventID:       uuid.New(),
+		EventType:     entity.EventTypeReceptionClosed,
+		Payload:       payload,
+		Status:        entity.WebhookDeliveryStatusPending,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+
+	withAttempts := func(attempts int) entity.WebhookDelivery {
+		delivery := pending
+		delivery.Attempts = attempts
+		return delivery
+	}
+
+	ok, unavailable := http.StatusNoContent, http.StatusServiceUnavailable
+	failure := "unexpected status 503"
+
+	for _, tc := range []struct {
+		name     string
+		delivery entity.WebhookDelivery
+		status   int
+		want     func(delivery entity.WebhookDelivery) entity.WebhookDelivery
+	}{
+		{
+			name:     "delivered",
+			delivery: withAttempts(0),
+			status:   ok,
+			want: func(delivery entity.WebhookDelivery) entity.WebhookDelivery {
+				delivery.Attempts = 1
+				delivery.Status = entity.WebhookDeliveryStatusDelivered
+				delivery.ResponseStatus = &ok
+				delivery.DeliveredAt = &now
+				return delivery
+			},
+		},
+		{
+			name:     "first failure is retried after base delay",
+			delivery: withAttempts(0),
+			status:   unavailable,
+			want: func(delivery entity.WebhookDelivery) entity.WebhookDelivery {
+				delivery.Attempts = 1
+				delivery.ResponseStatus = &unavailable
+				delivery.LastError = &failure
+				delivery.NextAttemptAt = now.Add(10 * time.Second)
+				return delivery
+			},
+		},
+		{
+			name:     "retry delay doubles",
+			delivery: withAttempts(1),
+			status:   unavailable,
+			want: func(delivery entity.WebhookDelivery) entity.WebhookDelivery {
+				delivery.Attempts = 2
+				delivery.ResponseStatus = &unavailable
+				delivery.LastError = &failure
+				delivery.NextAttemptAt = now.Add(20 * time.Second)
+				return delivery
+			},
+		},
+		{
+			name:     "retry delay is capped",
+			delivery: withAttempts(2),
+			status:   unavailable,
+			want: func(delivery entity.WebhookDelivery) entity.WebhookDelivery {
+				delivery.Attempts = 3
+				delivery.ResponseStatus = &unavailable
+				delivery.LastError = &failure
+				delivery.NextAttemptAt = now.Add(30 * time.Second)
+				return delivery
+			},
+		},
+		{
+			name:     "last failure is dead",
+			delivery: withAttempts(3),
+			status:   unavailable,
+			want: func(delivery entity.WebhookDelivery) entity.WebhookDelivery {
+				delivery.Attempts = 4
+				delivery.Status = entity.WebhookDeliveryStatusDead
+				delivery.ResponseStatus = &unavailable
+				delivery.LastError = &failure
+				return delivery
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			partner := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, payload, body)
+
+				timestamp := r.Header.Get(service.WebhookTimestampHeader)
+				assert.Equal(t, strconv.FormatInt(now.Unix(), 10), timestamp)
+				assert.Equal(t, "sha256="+service.SignWebhook(secret, timestamp, body), r.Header.Get(service.WebhookSignatureHeader))
+				assert.Equal(t, pending.EventID.String(), r.Header.Get(service.WebhookIDHeader))
+				assert.Equal(t, "reception_closed", r.Header.Get(service.WebhookEventHeader))
+
+				w.WriteHeader(tc.status)
+			}))
+			defer partner.Close()
+
+			mockWebhookRepo := repomocks.NewMockWebhook(ctrl)
+			mockWebhookRepo.EXPECT().
+				ClaimDue(ctx, now, now.Add(50*webhookSettings.Timeout), webhookSettings.BatchSize).
+				Return([]dto.WebhookDispatch{{Delivery: tc.delivery, URL: partner.URL, Secret: secret}}, nil)
+			mockWebhookRepo.EXPECT().SaveAttempt(ctx, tc.want(tc.delivery)).Return(nil)
+
+			s := service.NewWebhookService(mockWebhookRepo, clockwork.NewFakeClockAt(now), webhookSettings)
+
+			got, err := s.DeliverDue(ctx)
+
+			assert.NoError(t, err)
+			assert.Equal(t, 1, got)
+		})
+	}
+}
+
+func TestWebhookService_DeliverDue_Unreachable(t *testing.T) {
+	log.SetOutput(io.Discard)
+	t.Parallel()
+
+	var (
+		now = time.Date(2025, 5, 23, 10, 0, 0, 0, time.UTC)
+		ctx = context.Background()
+	)
+
+	partner := httptest.NewServer(http.NotFoundHandler())
+	partner.Close()
+
+	delivery := entity.WebhookDelivery{ID: uuid.New(), Status: entity.WebhookDeliveryStatusPending, Payload: []byte(`{}`)}
+
+	ctrl := gomock.NewController(t)
+	mockWebhookRepo := repomocks.NewMockWebhook(ctrl)
+	mockWebhookRepo.EXPECT().
+		ClaimDue(ctx, now, gomock.Any(), gomock.Any()).
+		Return([]dto.WebhookDispatch{{Delivery: delivery, URL: partner.URL, Secret: "0123456789abcdef"}}, nil)
+	mockWebhookRepo.EXPECT().
+		SaveAttempt(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, got entity.WebhookDelivery) error {
+			assert.Equal(t, 1, got.Attempts)
+			assert.Equal(t, entity.WebhookDeliveryStatusPending, got.Status)
+			assert.Nil(t, got.ResponseStatus)
+			assert.NotNil(t, got.LastError)
+			assert.Equal(t, now.Add(webhookSettings.RetryBaseDelay), got.NextAttemptAt)
+			return nil
+		})
+
+	s := service.NewWebhookService(mockWebhookRepo, clockwork.NewFakeClockAt(now), webhookSettings)
+
+	_, err := s.DeliverDue(ctx)
+	assert.NoError(t, err)
+}
+
+// A target that resolves to a private address after the webhook was created is refused when connecting
+func TestWebhookService_DeliverDue_PrivateTarget(t *testing.T) {
+	log.SetOutput(io.Discard)
+	t.Parallel()
+
+	var (
+		now = time.Date(2025, 5, 23, 10, 0, 0, 0, time.UTC)
+		ctx = context.Background()
+	)
+
+	var called bool
+	partner := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer partner.Close()
+
+	settings := webhookSettings
+	settings.AllowPrivateTargets = false
+
+	delivery := entity.WebhookDelivery{ID: uuid.New(), Status: entity.WebhookDeliveryStatusPending, Payload: []byte(`{}`)}
+
+	ctrl := gomock.NewController(t)
+	mockWebhookRepo := repomocks.NewMockWebhook(ctrl)
+	mockWebhookRepo.EXPECT().
+		ClaimDue(ctx, now, gomock.Any(), gomock.Any()).
+		Return([]dto.WebhookDispatch{{Delivery: delivery, URL: partner.URL, Secret: "0123456789abcdef"}}, nil)
+	mockWebhookRepo.EXPECT().
+		SaveAttempt(ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, got entity.WebhookDelivery) error {
+			assert.Equal(t, entity.WebhookDeliveryStatusPending, got.Status)
+			assert.Nil(t, got.ResponseStatus)
+			assert.Contains(t, lo.FromPtr(got.LastError), netguard.ErrForbiddenAddress.Error())
+			return nil
+		})
+
+	s := service.NewWebhookService(mockWebhookRepo, clockwork.NewFakeClockAt(now), settings)
+
+	_, err := s.DeliverDue(ctx)
+	assert.NoError(t, err)
+	assert.False(t, called)
+}
+
+func TestWebhookService_DeliverDue_ClaimFailed(t *testing.T) {
+	log.SetOutput(io.Discard)
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	mockWebhookRepo := repomocks.NewMockWebhook(ctrl)
+	mockWebhookRepo.EXPECT().ClaimDue(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("arbitrary error"))
+
+	s := service.NewWebhookService(mockWebhookRepo, clockwork.NewFakeClock(), webhookSettings)
+
+	got, err := s.DeliverDue(context.Background())
+	assert.ErrorIs(t, err, service.ErrCannotDeliverWebhooks)
+	assert.Zero(t, got)
+}
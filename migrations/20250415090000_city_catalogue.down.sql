@@ -0,0 +1,3 @@
+ALTER TABLE cities DROP COLUMN IF EXISTS created_at;
+ALTER TABLE cities DROP COLUMN IF EXISTS is_active;
+-- The name keeps its width, cities added with longer names would not fit the previous VARCHAR(16)
@@ -0,0 +1,3 @@
+ALTER TABLE cities ALTER COLUMN name TYPE VARCHAR(64);
+ALTER TABLE cities ADD COLUMN is_active BOOLEAN DEFAULT TRUE NOT NULL;
+ALTER TABLE cities ADD COLUMN created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL;
@@ -0,0 +1,18 @@
+CREATE TYPE product_type AS ENUM(
+    'электроника',
+    'одежда',
+    'обувь'
+);
+
+ALTER TABLE products ADD COLUMN type product_type;
+
+UPDATE products SET type = (product_types.names->>'ru')::product_type
+FROM product_types
+WHERE product_types.id = products.type_id;
+
+DELETE FROM products WHERE type IS NULL;
+
+ALTER TABLE products ALTER COLUMN type SET NOT NULL;
+ALTER TABLE products DROP COLUMN type_id;
+
+DROP TABLE IF EXISTS product_types;
@@ -0,0 +1,25 @@
+CREATE TABLE product_types(
+    id SERIAL,
+    code VARCHAR(32) UNIQUE NOT NULL,
+    names JSONB DEFAULT '{}' NOT NULL,
+    is_active BOOLEAN DEFAULT TRUE NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+INSERT INTO product_types(code, names) VALUES
+                       ('electronics', '{"ru": "электроника", "en": "electronics"}'),
+                       ('clothes', '{"ru": "одежда", "en": "clothes"}'),
+                       ('shoes', '{"ru": "обувь", "en": "shoes"}');
+
+ALTER TABLE products ADD COLUMN type_id INTEGER REFERENCES product_types(id);
+
+UPDATE products SET type_id = product_types.id
+FROM product_types
+WHERE product_types.names->>'ru' = products.type::TEXT;
+
+ALTER TABLE products ALTER COLUMN type_id SET NOT NULL;
+ALTER TABLE products DROP COLUMN type;
+
+DROP TYPE product_type;
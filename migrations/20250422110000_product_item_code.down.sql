@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_products_item_code;
+
+ALTER TABLE products DROP COLUMN IF EXISTS item_code;
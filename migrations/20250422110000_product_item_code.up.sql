@@ -0,0 +1,7 @@
+ALTER TABLE products ADD COLUMN item_code VARCHAR(64);
+
+UPDATE products SET item_code = id::TEXT;
+
+ALTER TABLE products ALTER COLUMN item_code SET NOT NULL;
+
+CREATE INDEX idx_products_item_code ON products(item_code);
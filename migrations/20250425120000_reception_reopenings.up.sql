@@ -0,0 +1,11 @@
+CREATE TABLE reception_reopenings(
+    id UUID DEFAULT gen_random_uuid() NOT NULL,
+    reception_id UUID NOT NULL REFERENCES receptions(id),
+    reopened_by UUID NOT NULL,
+    reason TEXT NOT NULL,
+    reopened_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_reception_reopenings_reception_id ON reception_reopenings(reception_id);
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_points_status;
+
+ALTER TABLE points DROP COLUMN IF EXISTS status;
+
+DROP TYPE IF EXISTS point_status;
@@ -0,0 +1,9 @@
+CREATE TYPE point_status AS ENUM(
+    'active',
+    'suspended',
+    'closed'
+);
+
+ALTER TABLE points ADD COLUMN status point_status DEFAULT 'active' NOT NULL;
+
+CREATE INDEX idx_points_status ON points(status);
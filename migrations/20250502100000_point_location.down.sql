@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_points_latitude;
+
+ALTER TABLE points DROP CONSTRAINT IF EXISTS chk_points_coordinates;
+
+ALTER TABLE points DROP COLUMN IF EXISTS longitude;
+ALTER TABLE points DROP COLUMN IF EXISTS latitude;
+ALTER TABLE points DROP COLUMN IF EXISTS address;
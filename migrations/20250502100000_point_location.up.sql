@@ -0,0 +1,10 @@
+ALTER TABLE points ADD COLUMN address VARCHAR(255) DEFAULT '' NOT NULL;
+ALTER TABLE points ADD COLUMN latitude DOUBLE PRECISION;
+ALTER TABLE points ADD COLUMN longitude DOUBLE PRECISION;
+
+ALTER TABLE points ADD CONSTRAINT chk_points_coordinates CHECK (
+    (latitude IS NULL AND longitude IS NULL) OR
+    (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+);
+
+CREATE INDEX idx_points_latitude ON points(latitude) WHERE latitude IS NOT NULL;
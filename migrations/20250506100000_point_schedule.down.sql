@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS point_holidays;
+DROP TABLE IF EXISTS point_working_hours;
+
+ALTER TABLE points DROP COLUMN IF EXISTS hours_override_until;
+ALTER TABLE points DROP COLUMN IF EXISTS time_zone;
@@ -0,0 +1,21 @@
+ALTER TABLE points ADD COLUMN time_zone VARCHAR(64) DEFAULT 'Europe/Moscow' NOT NULL;
+ALTER TABLE points ADD COLUMN hours_override_until TIMESTAMPTZ;
+
+CREATE TABLE point_working_hours(
+    point_id UUID NOT NULL REFERENCES points(id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL CHECK (closes_at > opens_at),
+
+    PRIMARY KEY (point_id, weekday)
+);
+
+CREATE TABLE point_holidays(
+    point_id UUID NOT NULL REFERENCES points(id) ON DELETE CASCADE,
+    date DATE NOT NULL,
+    opens_at TIME,
+    closes_at TIME,
+
+    PRIMARY KEY (point_id, date),
+    CHECK ((opens_at IS NULL AND closes_at IS NULL) OR closes_at > opens_at)
+);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_products_status;
+
+ALTER TABLE products DROP COLUMN IF EXISTS status_changed_at;
+ALTER TABLE products DROP COLUMN IF EXISTS status;
+
+DROP TYPE IF EXISTS product_status;
@@ -0,0 +1,15 @@
+CREATE TYPE product_status AS ENUM(
+    'received',
+    'stored',
+    'issued',
+    'returned'
+);
+
+ALTER TABLE products ADD COLUMN status product_status DEFAULT 'received' NOT NULL;
+ALTER TABLE products ADD COLUMN status_changed_at TIMESTAMPTZ DEFAULT NOW() NOT NULL;
+
+UPDATE products p SET status = 'stored'
+FROM receptions r
+WHERE r.id = p.reception_id AND r.status = 'close';
+
+CREATE INDEX idx_products_status ON products(status);
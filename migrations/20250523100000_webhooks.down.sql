@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_webhook_deliveries_webhook_id;
+DROP INDEX IF EXISTS idx_webhook_deliveries_due;
+
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP TYPE IF EXISTS webhook_delivery_status;
+
+DROP TABLE IF EXISTS webhooks;
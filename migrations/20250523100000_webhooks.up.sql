@@ -0,0 +1,37 @@
+CREATE TABLE webhooks(
+    id UUID DEFAULT gen_random_uuid() NOT NULL,
+    url VARCHAR(2048) NOT NULL,
+    event_types VARCHAR(32)[] NOT NULL,
+    point_id UUID REFERENCES points(id),
+    city VARCHAR(64),
+    secret VARCHAR(128) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+
+    PRIMARY KEY (id)
+);
+
+CREATE TYPE webhook_delivery_status AS ENUM(
+    'pending',
+    'delivered',
+    'dead'
+);
+
+CREATE TABLE webhook_deliveries(
+    id UUID DEFAULT gen_random_uuid() NOT NULL,
+    webhook_id UUID NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    event_type VARCHAR(32) NOT NULL,
+    payload JSONB NOT NULL,
+    status webhook_delivery_status DEFAULT 'pending' NOT NULL,
+    attempts INTEGER DEFAULT 0 NOT NULL,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    response_status INTEGER,
+    last_error TEXT,
+    created_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+    delivered_at TIMESTAMPTZ,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 'pending';
+CREATE INDEX idx_webhook_deliveries_webhook_id ON webhook_deliveries(webhook_id, created_at);
@@ -0,0 +1,20 @@
+CREATE TABLE outbox(
+    id UUID DEFAULT gen_random_uuid() NOT NULL,
+    seq BIGINT GENERATED ALWAYS AS IDENTITY,
+    event_type VARCHAR(32) NOT NULL,
+    point_id UUID NOT NULL,
+    city VARCHAR(64) NOT NULL,
+    reception_id UUID NOT NULL,
+    product_id UUID,
+    occurred_at TIMESTAMPTZ DEFAULT NOW() NOT NULL,
+    attempts INTEGER DEFAULT 0 NOT NULL,
+    last_error TEXT,
+    published_at TIMESTAMPTZ,
+
+    PRIMARY KEY (id)
+);
+
+CREATE INDEX idx_outbox_pending ON outbox(point_id, seq) WHERE published_at IS NULL;
+
+-- Events are relayed at least once, so a repeated event must not be queued for a webhook twice
+CREATE UNIQUE INDEX idx_webhook_deliveries_event ON webhook_deliveries(webhook_id, event_id);
@@ -0,0 +1,2 @@
+-- Products of open receptions are the ones in status 'received', closing a reception stores them
+CREATE UNIQUE INDEX idx_products_item_code_received ON products(item_code) WHERE status = 'received';
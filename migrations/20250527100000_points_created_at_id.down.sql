@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_points_created_at_id;
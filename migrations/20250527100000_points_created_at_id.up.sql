@@ -0,0 +1,2 @@
+-- Keyset pagination of the PVZ listing walks points in (created_at, id) order
+CREATE INDEX idx_points_created_at_id ON points(created_at, id);
@@ -0,0 +1,25 @@
+DROP TRIGGER IF EXISTS trg_outbox_notify ON outbox;
+DROP FUNCTION IF EXISTS notify_outbox_event();
+
+DROP INDEX IF EXISTS idx_outbox_occurred_at;
+DROP INDEX IF EXISTS idx_outbox_point_id;
+
+ALTER TABLE outbox
+    ADD COLUMN attempts INTEGER DEFAULT 0 NOT NULL,
+    ADD COLUMN last_error TEXT,
+    ADD COLUMN published_at TIMESTAMPTZ;
+
+UPDATE outbox o
+SET published_at = d.published_at, attempts = d.attempts
+FROM (
+    SELECT event_id, MAX(published_at) AS published_at, MAX(attempts) AS attempts
+    FROM outbox_deliveries
+    GROUP BY event_id
+    HAVING COUNT(*) FILTER (WHERE status = 'pending') = 0
+) d
+WHERE d.event_id = o.id;
+
+CREATE INDEX idx_outbox_pending ON outbox(point_id, seq) WHERE published_at IS NULL;
+
+DROP TABLE IF EXISTS outbox_deliveries;
+DROP TYPE IF EXISTS outbox_delivery_status;
@@ -0,0 +1,54 @@
+CREATE TYPE outbox_delivery_status AS ENUM(
+    'pending',
+    'published',
+    'dead'
+);
+
+-- Every sink keeps its own delivery state of an event, the row is created when the event is first claimed for the sink
+CREATE TABLE outbox_deliveries(
+    event_id UUID NOT NULL REFERENCES outbox(id) ON DELETE CASCADE,
+    sink VARCHAR(32) NOT NULL,
+    status outbox_delivery_status DEFAULT 'pending' NOT NULL,
+    attempts INTEGER DEFAULT 0 NOT NULL,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error TEXT,
+    published_at TIMESTAMPTZ,
+
+    PRIMARY KEY (event_id, sink)
+);
+
+INSERT INTO outbox_deliveries (event_id, sink, status, attempts, next_attempt_at, published_at)
+SELECT o.id, s.sink, 'published', o.attempts, o.published_at, o.published_at
+FROM outbox o
+CROSS JOIN (VALUES ('webhooks'), ('event_sink')) AS s(sink)
+WHERE o.published_at IS NOT NULL;
+
+DROP INDEX IF EXISTS idx_outbox_pending;
+
+ALTER TABLE outbox
+    DROP COLUMN attempts,
+    DROP COLUMN last_error,
+    DROP COLUMN published_at;
+
+CREATE INDEX idx_outbox_point_id ON outbox(point_id, seq);
+CREATE INDEX idx_outbox_occurred_at ON outbox(occurred_at);
+
+-- Each instance listens for committed events to pass them to its own subscribers
+CREATE FUNCTION notify_outbox_event() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('outbox_events', json_build_object(
+        'id', NEW.id,
+        'event_type', NEW.event_type,
+        'point_id', NEW.point_id,
+        'city', NEW.city,
+        'reception_id', NEW.reception_id,
+        'product_id', NEW.product_id,
+        'occurred_at', NEW.occurred_at
+    )::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_outbox_notify
+    AFTER INSERT ON outbox
+    FOR EACH ROW EXECUTE FUNCTION notify_outbox_event();
@@ -0,0 +1,199 @@
+package jwtkeys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSABits is the smallest RSA key accepted for RS256
+const minRSABits = 2048
+
+var ErrUnsupportedKey = errors.New("unsupported key type")
+
+// Key signs and verifies tokens, it is referenced by ID in the `kid` header.
+// Private is nil for keys that only verify tokens signed before a rotation.
+type Key struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+	Public  crypto.PublicKey
+}
+
+// NewKey wraps an RSA or Ed25519 key, private or public
+func NewKey(id string, key any) (Key, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < minRSABits {
+			return Key{}, fmt.Errorf("rsa key is %d bits, at least %d required", k.N.BitLen(), minRSABits)
+		}
+		return Key{ID: id, Method: jwt.SigningMethodRS256, Private: k, Public: &k.PublicKey}, nil
+	case *rsa.PublicKey:
+		if k.N.BitLen() < minRSABits {
+			return Key{}, fmt.Errorf("rsa key is %d bits, at least %d required", k.N.BitLen(), minRSABits)
+		}
+		return Key{ID: id, Method: jwt.SigningMethodRS256, Public: k}, nil
+	case ed25519.PrivateKey:
+		return Key{ID: id, Method: jwt.SigningMethodEdDSA, Private: k, Public: k.Public()}, nil
+	case ed25519.PublicKey:
+		return Key{ID: id, Method: jwt.SigningMethodEdDSA, Public: k}, nil
+	default:
+		return Key{}, fmt.Errorf("%w: %T", ErrUnsupportedKey, key)
+	}
+}
+
+// ParsePEM reads a PKCS#8 or PKCS#1 private key, or a PKIX public key
+func ParsePEM(id string, data []byte) (Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return Key{}, errors.New("no PEM block found")
+	}
+
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return Key{}, fmt.Errorf("%w: PEM block %q", ErrUnsupportedKey, block.Type)
+	}
+	if err != nil {
+		return Key{}, err
+	}
+
+	return NewKey(id, key)
+}
+
+// Set holds the key new tokens are signed with and all keys tokens are accepted from.
+// A nil Set has no keys.
+type Set struct {
+	signing *Key
+	keys    map[string]Key
+}
+
+// New builds a set, signingKeyID must be empty or refer to a private key among keys
+func New(signingKeyID string, keys ...Key) (*Set, error) {
+	s := &Set{keys: make(map[string]Key, len(keys))}
+	for _, key := range keys {
+		if _, ok := s.keys[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", key.ID)
+		}
+		s.keys[key.ID] = key
+	}
+
+	if len(signingKeyID) == 0 {
+		return s, nil
+	}
+
+	key, ok := s.keys[signingKeyID]
+	if !ok {
+		return nil, fmt.Errorf("signing key %q not found", signingKeyID)
+	}
+	if key.Private == nil {
+		return nil, fmt.Errorf("signing key %q has no private part", signingKeyID)
+	}
+	s.signing = &key
+
+	return s, nil
+}
+
+// LoadFiles reads PEM keys from paths, the file name without extension is the key id
+func LoadFiles(paths []string, signingKeyID string) (*Set, error) {
+	keys := make([]Key, 0, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("os.ReadFile: %w", err)
+		}
+
+		name := filepath.Base(path)
+		key, err := ParsePEM(strings.TrimSuffix(name, filepath.Ext(name)), data)
+		if err != nil {
+			return nil, fmt.Errorf("ParsePEM %s: %w", path, err)
+		}
+
+		keys = append(keys, key)
+	}
+
+	return New(signingKeyID, keys...)
+}
+
+// Signing returns the key new tokens are signed with
+func (s *Set) Signing() (Key, bool) {
+	if s == nil || s.signing == nil {
+		return Key{}, false
+	}
+
+	return *s.signing, true
+}
+
+// Get returns the key by its id
+func (s *Set) Get(id string) (Key, bool) {
+	if s == nil {
+		return Key{}, false
+	}
+
+	key, ok := s.keys[id]
+	return key, ok
+}
+
+// JWK is the public part of a key as described in RFC 7517, Ed25519 keys follow RFC 8037
+type JWK struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use"`
+	Algorithm string `json:"alg"`
+	N         string `json:"n,omitempty"`
+	E         string `json:"e,omitempty"`
+	Curve     string `json:"crv,omitempty"`
+	X         string `json:"x,omitempty"`
+}
+
+// JWKS returns public keys of the set ordered by id
+func (s *Set) JWKS() []JWK {
+	if s == nil {
+		return []JWK{}
+	}
+
+	jwks := make([]JWK, 0, len(s.keys))
+	for _, key := range s.keys {
+		jwk := JWK{KeyID: key.ID, Use: "sig", Algorithm: key.Method.Alg()}
+
+		switch k := key.Public.(type) {
+		case *rsa.PublicKey:
+			jwk.KeyType = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(k.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.KeyType = "OKP"
+			jwk.Curve = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(k)
+		}
+
+		jwks = append(jwks, jwk)
+	}
+
+	slices.SortFunc(jwks, func(a, b JWK) int { return strings.Compare(a.KeyID, b.KeyID) })
+
+	return jwks
+}
@@ -0,0 +1,209 @@
+package jwtkeys_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spanwalla/pvz/pkg/jwtkeys"
+)
+
+var (
+	rsaKey      = lo.Must(rsa.GenerateKey(rand.Reader, 2048))
+	smallRSAKey = lo.Must(rsa.GenerateKey(rand.Reader, 1024))
+	ecdsaKey    = lo.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader))
+	ed25519Key  = ed25519.NewKeyFromSeed(bytes.Repeat([]byte{1}, ed25519.SeedSize))
+)
+
+func encodePEM(blockType string, der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+func TestParsePEM(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		data        []byte
+		wantMethod  jwt.SigningMethod
+		wantPrivate bool
+		wantErr     bool
+		wantErrIs   error
+	}{
+		{
+			name:        "rsa pkcs8 private key",
+			data:        encodePEM("PRIVATE KEY", lo.Must(x509.MarshalPKCS8PrivateKey(rsaKey))),
+			wantMethod:  jwt.SigningMethodRS256,
+			wantPrivate: true,
+		},
+		{
+			name:        "rsa pkcs1 private key",
+			data:        encodePEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)),
+			wantMethod:  jwt.SigningMethodRS256,
+			wantPrivate: true,
+		},
+		{
+			name:       "rsa pkix public key",
+			data:       encodePEM("PUBLIC KEY", lo.Must(x509.MarshalPKIXPublicKey(&rsaKey.PublicKey))),
+			wantMethod: jwt.SigningMethodRS256,
+		},
+		{
+			name:       "rsa pkcs1 public key",
+			data:       encodePEM("RSA PUBLIC KEY", x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)),
+			wantMethod: jwt.SigningMethodRS256,
+		},
+		{
+			name:        "ed25519 private key",
+			data:        encodePEM("PRIVATE KEY", lo.Must(x509.MarshalPKCS8PrivateKey(ed25519Key))),
+			wantMethod:  jwt.SigningMethodEdDSA,
+			wantPrivate: true,
+		},
+		{
+			name:       "ed25519 public key",
+			data:       encodePEM("PUBLIC KEY", lo.Must(x509.MarshalPKIXPublicKey(ed25519Key.Public()))),
+			wantMethod: jwt.SigningMethodEdDSA,
+		},
+		{
+			name:    "rsa private key below the size floor",
+			data:    encodePEM("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(smallRSAKey)),
+			wantErr: true,
+		},
+		{
+			name:    "rsa public key below the size floor",
+			data:    encodePEM("PUBLIC KEY", lo.Must(x509.MarshalPKIXPublicKey(&smallRSAKey.PublicKey))),
+			wantErr: true,
+		},
+		{
+			name:      "ecdsa key",
+			data:      encodePEM("PRIVATE KEY", lo.Must(x509.MarshalPKCS8PrivateKey(ecdsaKey))),
+			wantErr:   true,
+			wantErrIs: jwtkeys.ErrUnsupportedKey,
+		},
+		{
+			name:      "unsupported block",
+			data:      encodePEM("EC PRIVATE KEY", lo.Must(x509.MarshalECPrivateKey(ecdsaKey))),
+			wantErr:   true,
+			wantErrIs: jwtkeys.ErrUnsupportedKey,
+		},
+		{
+			name:    "malformed block",
+			data:    encodePEM("PRIVATE KEY", []byte("not a key")),
+			wantErr: true,
+		},
+		{
+			name:    "no block",
+			data:    []byte("not a key"),
+			wantErr: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := jwtkeys.ParsePEM("key-1", tc.data)
+
+			if tc.wantErr {
+				assert.Error(t, err)
+				if tc.wantErrIs != nil {
+					assert.ErrorIs(t, err, tc.wantErrIs)
+				}
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "key-1", key.ID)
+			assert.Equal(t, tc.wantMethod, key.Method)
+			assert.Equal(t, tc.wantPrivate, key.Private != nil)
+			assert.NotNil(t, key.Public)
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	rsaPrivate := lo.Must(jwtkeys.NewKey("rsa-1", rsaKey))
+	edPrivate := lo.Must(jwtkeys.NewKey("ed-1", ed25519Key))
+	edPublic := lo.Must(jwtkeys.NewKey("ed-2", ed25519Key.Public()))
+
+	set, err := jwtkeys.New("ed-1", rsaPrivate, edPrivate, edPublic)
+	assert.NoError(t, err)
+
+	signing, ok := set.Signing()
+	assert.True(t, ok)
+	assert.Equal(t, edPrivate, signing)
+
+	key, ok := set.Get("rsa-1")
+	assert.True(t, ok)
+	assert.Equal(t, rsaPrivate, key)
+
+	_, ok = set.Get("unknown")
+	assert.False(t, ok)
+
+	_, err = jwtkeys.New("", rsaPrivate, rsaPrivate)
+	assert.Error(t, err, "duplicate key id")
+
+	_, err = jwtkeys.New("unknown", rsaPrivate)
+	assert.Error(t, err, "signing key not found")
+
+	_, err = jwtkeys.New("ed-2", edPublic)
+	assert.Error(t, err, "signing key without private part")
+
+	var nilSet *jwtkeys.Set
+	_, ok = nilSet.Signing()
+	assert.False(t, ok)
+	assert.Equal(t, []jwtkeys.JWK{}, nilSet.JWKS())
+}
+
+func TestLoadFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "ed-1.pem")
+	assert.NoError(t, os.WriteFile(path, encodePEM("PRIVATE KEY", lo.Must(x509.MarshalPKCS8PrivateKey(ed25519Key))), 0o600))
+
+	set, err := jwtkeys.LoadFiles([]string{path}, "ed-1")
+	assert.NoError(t, err)
+
+	signing, ok := set.Signing()
+	assert.True(t, ok)
+	assert.Equal(t, "ed-1", signing.ID)
+
+	_, err = jwtkeys.LoadFiles([]string{filepath.Join(dir, "missing.pem")}, "")
+	assert.Error(t, err)
+}
+
+func TestSet_JWKS(t *testing.T) {
+	set := lo.Must(jwtkeys.New("",
+		lo.Must(jwtkeys.NewKey("rsa-1", &rsaKey.PublicKey)),
+		lo.Must(jwtkeys.NewKey("ed-1", ed25519Key.Public())),
+	))
+
+	want := []jwtkeys.JWK{
+		{
+			KeyType:   "OKP",
+			KeyID:     "ed-1",
+			Use:       "sig",
+			Algorithm: "EdDSA",
+			Curve:     "Ed25519",
+			X:         base64.RawURLEncoding.EncodeToString(ed25519Key.Public().(ed25519.PublicKey)),
+		},
+		{
+			KeyType:   "RSA",
+			KeyID:     "rsa-1",
+			Use:       "sig",
+			Algorithm: "RS256",
+			N:         base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+			// 65537
+			E: "AQAB",
+		},
+	}
+
+	assert.Equal(t, want, set.JWKS())
+}
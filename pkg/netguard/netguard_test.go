@@ -0,0 +1,61 @@
+package netguard_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/spanwalla/pvz/pkg/netguard"
+)
+
+func TestIsPublic(t *testing.T) {
+	for _, tc := range []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "93.184.216.34", want: true},
+		{ip: "2606:2800:220:1:248:1893:25c8:1946", want: true},
+		{ip: "127.0.0.1"},
+		{ip: "::1"},
+		{ip: "10.1.2.3"},
+		{ip: "172.16.0.1"},
+		{ip: "192.168.1.1"},
+		{ip: "169.254.169.254"},
+		{ip: "fe80::1"},
+		{ip: "fd00::1"},
+		{ip: "0.0.0.0"},
+		{ip: "100.64.0.1"},
+		{ip: "224.0.0.1"},
+		{ip: "::ffff:127.0.0.1"},
+	} {
+		t.Run(tc.ip, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, netguard.IsPublic(netip.MustParseAddr(tc.ip)))
+		})
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	ctx := context.Background()
+
+	assert.NoError(t, netguard.CheckHost(ctx, "93.184.216.34"))
+	assert.ErrorIs(t, netguard.CheckHost(ctx, "169.254.169.254"), netguard.ErrForbiddenAddress)
+	assert.ErrorIs(t, netguard.CheckHost(ctx, "localhost"), netguard.ErrForbiddenAddress)
+}
+
+func TestDialer(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	client := &http.Client{Transport: &http.Transport{DialContext: netguard.Dialer(net.Dialer{}).DialContext}}
+
+	_, err := client.Get(server.URL)
+
+	assert.ErrorIs(t, err, netguard.ErrForbiddenAddress)
+}
@@ -1,60 +1,16 @@
 package validator
 
-import (
-	"errors"
-	"fmt"
-	"reflect"
-	"strings"
-
-	"github.com/go-playground/validator/v10"
-)
-
-type CustomValidator struct {
-	v *validator.Validate
-}
-
-func NewCustomValidator() *CustomValidator {
-	v := validator.New()
-	cv := &CustomValidator{v: v}
-
-	v.RegisterTagNameFunc(func(fld reflect.StructField) string {
-		name := strings.SplitN(fld.Tag.Get("json"), ",", 2)[0]
-		if name == "-" {
-			return ""
-		}
-		return name
-	})
-
-	return cv
+// FieldError describes a single invalid field
+type FieldError struct {
+	Field   string
+	Message string
 }
 
-func (cv *CustomValidator) Validate(i any) error {
-	err := cv.v.Struct(i)
-	if err != nil {
-		var validationErr validator.ValidationErrors
-		if errors.As(err, &validationErr) {
-			return cv.newValidationError(validationErr[0].Field(), validationErr[0].Tag(), validationErr[0].Param())
-		}
-		return err
-	}
-	return nil
+// ValidationError lists all invalid fields, its message is the message of the first one
+type ValidationError struct {
+	Fields []FieldError
 }
 
-func (cv *CustomValidator) newValidationError(field string, tag string, param string) error {
-	switch tag {
-	case "required":
-		return fmt.Errorf("field %s is required", field)
-	case "len":
-		return fmt.Errorf("field %s must be %s characters length", field, param)
-	case "uuid":
-		return fmt.Errorf("field %s must be a valid UUID", field)
-	case "email":
-		return fmt.Errorf("field %s must be a valid email address", field)
-	case "min":
-		return fmt.Errorf("field %s must be at least %s characters", field, param)
-	case "max":
-		return fmt.Errorf("field %s must be at most %s characters", field, param)
-	default:
-		return fmt.Errorf("field %s is invalid", field)
-	}
+func (e *ValidationError) Error() string {
+	return e.Fields[0].Message
 }